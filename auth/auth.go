@@ -14,8 +14,8 @@ type Auth struct {
 
 func NewAuth(b *bigip.BigIP) Auth {
 	return Auth{
-		user:      UsersResource{b: b},
-		partition: PartitionResource{b: b},
+		user:      newUsersResource(b),
+		partition: newPartitionResource(b),
 	}
 }
 
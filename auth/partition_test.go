@@ -3,16 +3,20 @@ package auth
 import (
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
 	"testing"
 )
 
 func TestPartitionResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.13.91", "admin", "1qaz@WSX3edc")
+	s := bigiptest.NewServer()
+	defer s.Close()
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
 
-	pr := PartitionResource{b: bigIP}
+	pr := newPartitionResource(bigIP)
 
 	pl, err := pr.List()
 	fmt.Println(pl)
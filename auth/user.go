@@ -1,11 +1,7 @@
 package auth
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type UsersList struct {
@@ -38,79 +34,28 @@ type User struct {
 	SessionLimit    int               `json:"sessionLimit,omitempty"`
 	Shell           string            `json:"shell,omitempty"`
 	PartitionAccess []PartitionAccess `json:"partitionAccess,omitempty"`
+	Extra           bigip.Extra       `json:"-"`
 }
 
-type UsersResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes User does not model in Extra.
+func (u *User) UnmarshalJSON(data []byte) error {
+	type plain User
+	return bigip.UnmarshalExtra(data, (*plain)(u), &u.Extra)
 }
 
-// UserEndpoint is the base path of the authz API.
-const UserEndpoint = "user"
-
-func (ur *UsersResource) List() (*UsersList, error) {
-	res, err := ur.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).
-		ManagerName(AuthManager).Resource(UserEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	var ul UsersList
-	if err := json.Unmarshal(res, &ul); err != nil {
-		panic(err)
-	}
-	return &ul, nil
+// MarshalJSON adds the attributes in Extra to those of User.
+func (u User) MarshalJSON() ([]byte, error) {
+	type plain User
+	return bigip.MarshalExtra(plain(u), u.Extra)
 }
 
-// Get a single User configuration identified by name.
-func (r *UsersResource) Get(name string) (*User, error) {
-	var item User
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(AuthManager).
-		Resource(UserEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create a new User configuration.
-func (r *UsersResource) Create(item User) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(AuthManager).
-		Resource(UserEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type UsersResource struct {
+	bigip.Resource[User, UsersList]
 }
 
-// Edit a User configuration identified by name.
-func (r *UsersResource) Update(name string, item User) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(AuthManager).
-		Resource(UserEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newUsersResource(b *bigip.BigIP) UsersResource {
+	return UsersResource{bigip.NewResource[User, UsersList](b, AuthManager, UserEndpoint)}
 }
 
-// Delete a single User configuration identified by name.
-func (r *UsersResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(AuthManager).
-		Resource(UserEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// UserEndpoint is the base path of the authz API.
+const UserEndpoint = "user"
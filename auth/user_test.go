@@ -5,15 +5,19 @@ import (
 	"testing"
 
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
 )
 
 func TestUsersResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.13.91", "admin", "1qaz@WSX3edc")
+	s := bigiptest.NewServer()
+	defer s.Close()
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
 
-	ur := UsersResource{b: bigIP}
+	ur := newUsersResource(bigIP)
 
 	ul, err := ur.List()
 	fmt.Println(ul)
@@ -2,27 +2,106 @@ package bigip
 
 import (
 	"bytes"
-	"crypto/tls"
+	"context"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/lefeck/go-bigip/rest"
-	"log"
+	"github.com/lefeck/go-bigip/transport"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
 const TimeFormat = "2006-01-02T15:04:05.000-0700"
 
+// defaultTokenTimeout is the lifetime in seconds BIG-IP gives a token unless configured otherwise.
+const defaultTokenTimeout = 1200
+
 // DefaultTimeout defines the default timeout for HTTP clients.
 var DefaultTimeout time.Duration = 60
 
+// DefaultLoginTimeout bounds the time a login may take, so that a device that
+// stops responding does not block the requests waiting for a token forever.
+var DefaultLoginTimeout = 30 * time.Second
+
 // BigIP struct contains a pointer to the RESTClient
 type BigIP struct {
 	RestClient *rest.RESTClient
+
+	// tokenSource is set for sessions created with token authentication.
+	tokenSource *tokenSource
+	partition   string
+}
+
+// New creates a session with the device at host configured by opts. Credentials
+// are given with WithBasicAuth or WithTokenAuth. New makes sure the device can be
+// reached and accepts the credentials before it returns; when it can not, the
+// error is a *SessionError that matches ErrUnreachable, ErrTLS, ErrAuthFailed or
+// ErrBadCredentials with errors.Is.
+func New(host string, opts ...Option) (*BigIP, error) {
+	return NewContext(context.Background(), host, opts...)
+}
+
+// NewContext is like New but uses ctx for the login and the requests made to check
+// the device.
+func NewContext(ctx context.Context, host string, opts ...Option) (*BigIP, error) {
+	o := newOptions(host, opts...)
+	if o.auth.UserName == "" {
+		return nil, errors.New("bigip: no credentials, use WithBasicAuth or WithTokenAuth")
+	}
+	config := &rest.Config{
+		Host: host,
+		ContentConfig: rest.ContentConfig{
+			ContentType: "application/json",
+		},
+		TLS:           o.tls,
+		Transport:     o.transport,
+		WrapTransport: o.wrap,
+	}
+
+	// The login uses the same transport settings as the REST client, but is not
+	// rate limited, see transport.SkipRateLimit.
+	rt, err := transport.New(&transport.Config{TLS: o.tls, Transport: o.transport, WrapTransport: o.wrap})
+	if err != nil {
+		return nil, err
+	}
+	o.auth.Client = &http.Client{Transport: rt, Timeout: DefaultLoginTimeout}
+
+	var source *tokenSource
+	if o.tokenAuth {
+		source = newTokenSource(o.auth)
+		if _, err := source.TokenContext(ctx); err != nil {
+			return nil, newSessionError(host, err)
+		}
+		config.TokenSource = source
+	} else {
+		config.Username = o.auth.UserName
+		config.Password = o.auth.Password
+	}
+
+	restClient, err := restClientFor(config)
+	if err != nil {
+		return nil, err
+	}
+	b := &BigIP{
+		RestClient:  restClient,
+		tokenSource: source,
+		partition:   o.partition,
+	}
+	if !o.tokenAuth {
+		if err := b.verify(ctx, host); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
 }
 
 // NewSession creates a new BigIP structure initialized with a username and password.
+// Unlike New it does not contact the device, so wrong credentials are only reported
+// by the first request.
 func NewSession(host, username, password string) (*BigIP, error) {
 	config := &rest.Config{
 		Host:     host,
@@ -43,29 +122,70 @@ func NewSession(host, username, password string) (*BigIP, error) {
 	}, nil
 }
 
-// NewToken retrieves a login token from a new BigIP structure with token authentication
+// NewToken retrieves a login token from a new BigIP structure with token authentication.
+// The token is refreshed before it expires, and a request rejected because the token
+// is no longer valid is retried once after logging in again. It is a shorthand for New
+// with WithTokenAuth.
 func NewToken(host, username, password, loginProviderName string, options ...Option) (*BigIP, error) {
-	auth := newAuthPayload(host, username, password, loginProviderName, options...)
-	token, _, err := auth.generateToken()
+	opts := append([]Option{WithTokenAuth(username, password, loginProviderName)}, options...)
+	return New(host, opts...)
+}
+
+// verify checks with a cheap request that host can be reached and accepts the
+// credentials of the session.
+func (b *BigIP) verify(ctx context.Context, host string) error {
+	u := b.RestClient.Get().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).ManagerName("sys").Resource("version").URL()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
-		log.Fatalf("generation token failed %v\n", err)
+		return err
 	}
-	config := &rest.Config{
-		Host: host,
-		ContentConfig: rest.ContentConfig{
-			ContentType: "application/json",
-		},
-		BearerToken: token,
+	resp, err := b.RestClient.Client.Do(req)
+	if err != nil {
+		return newSessionError(host, err)
 	}
+	defer resp.Body.Close()
+	return statusError(host, resp)
+}
 
-	restClient, err := restClientFor(config)
+// Partition returns the default partition of the session set with WithPartition,
+// or an empty string. Resources do not apply it themselves: names without a
+// partition refer to Common on the device.
+func (b *BigIP) Partition() string {
+	return b.partition
+}
+
+// Token returns the token the session currently authenticates with. It returns an
+// empty string for sessions using basic authentication.
+func (b *BigIP) Token() (string, error) {
+	if b.tokenSource == nil {
+		return "", nil
+	}
+	token, err := b.tokenSource.Token()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
+	return token.AccessToken, nil
+}
 
-	return &BigIP{
-		RestClient: restClient,
-	}, nil
+// Logout revokes the token of a session created with token authentication, so that
+// it no longer counts against the token limit of the user. The session can not be
+// used afterwards. Logout does nothing for sessions using basic authentication.
+func (b *BigIP) Logout(ctx context.Context) error {
+	if b.tokenSource == nil {
+		return nil
+	}
+	token := b.tokenSource.close()
+	if token == "" {
+		return nil
+	}
+	_, err := b.RestClient.Delete().Prefix(GetBaseResource()).ResourceCategory(GetShareResource()).ManagerName(AuthzManager).
+		Resource(TokensEndpoint).ResourceInstance(token).SetHeader("X-F5-Auth-Token", token).DoRaw(ctx)
+	return err
+}
+
+// Close is like Logout with a background context. It implements io.Closer.
+func (b *BigIP) Close() error {
+	return b.Logout(context.Background())
 }
 
 // restClientFor is a helper function that creates a new REST client for the given config.
@@ -77,8 +197,26 @@ func restClientFor(config *rest.Config) (*rest.RESTClient, error) {
 	return rest.RESTClientForConfigAndClient(config, httpClient)
 }
 
-// Option is a custom type that handles options
-type Option func(payload *authPayload)
+// Option configures a session created with New or NewToken.
+type Option func(o *options)
+
+// options holds the settings a session is created with.
+type options struct {
+	auth      *authPayload
+	tokenAuth bool
+	tls       *transport.TLSConfig
+	transport http.RoundTripper
+	wrap      transport.WrapperFunc
+	partition string
+}
+
+// tlsConfig returns the TLS settings of o, creating them on first use.
+func (o *options) tlsConfig() *transport.TLSConfig {
+	if o.tls == nil {
+		o.tls = &transport.TLSConfig{}
+	}
+	return o.tls
+}
 
 // authPayload contains authentication related information such as hostname, username, password, etc.
 type authPayload struct {
@@ -87,45 +225,198 @@ type authPayload struct {
 	Password          string        `json:"password"`
 	LoginProviderName string        `json:"loginProviderName"`
 	Timeout           time.Duration `json:"timeout"`
+	RefreshWindow     time.Duration `json:"-"`
+	TokenTimeout      time.Duration `json:"-"`
 	token             string
 	tokenExpiresAt    time.Time
 	Client            *http.Client `json:"client"`
 }
 
+// WithBasicAuth makes the session send username and password with every request.
+func WithBasicAuth(username, password string) Option {
+	return func(o *options) {
+		o.auth.UserName = username
+		o.auth.Password = password
+		o.tokenAuth = false
+	}
+}
+
+// WithTokenAuth makes the session log in with username and password against
+// loginProviderName, "tmos" for local users, and authenticate with the token it
+// receives.
+func WithTokenAuth(username, password, loginProviderName string) Option {
+	return func(o *options) {
+		o.auth.UserName = username
+		o.auth.Password = password
+		o.auth.LoginProviderName = loginProviderName
+		o.tokenAuth = true
+	}
+}
+
+// WithPartition sets the partition the session works in by default, returned by
+// Partition, e.g. for a tool to create its objects in.
+func WithPartition(partition string) Option {
+	return func(o *options) {
+		o.partition = partition
+	}
+}
+
 // WithTimeout is an Option type function used for setting the timeout
 func WithTimeout(timeout time.Duration) Option {
-	return func(auth *authPayload) {
-		auth.Timeout = timeout
-	}
-}
-
-// newAuthPayload creates a new authPayload based on the given hostname, username, password, and loginProviderName among other things.
-func newAuthPayload(host, username, password, loginProviderName string, options ...Option) *authPayload {
-	auth := &authPayload{
-		Host:              host,
-		UserName:          username,
-		Password:          password,
-		LoginProviderName: loginProviderName,
-		Timeout:           DefaultTimeout,
-		Client: &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: true,
-				},
-			},
+	return func(o *options) {
+		o.auth.Timeout = timeout
+	}
+}
+
+// WithTokenTimeout sets the lifetime of the tokens obtained by the session. BIG-IP
+// issues tokens valid for 1200s by default and accepts up to MaxTokenTimeout.
+func WithTokenTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.auth.TokenTimeout = timeout
+	}
+}
+
+// WithTokenRefreshWindow sets how long before its expiry a token is replaced by a new one.
+func WithTokenRefreshWindow(window time.Duration) Option {
+	return func(o *options) {
+		o.auth.RefreshWindow = window
+	}
+}
+
+// WithTLSConfig sets all TLS settings of the session at once. Without any TLS
+// option the certificate of the device is not verified.
+func WithTLSConfig(config *transport.TLSConfig) Option {
+	return func(o *options) {
+		o.tls = config
+	}
+}
+
+// WithRootCAs verifies the certificate of the device against pool instead of the
+// system pool.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(o *options) {
+		o.tlsConfig().RootCAs = pool
+	}
+}
+
+// WithCAFile verifies the certificate of the device against the PEM encoded CA
+// certificates in path.
+func WithCAFile(path string) Option {
+	return func(o *options) {
+		o.tlsConfig().CAFile = path
+	}
+}
+
+// WithPinnedCertificates only trusts a device presenting one of the certificates
+// identified by pins. See transport.TLSConfig.Pins for their format.
+func WithPinnedCertificates(pins ...string) Option {
+	return func(o *options) {
+		c := o.tlsConfig()
+		c.Pins = append(c.Pins, pins...)
+	}
+}
+
+// WithServerName verifies the certificate of the device against name instead of host.
+func WithServerName(name string) Option {
+	return func(o *options) {
+		o.tlsConfig().ServerName = name
+	}
+}
+
+// WithMinTLSVersion sets the minimum TLS version accepted, for example tls.VersionTLS12.
+func WithMinTLSVersion(version uint16) Option {
+	return func(o *options) {
+		o.tlsConfig().MinVersion = version
+	}
+}
+
+// WithClientCertificate authenticates the connections to the device with the PEM
+// encoded certificate and key in certFile and keyFile.
+func WithClientCertificate(certFile, keyFile string) Option {
+	return func(o *options) {
+		c := o.tlsConfig()
+		c.CertFile = certFile
+		c.KeyFile = keyFile
+	}
+}
+
+// WithInsecureSkipVerify does not verify the certificate of the device. Pins set
+// with WithPinnedCertificates are still checked.
+func WithInsecureSkipVerify() Option {
+	return func(o *options) {
+		o.tlsConfig().Insecure = true
+	}
+}
+
+// WithTransport makes the session send its requests through rt. TLS options can
+// only be combined with an *http.Transport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(o *options) {
+		o.transport = rt
+	}
+}
+
+// WithWrapTransport adds a middleware wrapping the round tripper of the session.
+// Wrappers are applied in the order they are given, the first one being closest
+// to the network.
+func WithWrapTransport(fn transport.WrapperFunc) Option {
+	return func(o *options) {
+		o.wrap = transport.Wrappers(o.wrap, fn)
+	}
+}
+
+// WithRetry retries requests failing because the device is busy or the connection
+// dropped, as configured by policy.
+func WithRetry(policy transport.RetryPolicy) Option {
+	return WithWrapTransport(transport.Retry(policy))
+}
+
+// WithRateLimit throttles the requests of the session as configured by policy,
+// separately for reads and writes, to spare restjavad on smaller devices. Requests
+// waiting for their turn fail once their context is done.
+func WithRateLimit(policy transport.LimitPolicy) Option {
+	return WithWrapTransport(transport.RateLimit(policy))
+}
+
+// WithLogger logs the method, URL, status and latency of every request of the
+// session to logger, which may be an *slog.Logger, with credentials redacted.
+func WithLogger(logger transport.Logger) Option {
+	return WithDebug(transport.DebugConfig{Logger: logger})
+}
+
+// WithDebug logs the requests of the session as configured by config, including
+// their headers and bodies when asked for.
+func WithDebug(config transport.DebugConfig) Option {
+	return WithWrapTransport(transport.Debug(config))
+}
+
+// WithInstrumentation reports the latency, status and number in flight of the
+// requests of the session to config.Metrics and traces them with config.Tracer,
+// labelled with the manager and resource they were made to.
+func WithInstrumentation(config transport.InstrumentConfig) Option {
+	return WithWrapTransport(transport.Instrument(config))
+}
+
+// newOptions creates the options of a session with host and applies opts to them.
+func newOptions(host string, opts ...Option) *options {
+	o := &options{
+		auth: &authPayload{
+			Host:          host,
+			Timeout:       DefaultTimeout,
+			RefreshWindow: DefaultTokenRefreshWindow,
 		},
 	}
 
 	// Apply any incoming options
-	for _, opt := range options {
-		opt(auth)
+	for _, opt := range opts {
+		opt(o)
 	}
 
-	return auth
+	return o
 }
 
 // newHTTPRequest is a helper function for creating new HTTP requests.
-func (auth *authPayload) newHTTPRequest() (*http.Request, error) {
+func (auth *authPayload) newHTTPRequest(ctx context.Context) (*http.Request, error) {
 	authz := authPayload{
 		Host:              auth.Host,
 		UserName:          auth.UserName,
@@ -145,7 +436,7 @@ func (auth *authPayload) newHTTPRequest() (*http.Request, error) {
 		return nil, fmt.Errorf("failed to parse URL: %v", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, parsedURL.String(), bytes.NewBuffer(data))
+	req, err := http.NewRequestWithContext(transport.SkipRateLimit(ctx), http.MethodPost, parsedURL.String(), bytes.NewBuffer(data))
 	if err != nil {
 		return nil, err
 	}
@@ -157,40 +448,26 @@ func (auth *authPayload) newHTTPRequest() (*http.Request, error) {
 type authToken struct {
 	Username          string `json:"username"`
 	LoginProviderName string `json:"loginProviderName"`
-	Token             struct {
-		Token            string        `json:"token"`
-		Name             string        `json:"name"`
-		UserName         string        `json:"userName"`
-		AuthProviderName string        `json:"authProviderName"`
-		GroupReferences  []interface{} `json:"groupReferences"`
-		Timeout          int           `json:"timeout"`
-		StartTime        string        `json:"startTime"`
-		Address          string        `json:"address"`
-		Partition        string        `json:"partition"`
-		Generation       int           `json:"generation"`
-		LastUpdateMicros int64         `json:"lastUpdateMicros"`
-		ExpirationMicros int64         `json:"expirationMicros"`
-		Kind             string        `json:"kind"`
-		SelfLink         string        `json:"selfLink"`
-	} `json:"token"`
-	Generation       int `json:"generation"`
-	LastUpdateMicros int `json:"lastUpdateMicros"`
-}
-
-// generateToken is used to create a new authentication token
-func (auth *authPayload) generateToken() (string, time.Time, error) {
-	req, err := auth.newHTTPRequest()
+	Token             Token  `json:"token"`
+	Generation        int    `json:"generation"`
+	LastUpdateMicros  int    `json:"lastUpdateMicros"`
+}
+
+// generateToken logs in and returns the new token and the time it expires at.
+func (auth *authPayload) generateToken(ctx context.Context) (string, time.Time, error) {
+	req, err := auth.newHTTPRequest(ctx)
 	if err != nil {
 		return "", time.Time{}, err
 	}
+	sentAt := time.Now()
 	resp, err := auth.Client.Do(req)
 	if err != nil {
-		return "", time.Time{}, err
+		return "", time.Time{}, newSessionError(auth.Host, err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode >= 400 {
-		return "", time.Time{}, fmt.Errorf("http response status code error: %s", resp.Status)
+	if err := statusError(auth.Host, resp); err != nil {
+		return "", time.Time{}, err
 	}
 
 	token := authToken{}
@@ -199,16 +476,40 @@ func (auth *authPayload) generateToken() (string, time.Time, error) {
 		return "", time.Time{}, fmt.Errorf("failed to create token: %v", err)
 	}
 
-	startTime, err := time.Parse(TimeFormat, token.Token.StartTime)
+	// The lifetime is measured with the local clock from the moment the login was
+	// sent, so that a clock skew between this host and the device does not let the
+	// token expire unnoticed.
+	timeout := int(token.Token.Timeout)
+	if timeout <= 0 {
+		timeout = defaultTokenTimeout
+	}
+	expiresAt := sentAt.Add(time.Duration(timeout) * time.Second)
+	return token.Token.Token, expiresAt, nil
+}
 
+// extendToken sets the lifetime of token to timeout.
+func (auth *authPayload) extendToken(ctx context.Context, token string, timeout time.Duration) error {
+	data, err := tokenTimeoutBody(timeout)
 	if err != nil {
-		return "", time.Time{}, fmt.Errorf("failed to parse token start time: %v", err)
+		return err
 	}
+	rawURL, basePath, _ := rest.DefaultServerURL(auth.Host, "/mgmt/shared/authz/tokens/"+token)
+	req, err := http.NewRequestWithContext(transport.SkipRateLimit(ctx), http.MethodPatch, rawURL.String()+basePath, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-F5-Auth-Token", token)
+	resp, err := auth.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
 
-	expiresAt := startTime.Add(time.Duration(token.Token.Timeout-token.Token.Timeout+int(auth.Timeout.Seconds())) * time.Second)
-
-	auth.token = token.Token.Token
-	auth.tokenExpiresAt = expiresAt
-
-	return token.Token.Token, expiresAt, nil
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := rest.NewAPIError(resp)
+		apiErr.URL = strings.Replace(apiErr.URL, token, transport.Redacted, 1)
+		return apiErr
+	}
+	return nil
 }
@@ -0,0 +1,134 @@
+package bigip
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newAuthServer returns a server that accepts basic authentication and logins for
+// admin/admin only.
+func newAuthServer() *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/mgmt/shared/authn/login" {
+			if r.Method != http.MethodPost {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			var body struct{ Username, Password string }
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.Username != "admin" || body.Password != "admin" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"code":401,"message":"Authentication failed."}`))
+				return
+			}
+			w.Write([]byte(`{"token":{"token":"token-1","timeout":1200}}`))
+			return
+		}
+		if user, pass, ok := r.BasicAuth(); ok && (user != "admin" || pass != "admin") {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":401,"message":"Authorization failed: no user authentication header or token detected."}`))
+			return
+		}
+		w.Write([]byte(`{"kind":"tm:sys:version:versionstats"}`))
+	}))
+}
+
+func TestNew(t *testing.T) {
+	ts := newAuthServer()
+	defer ts.Close()
+
+	tests := []struct {
+		name string
+		opts []Option
+		kind error
+	}{
+		{name: "basic", opts: []Option{WithBasicAuth("admin", "admin")}},
+		{name: "token", opts: []Option{WithTokenAuth("admin", "admin", "tmos")}},
+		{name: "basic bad credentials", opts: []Option{WithBasicAuth("admin", "wrong")}, kind: ErrBadCredentials},
+		{name: "token bad credentials", opts: []Option{WithTokenAuth("admin", "wrong", "tmos")}, kind: ErrBadCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := New(ts.URL, tt.opts...)
+			if tt.kind == nil {
+				if err != nil {
+					t.Fatalf("New: %v", err)
+				}
+				if b.RestClient == nil {
+					t.Fatal("expected a REST client")
+				}
+				return
+			}
+			if !errors.Is(err, tt.kind) {
+				t.Fatalf("expected %v, got %v", tt.kind, err)
+			}
+			if !errors.Is(err, ErrAuthFailed) {
+				t.Errorf("expected %v to match ErrAuthFailed", err)
+			}
+			var sessionErr *SessionError
+			if !errors.As(err, &sessionErr) || sessionErr.Host != ts.URL {
+				t.Errorf("expected a *SessionError for %s, got %#v", ts.URL, err)
+			}
+		})
+	}
+}
+
+func TestNewUnreachable(t *testing.T) {
+	ts := newAuthServer()
+	url := ts.URL
+	ts.Close()
+
+	for _, opt := range []Option{WithBasicAuth("admin", "admin"), WithTokenAuth("admin", "admin", "tmos")} {
+		_, err := New(url, opt)
+		if !errors.Is(err, ErrUnreachable) {
+			t.Errorf("expected ErrUnreachable, got %v", err)
+		}
+	}
+}
+
+func TestNewWithoutCredentials(t *testing.T) {
+	if _, err := New("localhost"); err == nil {
+		t.Fatal("expected an error without credentials")
+	}
+}
+
+func TestNewTLSVerification(t *testing.T) {
+	ts := newAuthServer()
+	defer ts.Close()
+
+	fingerprint := sha256.Sum256(ts.Certificate().Raw)
+	pin := hex.EncodeToString(fingerprint[:])
+	pool := x509.NewCertPool()
+	pool.AddCert(ts.Certificate())
+
+	tests := []struct {
+		name string
+		opts []Option
+		kind error
+	}{
+		{name: "pinned", opts: []Option{WithPinnedCertificates(pin)}},
+		{name: "root CAs", opts: []Option{WithRootCAs(pool), WithServerName("example.com")}},
+		{name: "wrong pin", opts: []Option{WithPinnedCertificates(strings.Repeat("00", 32))}, kind: ErrTLS},
+		{name: "untrusted", opts: []Option{WithMinTLSVersion(tls.VersionTLS12)}, kind: ErrTLS},
+	}
+	for _, tt := range tests {
+		for _, auth := range []Option{WithBasicAuth("admin", "admin"), WithTokenAuth("admin", "admin", "tmos")} {
+			_, err := New(ts.URL, append([]Option{auth}, tt.opts...)...)
+			if tt.kind == nil && err != nil {
+				t.Errorf("%s: New: %v", tt.name, err)
+			}
+			if tt.kind != nil && !errors.Is(err, tt.kind) {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.kind, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,480 @@
+package bigiptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// folders are the paths that group collections rather than being collections
+// themselves, e.g. ltm/monitor holds ltm/monitor/http.
+var folders = map[string]bool{
+	"gtm/global-settings":      true,
+	"gtm/monitor":              true,
+	"gtm/pool":                 true,
+	"gtm/wideip":               true,
+	"ltm/message-routing":      true,
+	"ltm/monitor":              true,
+	"ltm/persistence":          true,
+	"ltm/profile":              true,
+	"net/tunnels":              true,
+	"security/dos":             true,
+	"security/firewall":        true,
+	"security/ip-intelligence": true,
+	"security/log":             true,
+	"sys/application":          true,
+	"sys/crypto":               true,
+	"sys/disk":                 true,
+	"sys/ecm":                  true,
+	"sys/file":                 true,
+	"sys/ipfix":                true,
+	"sys/software":             true,
+}
+
+// unpartitioned are the collections under ltm, gtm and net whose objects do not
+// belong to a partition. Objects of the other collections there are put in
+// /Common unless they name a partition.
+var unpartitioned = map[string]bool{
+	"net/interface": true,
+	"net/trunk":     true,
+}
+
+// subcollectionFields are the attributes that create objects of a subcollection
+// when an object is created, e.g. the members of a pool.
+var subcollectionFields = map[string]string{
+	"ltm/pool": "members",
+}
+
+// target is the collection, object or statistics a request is addressed to.
+type target struct {
+	// collection is the path of the collection, e.g. ltm/pool or
+	// ltm/pool/~Common~p1/members.
+	collection string
+	// name is the last path segment addressing an object of the collection,
+	// e.g. ~Common~p1, or "" for the collection itself.
+	name string
+	// kind is the path of the collection without the objects in it, e.g.
+	// ltm/pool/members.
+	kind  []string
+	stats bool
+}
+
+// resolve returns the target of a request to p, which is relative to /mgmt/tm.
+func (s *Server) resolve(p string) (target, error) {
+	segs := strings.Split(strings.Trim(p, "/"), "/")
+	if len(segs) < 2 {
+		return target{}, fmt.Errorf("Public URI path not registered: /mgmt/tm/%s", p)
+	}
+	t := target{collection: segs[0] + "/" + segs[1], kind: segs[:2:2]}
+	i := 2
+	if folders[t.collection] && i < len(segs) && segs[i] != "stats" {
+		t.collection += "/" + segs[i]
+		t.kind = append(t.kind, segs[i])
+		i++
+	}
+	for ; i < len(segs); i++ {
+		switch {
+		case segs[i] == "stats" && i == len(segs)-1:
+			t.stats = true
+		case t.name == "":
+			t.name = segs[i]
+		default:
+			j := s.find(t.collection, t.name)
+			if j < 0 {
+				return target{}, notFound(t)
+			}
+			t.collection += "/" + instanceSegment(s.state.Collections[t.collection][j]) + "/" + segs[i]
+			t.kind = append(t.kind, segs[i])
+			t.name = ""
+		}
+	}
+	return t, nil
+}
+
+func (t target) kindName(suffix string) string {
+	return "tm:" + strings.Join(t.kind, ":") + ":" + t.kind[len(t.kind)-1] + suffix
+}
+
+func notFound(t target) error {
+	return fmt.Errorf("01020036:3: The requested %s (%s) was not found.", strings.Join(t.kind, " "), strings.ReplaceAll(t.name, "~", "/"))
+}
+
+// objectID returns the full path of obj, or its name if it has none.
+func objectID(obj object) string {
+	if fullPath, _ := obj["fullPath"].(string); fullPath != "" {
+		return fullPath
+	}
+	name, _ := obj["name"].(string)
+	return name
+}
+
+// instanceSegment returns the path segment addressing obj, e.g. ~Common~p1.
+func instanceSegment(obj object) string {
+	return strings.ReplaceAll(objectID(obj), "/", "~")
+}
+
+// find returns the index of the object of the collection addressed by name,
+// which is a name, a full path or a path segment such as ~Common~p1, or -1.
+func (s *Server) find(collection, name string) int {
+	key := strings.ReplaceAll(name, "~", "/")
+	for i, obj := range s.state.Collections[collection] {
+		fullPath, _ := obj["fullPath"].(string)
+		objName, _ := obj["name"].(string)
+		partition, _ := obj["partition"].(string)
+		switch {
+		case key == fullPath,
+			key == objName && (partition == "" || partition == "Common"),
+			fullPath == "" && key == "/Common/"+objName:
+			return i
+		}
+	}
+	return -1
+}
+
+// newObject completes obj, a new object of the collection of t, with the
+// attributes the device adds to a new object.
+func (s *Server) newObject(t target, obj object) object {
+	for key, value := range obj {
+		if value == nil {
+			delete(obj, key)
+		}
+	}
+	name, _ := obj["name"].(string)
+	partition, _ := obj["partition"].(string)
+	if strings.HasPrefix(name, "/") {
+		parts := strings.Split(strings.TrimPrefix(name, "/"), "/")
+		partition, name = parts[0], parts[len(parts)-1]
+	}
+	if partition == "" && !unpartitioned[t.collection] {
+		switch t.kind[0] {
+		case "ltm", "gtm", "net":
+			partition = "Common"
+		}
+	}
+	if name != "" {
+		obj["name"] = name
+		if partition != "" {
+			obj["partition"] = partition
+			obj["fullPath"] = "/" + partition + "/" + name
+		}
+	}
+	obj["kind"] = t.kindName("state")
+	obj["generation"] = 1
+	obj["selfLink"] = s.link(t.collection + "/" + instanceSegment(obj))
+	return obj
+}
+
+// serveTM handles a request to p, which is relative to /mgmt/tm.
+func (s *Server) serveTM(method, p string, query url.Values, body []byte) response {
+	if p == "sys/version" && method == http.MethodGet {
+		if _, ok := s.state.Singletons[p]; !ok {
+			return jsonResponse(http.StatusOK, s.versionObject())
+		}
+	}
+	t, err := s.resolve(p)
+	if err != nil {
+		return errorResponse(http.StatusNotFound, err.Error())
+	}
+	if t.stats {
+		if method != http.MethodGet {
+			return errorResponse(http.StatusMethodNotAllowed, "Method not allowed: "+method)
+		}
+		return s.getStats(t)
+	}
+
+	var obj object
+	if method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch {
+		if obj, err = decodeObject(body); err != nil {
+			return errorResponse(http.StatusBadRequest, err.Error())
+		}
+		// Attributes may be named the way tmsh names them, e.g. file-name for fileName.
+		for key, value := range obj {
+			if strings.Contains(key, "-") {
+				delete(obj, key)
+				obj[camelCase(key)] = value
+			}
+		}
+	}
+	if t.name == "" {
+		switch method {
+		case http.MethodGet:
+			if single, ok := s.state.Singletons[t.collection]; ok {
+				return jsonResponse(http.StatusOK, selectAttributes(single, query))
+			}
+			return s.list(t, query)
+		case http.MethodPost:
+			return s.create(t, obj)
+		case http.MethodPut, http.MethodPatch:
+			// Objects that are not part of a collection are modified at its path.
+			single := s.state.Singletons[t.collection]
+			if single == nil {
+				single = object{"kind": t.kindName("state"), "selfLink": s.link(t.collection)}
+			}
+			merge(single, obj)
+			s.state.Singletons[t.collection] = single
+			return jsonResponse(http.StatusOK, single)
+		}
+		return errorResponse(http.StatusMethodNotAllowed, "Method not allowed: "+method)
+	}
+
+	i := s.find(t.collection, t.name)
+	if i < 0 {
+		return errorResponse(http.StatusNotFound, notFound(t).Error())
+	}
+	current := s.state.Collections[t.collection][i]
+	switch method {
+	case http.MethodGet:
+		return jsonResponse(http.StatusOK, s.render(t.collection, current, query))
+	case http.MethodPut, http.MethodPatch:
+		// Like a device, PUT leaves the attributes it does not name unchanged.
+		updated := current
+		delete(obj, "name")
+		delete(obj, "partition")
+		delete(obj, "fullPath")
+		merge(updated, obj)
+		generation, _ := strconv.Atoi(fmt.Sprint(current["generation"]))
+		updated["generation"] = generation + 1
+		s.state.Collections[t.collection][i] = updated
+		return jsonResponse(http.StatusOK, s.render(t.collection, updated, nil))
+	case http.MethodDelete:
+		items := s.state.Collections[t.collection]
+		s.state.Collections[t.collection] = append(items[:i:i], items[i+1:]...)
+		prefix := t.collection + "/" + instanceSegment(current)
+		for collection := range s.state.Collections {
+			if strings.HasPrefix(collection, prefix+"/") {
+				delete(s.state.Collections, collection)
+			}
+		}
+		for p := range s.state.Stats {
+			if p == prefix || strings.HasPrefix(p, prefix+"/") {
+				delete(s.state.Stats, p)
+			}
+		}
+		return response{status: http.StatusOK}
+	}
+	return errorResponse(http.StatusMethodNotAllowed, "Method not allowed: "+method)
+}
+
+// camelCase returns the attribute name for the tmsh name, e.g. fileName for file-name.
+func camelCase(name string) string {
+	parts := strings.Split(name, "-")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// merge sets the attributes of dst to those of src, removing those set to null.
+func merge(dst, src object) {
+	for key, value := range src {
+		if value == nil {
+			delete(dst, key)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+func (s *Server) create(t target, obj object) response {
+	// Commands such as POST /mgmt/tm/sys/config {"command":"save"} run rather
+	// than create an object.
+	if _, ok := obj["command"]; ok {
+		return jsonResponse(http.StatusOK, obj)
+	}
+	if name, _ := obj["name"].(string); name == "" {
+		return errorResponse(http.StatusBadRequest, "Missing name attribute in the request body")
+	}
+
+	var subItems []any
+	field := subcollectionFields[t.collection]
+	if field != "" {
+		subItems, _ = obj[field].([]any)
+		delete(obj, field)
+	}
+	obj = s.newObject(t, obj)
+	if s.find(t.collection, objectID(obj)) >= 0 {
+		message := fmt.Sprintf("01020066:3: The requested %s (%s) already exists", strings.Join(t.kind, " "), objectID(obj))
+		if partition, _ := obj["partition"].(string); partition != "" {
+			message += " in partition " + partition
+		}
+		return errorResponse(http.StatusConflict, message+".")
+	}
+	s.state.Collections[t.collection] = append(s.state.Collections[t.collection], obj)
+
+	if len(subItems) != 0 {
+		sub := target{collection: t.collection + "/" + instanceSegment(obj) + "/" + field, kind: append(t.kind[:len(t.kind):len(t.kind)], field)}
+		for _, item := range subItems {
+			subObj, ok := item.(map[string]any)
+			if !ok {
+				subObj = object{"name": fmt.Sprint(item)}
+			}
+			s.state.Collections[sub.collection] = append(s.state.Collections[sub.collection], s.newObject(sub, subObj))
+		}
+	}
+	return jsonResponse(http.StatusOK, s.render(t.collection, obj, nil))
+}
+
+// render returns a copy of obj as returned by a GET, with references to its
+// subcollections, which are expanded if query asks for it.
+func (s *Server) render(collection string, obj object, query url.Values) object {
+	obj = copyObject(obj)
+	prefix := collection + "/" + instanceSegment(obj) + "/"
+	for sub, items := range s.state.Collections {
+		name := strings.TrimPrefix(sub, prefix)
+		if !strings.HasPrefix(sub, prefix) || strings.Contains(name, "/") {
+			continue
+		}
+		ref := object{"link": s.link(sub), "isSubcollection": true}
+		if query.Get("expandSubcollections") == "true" {
+			expanded := make([]object, len(items))
+			for i, item := range items {
+				expanded[i] = s.render(sub, item, nil)
+			}
+			ref["items"] = expanded
+		}
+		obj[name+"Reference"] = ref
+	}
+	return selectAttributes(obj, query)
+}
+
+// selectAttributes returns obj with only the attributes listed by $select.
+func selectAttributes(obj object, query url.Values) object {
+	selected := query.Get("$select")
+	if selected == "" {
+		return obj
+	}
+	result := object{}
+	for _, key := range strings.Split(selected, ",") {
+		if value, ok := obj[key]; ok {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+var filterRE = regexp.MustCompile(`^\s*(\w+)\s+eq\s+'?([^']*?)'?\s*$`)
+
+func (s *Server) list(t target, query url.Values) response {
+	items := s.state.Collections[t.collection]
+	if filter := query.Get("$filter"); filter != "" {
+		m := filterRE.FindStringSubmatch(filter)
+		if m == nil {
+			return errorResponse(http.StatusBadRequest, "Query parameter $filter has an unsupported value: "+filter)
+		}
+		var filtered []object
+		for _, item := range items {
+			if fmt.Sprint(item[m[1]]) == m[2] {
+				filtered = append(filtered, item)
+			}
+		}
+		items = filtered
+	}
+
+	res := object{"kind": t.kindName("collectionstate"), "selfLink": s.link(t.collection)}
+	total := len(items)
+	top, skip := -1, 0
+	if v := query.Get("$top"); v != "" {
+		var err error
+		if top, err = strconv.Atoi(v); err != nil || top < 0 {
+			return errorResponse(http.StatusBadRequest, "Query parameter $top has an invalid value: "+v)
+		}
+	}
+	if v := query.Get("$skip"); v != "" {
+		var err error
+		if skip, err = strconv.Atoi(v); err != nil || skip < 0 {
+			return errorResponse(http.StatusBadRequest, "Query parameter $skip has an invalid value: "+v)
+		}
+	}
+	if skip > len(items) {
+		skip = len(items)
+	}
+	items = items[skip:]
+	if top >= 0 && top < len(items) {
+		items = items[:top]
+	}
+
+	if len(items) != 0 {
+		rendered := make([]object, len(items))
+		for i, item := range items {
+			rendered[i] = s.render(t.collection, item, query)
+		}
+		res["items"] = rendered
+	}
+	if top > 0 {
+		res["currentItemCount"] = len(items)
+		res["itemsPerPage"] = top
+		res["pageIndex"] = skip/top + 1
+		res["startIndex"] = skip + 1
+		res["totalItems"] = total
+		res["totalPages"] = (total + top - 1) / top
+		if skip+top < total {
+			next := url.Values{}
+			for key, values := range query {
+				next[key] = values
+			}
+			next.Set("$skip", strconv.Itoa(skip+top))
+			next.Set("ver", s.Version)
+			res["nextLink"] = linkHost + "/mgmt/tm/" + t.collection + "?" + next.Encode()
+		}
+	}
+	return jsonResponse(http.StatusOK, res)
+}
+
+func (s *Server) getStats(t target) response {
+	items := s.state.Collections[t.collection]
+	selfLink := s.link(t.collection + "/stats")
+	if t.name != "" {
+		i := s.find(t.collection, t.name)
+		if i < 0 {
+			return errorResponse(http.StatusNotFound, notFound(t).Error())
+		}
+		items = items[i : i+1]
+		selfLink = s.link(t.collection + "/" + instanceSegment(items[0]) + "/stats")
+	}
+
+	entries := object{}
+	for _, item := range items {
+		p := t.collection + "/" + instanceSegment(item)
+		nested := object{"tmName": object{"description": objectID(item)}}
+		for key, value := range s.state.Stats[p] {
+			if _, ok := value.(string); ok {
+				nested[key] = object{"description": value}
+			} else {
+				nested[key] = object{"value": value}
+			}
+		}
+		entries[linkHost+"/mgmt/tm/"+p+"/stats"] = object{
+			"nestedStats": object{
+				"kind":     t.kindName("stats"),
+				"selfLink": s.link(p + "/stats"),
+				"entries":  nested,
+			},
+		}
+	}
+	suffix := "collectionstats"
+	if t.name != "" {
+		suffix = "stats"
+	}
+	return jsonResponse(http.StatusOK, object{"kind": t.kindName(suffix), "selfLink": selfLink, "entries": entries})
+}
+
+// snapshot returns a copy of the configuration.
+func (s *Server) snapshot() state {
+	data, err := json.Marshal(s.state)
+	if err != nil {
+		panic(fmt.Sprintf("bigiptest: %v", err))
+	}
+	var c state
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.UseNumber()
+	if err := dec.Decode(&c); err != nil {
+		panic(fmt.Sprintf("bigiptest: %v", err))
+	}
+	return c
+}
@@ -0,0 +1,147 @@
+package bigiptest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxChunkSize is the largest chunk of a file transfer a device accepts.
+const maxChunkSize = 1024 * 1024
+
+// uploadDirectories maps the upload endpoints to the directory of the device the
+// files uploaded to them are stored in.
+var uploadDirectories = map[string]string{
+	"/mgmt/shared/file-transfer/uploads/":         "/var/config/rest/downloads/",
+	"/mgmt/shared/file-transfer/ucs-uploads/":     "/var/local/ucs/",
+	"/mgmt/cm/autodeploy/software-image-uploads/": "/shared/images/",
+}
+
+// File returns a copy of the file at path on the device, e.g. one uploaded to
+// /var/config/rest/downloads/app.tgz, and whether it exists.
+func (s *Server) File(path string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.files[path]
+	return append([]byte(nil), data...), ok
+}
+
+// SetFile sets the content of the file at path on the device.
+func (s *Server) SetFile(path string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[path] = append([]byte(nil), data...)
+}
+
+// downloadDirectories maps the download endpoints to the directory of the device
+// the files downloaded from them are read from.
+var downloadDirectories = map[string]string{
+	"/mgmt/shared/file-transfer/bulk/":          "/var/config/rest/bulk/",
+	"/mgmt/shared/file-transfer/ucs-downloads/": "/var/local/ucs/",
+	"/mgmt/cm/autodeploy/qkview-downloads/":     "/var/tmp/",
+}
+
+// isFileTransfer reports whether p is the path of a file transfer.
+func isFileTransfer(p string) bool {
+	for _, directories := range []map[string]string{uploadDirectories, downloadDirectories} {
+		for prefix := range directories {
+			if strings.HasPrefix(p, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// serveFileTransfer handles a request to the file transfer endpoints at p.
+func (s *Server) serveFileTransfer(method, p string, header http.Header, body []byte) response {
+	for _, transfer := range []struct {
+		directories map[string]string
+		method      string
+		serve       func(path, contentRange string, body []byte) response
+	}{
+		{uploadDirectories, http.MethodPost, s.upload},
+		{downloadDirectories, http.MethodGet, s.download},
+	} {
+		for prefix, dir := range transfer.directories {
+			name := strings.TrimPrefix(p, prefix)
+			if !strings.HasPrefix(p, prefix) || name == "" || strings.Contains(name, "/") {
+				continue
+			}
+			if method != transfer.method {
+				return errorResponse(http.StatusMethodNotAllowed, "Method not allowed: "+method)
+			}
+			return transfer.serve(dir+name, header.Get("Content-Range"), body)
+		}
+	}
+	return errorResponse(http.StatusNotFound, "Public URI path not registered: "+p)
+}
+
+// upload writes a chunk sent with the given Content-Range to the file at path.
+func (s *Server) upload(path, contentRange string, chunk []byte) response {
+	var start, end, total int64
+	if _, err := fmt.Sscanf(contentRange, "%d-%d/%d", &start, &end, &total); err != nil {
+		return errorResponse(http.StatusBadRequest, "Invalid Content-Range header: "+contentRange)
+	}
+	if len(chunk) > maxChunkSize {
+		return errorResponse(http.StatusBadRequest, fmt.Sprintf("Chunk size %d exceeds the maximum of %d bytes", len(chunk), maxChunkSize))
+	}
+	if total != 0 && (end-start+1 != int64(len(chunk)) || end >= total) {
+		return errorResponse(http.StatusBadRequest, "Content-Range "+contentRange+" does not match the body")
+	}
+	data := s.files[path]
+	if start == 0 {
+		// A transfer starting over replaces the file.
+		data = nil
+	}
+	if start > int64(len(data)) {
+		return errorResponse(http.StatusBadRequest, fmt.Sprintf("Chunk starting at %d follows %d bytes", start, len(data)))
+	}
+	s.files[path] = append(data[:start:start], chunk...)
+	return jsonResponse(http.StatusOK, object{
+		"remainingByteCount": total - start - int64(len(chunk)),
+		"totalByteCount":     total,
+		"localFilePath":      path,
+		"temporaryFilePath":  path + ".tmp",
+		"generation":         0,
+		"lastUpdateMicros":   time.Now().UnixMicro(),
+	})
+}
+
+// download returns the chunk of the file at path asked for with the given
+// Content-Range, or the first chunk if there is none.
+func (s *Server) download(path, contentRange string, _ []byte) response {
+	data, ok := s.files[path]
+	if !ok {
+		return errorResponse(http.StatusNotFound, "File "+path+" not found")
+	}
+	start, end := int64(0), int64(maxChunkSize-1)
+	if contentRange != "" {
+		var total int64
+		if _, err := fmt.Sscanf(contentRange, "%d-%d/%d", &start, &end, &total); err != nil || end < start {
+			return errorResponse(http.StatusBadRequest, "Invalid Content-Range header: "+contentRange)
+		}
+	}
+	size := int64(len(data))
+	switch {
+	case end-start+1 > maxChunkSize:
+		return errorResponse(http.StatusBadRequest, fmt.Sprintf("Chunk size %d exceeds the maximum of %d bytes", end-start+1, maxChunkSize))
+	case start > size || start == size && size != 0:
+		return errorResponse(http.StatusRequestedRangeNotSatisfiable, fmt.Sprintf("Range %d-%d is beyond the %d bytes of %s", start, end, size, path))
+	case end >= size:
+		end = size - 1
+	}
+	chunk := data[start : end+1]
+	if size == 0 {
+		end = 0
+	}
+	return response{
+		status: http.StatusOK,
+		header: http.Header{
+			"Content-Type":  {"application/octet-stream"},
+			"Content-Range": {fmt.Sprintf("%d-%d/%d", start, end, size)},
+		},
+		body: append([]byte(nil), chunk...),
+	}
+}
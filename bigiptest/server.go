@@ -0,0 +1,455 @@
+// Package bigiptest provides a fake BIG-IP serving the iControl REST API from
+// memory, so that code using the bigip packages can be tested without a device:
+//
+//	s := bigiptest.NewServer()
+//	defer s.Close()
+//
+//	b, err := bigip.NewSession(s.URL, s.Username, s.Password)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	err = ltm.New(b).Pool().Create(ltm.Pool{Name: "p1"})
+//
+// The server keeps the objects created through it in collections under
+// /mgmt/tm and addresses them by name or full path, e.g. ~Common~p1, answers
+// with the errors a device answers with, such as 404 for a missing object and
+// 409 for a duplicate one, and emulates subcollections like the members of a
+// pool, statistics, token authentication, transactions, asynchronous tasks and
+// file transfers.
+// Collections are created on first use, so that any endpoint can be exercised.
+package bigiptest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultUsername and DefaultPassword are the credentials a new Server accepts.
+	DefaultUsername = "admin"
+	DefaultPassword = "admin"
+
+	// DefaultVersion is the TMOS version a new Server reports.
+	DefaultVersion = "16.1.0"
+
+	// DefaultTokenTimeout is the lifetime in seconds of the tokens issued by a Server.
+	DefaultTokenTimeout = 1200
+
+	// maxTokenTimeout is the longest lifetime in seconds a token can be given.
+	maxTokenTimeout = 36000
+
+	authTokenHeader      = "X-F5-Auth-Token"
+	coordinationIDHeader = "X-F5-REST-Coordination-Id"
+	linkHost             = "https://localhost"
+)
+
+// Server is a fake BIG-IP. Username, Password and Version may be changed before
+// the first request is made.
+type Server struct {
+	*httptest.Server
+
+	Username string
+	Password string
+	Version  string
+
+	mu           sync.Mutex
+	state        state
+	tokens       map[string]*token
+	transactions map[int64]*transaction
+	lastTransID  int64
+	tasks        map[string]*task
+	lastTaskID   int64
+	failures     []failure
+	requests     []string
+	handlers     map[string]http.Handler
+	files        map[string][]byte
+}
+
+// state holds the configuration of the device, which transactions roll back on failure.
+type state struct {
+	// Collections holds the objects of every collection by path, e.g. ltm/pool or
+	// ltm/pool/~Common~p1/members.
+	Collections map[string][]object
+	// Singletons holds objects that are not part of a collection, such as
+	// sys/global-settings, by path.
+	Singletons map[string]object
+	// Stats holds the statistics of objects by path, e.g. ltm/pool/~Common~p1.
+	Stats map[string]map[string]any
+}
+
+type object = map[string]any
+
+type failure struct {
+	status  int
+	message string
+}
+
+// NewServer starts and returns a new Server, which must be closed when done.
+func NewServer() *Server {
+	s := &Server{
+		Username: DefaultUsername,
+		Password: DefaultPassword,
+		Version:  DefaultVersion,
+		state: state{
+			Collections: make(map[string][]object),
+			Singletons:  make(map[string]object),
+			Stats:       make(map[string]map[string]any),
+		},
+		tokens:       make(map[string]*token),
+		transactions: make(map[int64]*transaction),
+		tasks:        make(map[string]*task),
+		handlers:     make(map[string]http.Handler),
+		files:        make(map[string][]byte),
+	}
+	// Every device has these.
+	s.Add("auth/partition", map[string]any{"name": "Common", "description": "Repository for system objects and shared objects.", "defaultRouteDomain": 0})
+	s.Add("auth/user", map[string]any{"name": "admin", "description": "Admin User", "shell": "none",
+		"partitionAccess": []any{map[string]any{"name": "all-partitions", "role": "admin"}}})
+	s.Server = httptest.NewTLSServer(s)
+	return s
+}
+
+// HandleFunc makes the server answer authenticated requests to path, e.g.
+// /mgmt/tm/util/bash, with handler, for endpoints the server does not emulate.
+func (s *Server) HandleFunc(path string, handler func(w http.ResponseWriter, r *http.Request)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[path] = http.HandlerFunc(handler)
+}
+
+// Add adds objects to the collection at path, which is relative to /mgmt/tm,
+// e.g. ltm/pool or ltm/pool/~Common~p1/members, replacing those of the same name.
+func (s *Server) Add(path string, objects ...map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.resolve(path)
+	if err != nil || t.name != "" {
+		panic(fmt.Sprintf("bigiptest: %s is not a collection", path))
+	}
+	for _, obj := range objects {
+		obj = s.newObject(t, copyObject(obj))
+		if i := s.find(t.collection, objectID(obj)); i >= 0 {
+			s.state.Collections[t.collection][i] = obj
+			continue
+		}
+		s.state.Collections[t.collection] = append(s.state.Collections[t.collection], obj)
+	}
+}
+
+// SetObject sets the object at path that is not part of a collection, such as
+// sys/global-settings.
+func (s *Server) SetObject(path string, obj map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state.Singletons[strings.Trim(path, "/")] = copyObject(obj)
+}
+
+// Object returns a copy of the object at path, e.g. ltm/pool/~Common~p1 or
+// sys/global-settings, and whether it exists.
+func (s *Server) Object(path string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.resolve(path)
+	if err != nil {
+		return nil, false
+	}
+	if t.name == "" {
+		obj, ok := s.state.Singletons[t.collection]
+		return copyObject(obj), ok
+	}
+	i := s.find(t.collection, t.name)
+	if i < 0 {
+		return nil, false
+	}
+	return copyObject(s.state.Collections[t.collection][i]), true
+}
+
+// SetStats sets the statistics of the object at path, e.g. ltm/pool/~Common~p1.
+// Numbers are reported as counters and strings as descriptions.
+func (s *Server) SetStats(path string, stats map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state.Stats[strings.Trim(path, "/")] = copyObject(stats)
+}
+
+// FailNext makes the server answer the next request with an error of the given
+// status and message instead of handling it. Calls queue up.
+func (s *Server) FailNext(status int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure{status: status, message: message})
+}
+
+// Requests returns the requests the server received, as method and request URI,
+// e.g. "GET /mgmt/tm/ltm/pool?$top=10".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// ServeHTTP handles a request to the fake device.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeResponse(w, errorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+	handler, res := s.handle(r, body)
+	if handler != nil {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		handler.ServeHTTP(w, r)
+		return
+	}
+	writeResponse(w, res)
+}
+
+// handle returns the response to r, or the handler registered for its path.
+func (s *Server) handle(r *http.Request, body []byte) (http.Handler, response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.RequestURI())
+	if len(s.failures) != 0 {
+		f := s.failures[0]
+		s.failures = s.failures[1:]
+		return nil, errorResponse(f.status, f.message)
+	}
+
+	p := r.URL.Path
+	switch {
+	case p == "/mgmt/shared/authn/login" && r.Method == http.MethodPost:
+		return nil, s.login(body)
+	case !s.authorized(r):
+		return nil, errorResponse(http.StatusUnauthorized, "Authorization failed: no user authentication header or token detected. Uri:"+p)
+	case s.handlers[p] != nil:
+		return s.handlers[p], response{}
+	case p == "/mgmt/shared/authz/tokens" || strings.HasPrefix(p, "/mgmt/shared/authz/tokens/"):
+		return nil, s.serveTokens(r.Method, strings.TrimPrefix(p, "/mgmt/shared/authz/tokens"), body)
+	case p == "/mgmt/tm/transaction" || strings.HasPrefix(p, "/mgmt/tm/transaction/"):
+		return nil, s.serveTransaction(r.Method, strings.TrimPrefix(p, "/mgmt/tm/transaction"), body)
+	case strings.HasPrefix(p, "/mgmt/tm/task/"):
+		return nil, s.serveTask(r.Method, strings.TrimPrefix(p, "/mgmt/tm/task"), body)
+	case r.Header.Get(coordinationIDHeader) != "":
+		return nil, s.queue(r.Header.Get(coordinationIDHeader), r.Method, r.URL.RequestURI(), body)
+	case isFileTransfer(p):
+		return nil, s.serveFileTransfer(r.Method, p, r.Header, body)
+	case strings.HasPrefix(p, "/mgmt/tm/"):
+		return nil, s.serveTM(r.Method, strings.TrimPrefix(p, "/mgmt/tm/"), r.URL.Query(), body)
+	}
+	return nil, errorResponse(http.StatusNotFound, "Public URI path not registered: "+p)
+}
+
+// response is the status and body of an answer of the server.
+type response struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func writeResponse(w http.ResponseWriter, res response) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	for key, values := range res.header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(res.status)
+	w.Write(res.body)
+}
+
+func jsonResponse(status int, v any) response {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errorResponse(http.StatusInternalServerError, err.Error())
+	}
+	return response{status: status, body: data}
+}
+
+// errorResponse returns an error in the format of iControl REST.
+func errorResponse(status int, message string) response {
+	return jsonResponse(status, object{"code": status, "message": message, "errorStack": []any{}, "apiError": 3})
+}
+
+// decodeObject decodes a request body, keeping numbers as they were sent.
+func decodeObject(data []byte) (object, error) {
+	obj := object{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return obj, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("Found invalid JSON body in the request: %v", err)
+	}
+	return obj, nil
+}
+
+// copyObject returns a deep copy of obj.
+func copyObject(obj object) object {
+	if obj == nil {
+		return nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(fmt.Sprintf("bigiptest: %v", err))
+	}
+	c, err := decodeObject(data)
+	if err != nil {
+		panic(fmt.Sprintf("bigiptest: %v", err))
+	}
+	return c
+}
+
+// token is a token issued by the server.
+type token struct {
+	Token            string `json:"token"`
+	Name             string `json:"name"`
+	UserName         string `json:"userName"`
+	AuthProviderName string `json:"authProviderName"`
+	Timeout          int64  `json:"timeout"`
+	StartTime        string `json:"startTime"`
+	Generation       int64  `json:"generation"`
+	LastUpdateMicros int64  `json:"lastUpdateMicros"`
+	ExpirationMicros int64  `json:"expirationMicros"`
+	Kind             string `json:"kind"`
+	SelfLink         string `json:"selfLink"`
+}
+
+func (t *token) setTimeout(timeout int64) {
+	now := time.Now()
+	t.Timeout = timeout
+	t.Generation++
+	t.LastUpdateMicros = now.UnixMicro()
+	t.ExpirationMicros = now.Add(time.Duration(timeout) * time.Second).UnixMicro()
+}
+
+func (s *Server) login(body []byte) response {
+	var login struct {
+		Username          string `json:"username"`
+		Password          string `json:"password"`
+		LoginProviderName string `json:"loginProviderName"`
+	}
+	if err := json.Unmarshal(body, &login); err != nil {
+		return errorResponse(http.StatusBadRequest, err.Error())
+	}
+	if login.Username != s.Username || login.Password != s.Password {
+		return errorResponse(http.StatusUnauthorized, "Authentication failed.")
+	}
+	if login.LoginProviderName == "" {
+		login.LoginProviderName = "tmos"
+	}
+
+	b := make([]byte, 13)
+	rand.Read(b)
+	t := &token{
+		Token:            strings.ToUpper(hex.EncodeToString(b)),
+		UserName:         login.Username,
+		AuthProviderName: login.LoginProviderName,
+		StartTime:        time.Now().Format(time.RFC3339),
+		Kind:             "shared:authz:tokens:authtokenitemstate",
+	}
+	t.Name = t.Token
+	t.SelfLink = linkHost + "/mgmt/shared/authz/tokens/" + t.Token
+	t.setTimeout(DefaultTokenTimeout)
+	s.tokens[t.Token] = t
+
+	return jsonResponse(http.StatusOK, object{
+		"username":          login.Username,
+		"loginProviderName": login.LoginProviderName,
+		"token":             t,
+		"generation":        0,
+		"lastUpdateMicros":  0,
+	})
+}
+
+// authorized reports whether r carries the credentials of the server or a valid token.
+func (s *Server) authorized(r *http.Request) bool {
+	if username, password, ok := r.BasicAuth(); ok {
+		return username == s.Username && password == s.Password
+	}
+	t, ok := s.tokens[r.Header.Get(authTokenHeader)]
+	if !ok {
+		return false
+	}
+	if time.Now().UnixMicro() > t.ExpirationMicros {
+		delete(s.tokens, t.Token)
+		return false
+	}
+	return true
+}
+
+func (s *Server) serveTokens(method, p string, body []byte) response {
+	name := strings.Trim(p, "/")
+	if name == "" {
+		if method != http.MethodGet {
+			return errorResponse(http.StatusMethodNotAllowed, "Method not allowed: "+method)
+		}
+		items := make([]*token, 0, len(s.tokens))
+		for _, t := range s.tokens {
+			items = append(items, t)
+		}
+		sort.Slice(items, func(i, j int) bool { return items[i].Token < items[j].Token })
+		return jsonResponse(http.StatusOK, object{"items": items, "kind": "shared:authz:tokens:authtokencollectionstate",
+			"selfLink": linkHost + "/mgmt/shared/authz/tokens"})
+	}
+
+	t, ok := s.tokens[name]
+	if !ok {
+		return errorResponse(http.StatusNotFound, "Object with ID "+name+" not found")
+	}
+	switch method {
+	case http.MethodGet:
+	case http.MethodPatch:
+		var patch struct {
+			Timeout int64 `json:"timeout"`
+		}
+		if err := json.Unmarshal(body, &patch); err != nil {
+			return errorResponse(http.StatusBadRequest, err.Error())
+		}
+		if patch.Timeout <= 0 || patch.Timeout > maxTokenTimeout {
+			return errorResponse(http.StatusBadRequest, fmt.Sprintf("Invalid timeout value. Timeout should be between 0 and %d seconds.", maxTokenTimeout))
+		}
+		t.setTimeout(patch.Timeout)
+	case http.MethodDelete:
+		delete(s.tokens, name)
+	default:
+		return errorResponse(http.StatusMethodNotAllowed, "Method not allowed: "+method)
+	}
+	return jsonResponse(http.StatusOK, t)
+}
+
+// versionObject returns the answer to GET /mgmt/tm/sys/version.
+func (s *Server) versionObject() object {
+	return object{
+		"kind":     "tm:sys:version:versionstats",
+		"selfLink": linkHost + "/mgmt/tm/sys/version?ver=" + s.Version,
+		"entries": object{
+			linkHost + "/mgmt/tm/sys/version/0": object{
+				"nestedStats": object{
+					"entries": object{
+						"Build":   object{"description": "0.0.1"},
+						"Date":    object{"description": "Mon Jan  1 00:00:00 PST 2024"},
+						"Edition": object{"description": "Final"},
+						"Product": object{"description": "BIG-IP"},
+						"Title":   object{"description": "Main Package"},
+						"Version": object{"description": s.Version},
+					},
+				},
+			},
+		},
+	}
+}
+
+// link returns the link of the object or collection at p, which is relative to /mgmt/tm.
+func (s *Server) link(p string) string {
+	return linkHost + "/mgmt/tm/" + p + "?ver=" + url.QueryEscape(s.Version)
+}
@@ -0,0 +1,192 @@
+package bigiptest_test
+
+import (
+	"context"
+	"errors"
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/ltm"
+	"github.com/lefeck/go-bigip/rest"
+	"testing"
+	"time"
+)
+
+func newSession(t *testing.T) (*bigiptest.Server, *bigip.BigIP) {
+	t.Helper()
+	s := bigiptest.NewServer()
+	t.Cleanup(s.Close)
+	b, err := bigip.New(s.URL, bigip.WithBasicAuth(s.Username, s.Password))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return s, b
+}
+
+func TestServerCollections(t *testing.T) {
+	s, b := newSession(t)
+	pools := ltm.New(b).Pool()
+
+	if err := pools.Create(ltm.Pool{Name: "p1", Members: []string{"10.0.0.1:80", "10.0.0.2:80"}}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	err := pools.Create(ltm.Pool{Name: "p1"})
+	if !rest.IsConflict(err) {
+		t.Errorf("expected a conflict, got %v", err)
+	}
+
+	pool, err := pools.Get("/Common/p1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if pool.FullPath != "/Common/p1" || pool.Kind != "tm:ltm:pool:poolstate" || !pool.MembersReference.IsSubcollection {
+		t.Errorf("unexpected pool %+v", pool)
+	}
+
+	members, err := ltm.New(b).PoolMembers().List("/Common/p1")
+	if err != nil {
+		t.Fatalf("List members: %v", err)
+	}
+	if len(members.Items) != 2 || members.Items[1].FullPath != "/Common/10.0.0.2:80" {
+		t.Errorf("unexpected members %+v", members.Items)
+	}
+
+	if err := pools.Patch("p1", ltm.Pool{MinActiveMembers: bigip.Some[int64](0), Description: bigip.Some("web")}); err != nil {
+		t.Fatalf("Patch: %v", err)
+	}
+	obj, ok := s.Object("ltm/pool/~Common~p1")
+	if !ok || obj["description"] != "web" || obj["minActiveMembers"] == nil {
+		t.Errorf("unexpected object %v", obj)
+	}
+
+	s.SetStats("ltm/pool/~Common~p1", map[string]any{"serverside.curConns": 3, "status.availabilityState": "available"})
+	stats, err := pools.Stats("/Common/p1")
+	if err != nil {
+		t.Fatalf("Stats: %v", err)
+	}
+	for _, entry := range stats.Entries {
+		if entry.NestedStats.Entries["serverside.curConns"].Value != 3 ||
+			entry.NestedStats.Entries["status.availabilityState"].Description != "available" {
+			t.Errorf("unexpected stats %+v", entry)
+		}
+	}
+
+	if err := pools.Delete("/Common/p1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := pools.Get("/Common/p1"); !rest.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if _, err := ltm.New(b).PoolMembers().List("/Common/p1"); !rest.IsNotFound(err) {
+		t.Errorf("expected the members to be deleted, got %v", err)
+	}
+}
+
+func TestServerPaging(t *testing.T) {
+	s, b := newSession(t)
+	for i := 0; i < 7; i++ {
+		s.Add("ltm/virtual", map[string]any{"name": "vs" + string(rune('0'+i)), "partition": []string{"Common", "tenant"}[i%2]})
+	}
+	virtuals := ltm.New(b).Virtual()
+
+	it := virtuals.Iterate(context.Background(), bigip.ListOptions{Top: 3})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if it.Err() != nil || count != 7 {
+		t.Errorf("expected 7 virtual servers, got %d, %v", count, it.Err())
+	}
+
+	list, err := virtuals.List(bigip.ListOptions{Filter: bigip.PartitionFilter("tenant"), Select: []string{"name", "fullPath"}})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list.Items) != 3 || list.Items[0].FullPath != "/tenant/vs1" || list.Items[0].Kind != "" {
+		t.Errorf("unexpected items %+v", list.Items)
+	}
+}
+
+func TestServerAuthentication(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+
+	if _, err := bigip.New(s.URL, bigip.WithBasicAuth("admin", "wrong")); !errors.Is(err, bigip.ErrBadCredentials) {
+		t.Errorf("expected bad credentials, got %v", err)
+	}
+	b, err := bigip.NewToken(s.URL, s.Username, s.Password, "tmos")
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+	if _, err := ltm.New(b).Pool().List(); err != nil {
+		t.Errorf("List: %v", err)
+	}
+	tokens, err := b.Tokens().List()
+	if err != nil || len(tokens.Items) != 1 {
+		t.Fatalf("unexpected tokens %+v, %v", tokens, err)
+	}
+	token, err := b.Tokens().Extend(tokens.Items[0].Token, time.Hour)
+	if err != nil || token.Timeout != 3600 {
+		t.Fatalf("Extend: %+v, %v", token, err)
+	}
+	if err := b.Logout(context.Background()); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+
+	admin, err := bigip.NewSession(s.URL, s.Username, s.Password)
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	if tokens, err := admin.Tokens().List(); err != nil || len(tokens.Items) != 0 {
+		t.Errorf("expected the token to be revoked, got %+v, %v", tokens, err)
+	}
+}
+
+func TestServerTransaction(t *testing.T) {
+	s, b := newSession(t)
+
+	_, err := b.RunTransaction(context.Background(), func(tx *bigip.BigIP) error {
+		l := ltm.New(tx)
+		if err := l.Pool().Create(ltm.Pool{Name: "p1"}); err != nil {
+			return err
+		}
+		return l.Pool().Create(ltm.Pool{Name: "p1"})
+	})
+	if err == nil {
+		t.Fatal("expected the transaction to fail")
+	}
+	if _, ok := s.Object("ltm/pool/~Common~p1"); ok {
+		t.Error("expected the failed transaction to be rolled back")
+	}
+
+	state, err := b.RunTransaction(context.Background(), func(tx *bigip.BigIP) error {
+		l := ltm.New(tx)
+		if err := l.Pool().Create(ltm.Pool{Name: "p1"}); err != nil {
+			return err
+		}
+		return l.Virtual().Create(ltm.VirtualServer{Name: "vs1", Pool: "/Common/p1"})
+	})
+	if err != nil {
+		t.Fatalf("RunTransaction: %v", err)
+	}
+	if state.State != bigip.TransactionCompleted {
+		t.Errorf("unexpected state %+v", state)
+	}
+	if _, ok := s.Object("ltm/virtual/~Common~vs1"); !ok {
+		t.Error("expected the virtual server to be created")
+	}
+}
+
+func TestServerFailNext(t *testing.T) {
+	s, b := newSession(t)
+	s.FailNext(503, "device busy")
+	if _, err := ltm.New(b).Pool().List(); !rest.IsBusy(err) {
+		t.Errorf("expected a busy error, got %v", err)
+	}
+	if _, err := ltm.New(b).Pool().List(); err != nil {
+		t.Errorf("List: %v", err)
+	}
+	requests := s.Requests()
+	if len(requests) != 3 || requests[2] != "GET /mgmt/tm/ltm/pool" {
+		t.Errorf("unexpected requests %v", requests)
+	}
+}
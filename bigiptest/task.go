@@ -0,0 +1,86 @@
+package bigiptest
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Task states, see bigip.TaskState.
+const (
+	taskCreated   = "CREATED"
+	taskStarted   = "STARTED"
+	taskCompleted = "COMPLETED"
+)
+
+// task is a command submitted under /mgmt/tm/task. It is not run, but reports
+// STARTED at the first poll of its result once validated and COMPLETED afterwards,
+// so that clients exercise their polling.
+type task struct {
+	attrs object
+	polls int
+}
+
+// serveTask handles a request to /mgmt/tm/task followed by p, e.g. /sys/config or
+// /sys/config/1/result.
+func (s *Server) serveTask(method, p string, body []byte) response {
+	segs := strings.Split(strings.Trim(p, "/"), "/")
+	if len(segs) < 2 {
+		return errorResponse(http.StatusNotFound, "Public URI path not registered: /mgmt/tm/task"+p)
+	}
+	if method == http.MethodPost {
+		obj, err := decodeObject(body)
+		if err != nil {
+			return errorResponse(http.StatusBadRequest, err.Error())
+		}
+		s.lastTaskID++
+		id := strconv.FormatInt(s.lastTaskID, 10)
+		endpoint := strings.Join(segs, "/")
+		obj["_taskId"] = s.lastTaskID
+		obj["_taskState"] = taskCreated
+		obj["kind"] = "tm:task:" + strings.ReplaceAll(endpoint, "/", ":") + ":" + segs[len(segs)-1] + "state"
+		obj["selfLink"] = s.link("task/" + endpoint + "/" + id)
+		s.tasks[path.Join(endpoint, id)] = &task{attrs: obj}
+		return jsonResponse(http.StatusOK, obj)
+	}
+
+	result := segs[len(segs)-1] == "result"
+	if result {
+		segs = segs[:len(segs)-1]
+	}
+	key := strings.Join(segs, "/")
+	t, ok := s.tasks[key]
+	if !ok {
+		return errorResponse(http.StatusNotFound, "Task not found: "+key)
+	}
+	switch {
+	case result && method == http.MethodGet:
+		if t.attrs["_taskState"] == taskStarted {
+			if t.polls++; t.polls > 1 {
+				t.attrs["_taskState"] = taskCompleted
+			}
+		}
+		return jsonResponse(http.StatusOK, t.attrs)
+	case result:
+	case method == http.MethodGet:
+		return jsonResponse(http.StatusOK, t.attrs)
+	case method == http.MethodDelete:
+		delete(s.tasks, key)
+		return response{status: http.StatusOK}
+	case method == http.MethodPut || method == http.MethodPatch:
+		var update struct {
+			State string `json:"_taskState"`
+		}
+		if err := json.Unmarshal(body, &update); err != nil {
+			return errorResponse(http.StatusBadRequest, err.Error())
+		}
+		if update.State != "VALIDATING" || t.attrs["_taskState"] != taskCreated {
+			return errorResponse(http.StatusBadRequest, "Invalid task state transition to "+update.State)
+		}
+		t.attrs["_taskState"] = taskStarted
+		return jsonResponse(http.StatusOK, t.attrs)
+	}
+	return errorResponse(http.StatusMethodNotAllowed, "Method not allowed: "+method)
+}
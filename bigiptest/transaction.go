@@ -0,0 +1,183 @@
+package bigiptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Transaction states, see bigip.TransactionState.
+const (
+	transactionStarted   = "STARTED"
+	transactionCompleted = "COMPLETED"
+	transactionFailed    = "FAILED"
+)
+
+type transaction struct {
+	TransID        int64  `json:"transId"`
+	State          string `json:"state"`
+	TimeoutSeconds int    `json:"timeoutSeconds"`
+	ValidateOnly   bool   `json:"validateOnly"`
+	FailureReason  string `json:"failureReason,omitempty"`
+	Kind           string `json:"kind"`
+	SelfLink       string `json:"selfLink"`
+
+	commands    []*command
+	lastCommand int64
+}
+
+type command struct {
+	CommandID int64           `json:"commandId"`
+	EvalOrder int             `json:"evalOrder"`
+	Method    string          `json:"method"`
+	URI       string          `json:"uri"`
+	Body      json.RawMessage `json:"body,omitempty"`
+	Kind      string          `json:"kind"`
+	SelfLink  string          `json:"selfLink"`
+}
+
+// serveTransaction handles a request to /mgmt/tm/transaction followed by p.
+func (s *Server) serveTransaction(method, p string, body []byte) response {
+	segs := strings.Split(strings.Trim(p, "/"), "/")
+	if segs[0] == "" {
+		switch method {
+		case http.MethodGet:
+			items := make([]*transaction, 0, len(s.transactions))
+			for _, tx := range s.transactions {
+				items = append(items, tx)
+			}
+			sort.Slice(items, func(i, j int) bool { return items[i].TransID < items[j].TransID })
+			return jsonResponse(http.StatusOK, object{"items": items, "kind": "tm:transactioncollectionstate", "selfLink": s.link("transaction")})
+		case http.MethodPost:
+			s.lastTransID++
+			tx := &transaction{TransID: s.lastTransID, State: transactionStarted, TimeoutSeconds: 120, Kind: "tm:transactionstate"}
+			tx.SelfLink = s.link("transaction/" + strconv.FormatInt(tx.TransID, 10))
+			s.transactions[tx.TransID] = tx
+			return jsonResponse(http.StatusOK, tx)
+		}
+		return errorResponse(http.StatusMethodNotAllowed, "Method not allowed: "+method)
+	}
+
+	tx, res, ok := s.transaction(segs[0])
+	if !ok {
+		return res
+	}
+	switch {
+	case len(segs) == 1 && method == http.MethodGet:
+		return jsonResponse(http.StatusOK, tx)
+	case len(segs) == 1 && method == http.MethodDelete:
+		delete(s.transactions, tx.TransID)
+		return response{status: http.StatusOK}
+	case len(segs) == 1 && method == http.MethodPatch:
+		return s.commit(tx, body)
+	case len(segs) == 2 && segs[1] == "commands" && method == http.MethodGet:
+		return jsonResponse(http.StatusOK, object{"items": tx.commands, "kind": "tm:transaction:commandscollectionstate",
+			"selfLink": s.link(fmt.Sprintf("transaction/%d/commands", tx.TransID))})
+	case len(segs) == 3 && segs[1] == "commands":
+		for i, c := range tx.commands {
+			if strconv.FormatInt(c.CommandID, 10) != segs[2] {
+				continue
+			}
+			switch method {
+			case http.MethodGet:
+				return jsonResponse(http.StatusOK, c)
+			case http.MethodDelete:
+				tx.commands = append(tx.commands[:i:i], tx.commands[i+1:]...)
+				for j, c := range tx.commands {
+					c.EvalOrder = j + 1
+				}
+				return response{status: http.StatusOK}
+			}
+			return errorResponse(http.StatusMethodNotAllowed, "Method not allowed: "+method)
+		}
+		return errorResponse(http.StatusNotFound, fmt.Sprintf("Command %s not found in transaction %d", segs[2], tx.TransID))
+	}
+	return errorResponse(http.StatusNotFound, "Public URI path not registered: /mgmt/tm/transaction"+p)
+}
+
+// transaction returns the transaction identified by id, or the response to a
+// request addressed to a transaction that does not exist.
+func (s *Server) transaction(id string) (*transaction, response, bool) {
+	transID, err := strconv.ParseInt(id, 10, 64)
+	if tx, ok := s.transactions[transID]; err == nil && ok {
+		return tx, response{}, true
+	}
+	return nil, errorResponse(http.StatusNotFound, "Transaction with ID "+id+" not found"), false
+}
+
+// queue adds a request carrying the coordination ID header to its transaction.
+func (s *Server) queue(id, method, uri string, body []byte) response {
+	tx, res, ok := s.transaction(id)
+	if !ok {
+		return res
+	}
+	if tx.State != transactionStarted {
+		return errorResponse(http.StatusBadRequest, fmt.Sprintf("Transaction %d is in state %s and cannot take more commands", tx.TransID, tx.State))
+	}
+	tx.lastCommand++
+	c := &command{
+		CommandID: tx.lastCommand,
+		EvalOrder: len(tx.commands) + 1,
+		Method:    method,
+		URI:       linkHost + uri,
+		Kind:      "tm:transaction:commandsstate",
+		SelfLink:  s.link(fmt.Sprintf("transaction/%d/commands/%d", tx.TransID, tx.lastCommand)),
+	}
+	if len(body) != 0 {
+		c.Body = append(json.RawMessage(nil), body...)
+	}
+	tx.commands = append(tx.commands, c)
+	return jsonResponse(http.StatusOK, c)
+}
+
+// commit runs the commands of tx when it is set to VALIDATING, undoing them all
+// if one fails or only validation is asked for.
+func (s *Server) commit(tx *transaction, body []byte) response {
+	var patch struct {
+		State        string `json:"state"`
+		ValidateOnly bool   `json:"validateOnly"`
+	}
+	if err := json.Unmarshal(body, &patch); err != nil {
+		return errorResponse(http.StatusBadRequest, err.Error())
+	}
+	if patch.State != "VALIDATING" {
+		return errorResponse(http.StatusBadRequest, "Invalid transaction state: "+patch.State)
+	}
+	if tx.State != transactionStarted {
+		return errorResponse(http.StatusBadRequest, fmt.Sprintf("Transaction %d is in state %s and cannot be committed", tx.TransID, tx.State))
+	}
+
+	saved := s.snapshot()
+	for _, c := range tx.commands {
+		u, err := url.Parse(c.URI)
+		if err != nil {
+			s.state = saved
+			return errorResponse(http.StatusBadRequest, "transaction failed:"+err.Error())
+		}
+		res := s.serveTM(c.Method, strings.TrimPrefix(u.Path, "/mgmt/tm/"), u.Query(), c.Body)
+		if res.status >= http.StatusBadRequest {
+			s.state = saved
+			var apiErr struct {
+				Message string `json:"message"`
+			}
+			json.Unmarshal(res.body, &apiErr)
+			if !patch.ValidateOnly {
+				tx.State = transactionFailed
+				tx.FailureReason = apiErr.Message
+			}
+			return errorResponse(http.StatusBadRequest, "transaction failed:"+apiErr.Message)
+		}
+	}
+
+	tx.ValidateOnly = patch.ValidateOnly
+	if patch.ValidateOnly {
+		s.state = saved
+	} else {
+		tx.State = transactionCompleted
+	}
+	return jsonResponse(http.StatusOK, tx)
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/lefeck/go-bigip"
 )
 
@@ -39,15 +40,20 @@ const VersionEndpoint = "version"
 
 // Show bigip device version
 func (vsr *VersionStatsResoure) Show() (*VersionStats, error) {
+	return vsr.ShowContext(context.Background())
+}
+
+// ShowContext is like Show but uses ctx for the request.
+func (vsr *VersionStatsResoure) ShowContext(ctx context.Context) (*VersionStats, error) {
 	var vs *VersionStats
 	res, err := vsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(CliManager).
-		Resource(VersionEndpoint).DoRaw(context.Background())
+		Resource(VersionEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if err := json.Unmarshal(res, &vs); err != nil {
-		panic(err)
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
 	}
 
 	return vs, nil
@@ -2,11 +2,29 @@ package cli
 
 import (
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
 	"testing"
 )
 
 func TestVersionStatsResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	s := bigiptest.NewServer()
+	defer s.Close()
+	s.SetObject("cli/version", map[string]any{
+		"kind": "tm:cli:version:versionstats",
+		"entries": map[string]any{
+			"https://localhost/mgmt/tm/cli/version/0": map[string]any{
+				"nestedStats": map[string]any{
+					"entries": map[string]any{
+						"active":    map[string]any{"description": "13.0"},
+						"latest":    map[string]any{"description": "13.0"},
+						"supported": map[string]any{"description": "12.1 13.0"},
+					},
+				},
+			},
+		},
+	})
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
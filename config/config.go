@@ -0,0 +1,360 @@
+// Package config loads connection profiles for BIG-IP devices from a file in the
+// style of a kubeconfig, so that tools do not each parse hosts and credentials
+// from their own flags. The file is written in JSON and holds named contexts, one
+// of which is current:
+//
+//	{
+//	  "current-context": "lab",
+//	  "contexts": [
+//	    {
+//	      "name": "lab",
+//	      "host": "10.0.0.1",
+//	      "port": 8443,
+//	      "auth": "token",
+//	      "login-provider": "tmos",
+//	      "partition": "Tenant",
+//	      "tls": {"ca-file": "/etc/bigip/ca.pem"},
+//	      "credentials": {"username": "admin", "password-env": "LAB_PASSWORD"}
+//	    }
+//	  ]
+//	}
+//
+// YAML is not supported, as the package has no dependencies beyond those of the
+// module. Unknown fields are rejected. The settings of a context are overridden
+// by the BIGIP_* environment variables, see ApplyEnv. The quickest way to a
+// session is NewSession:
+//
+//	b, err := config.NewSession("lab")
+package config
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/transport"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Environment variables read by the package.
+const (
+	// EnvConfig is the path of the config file, instead of DefaultPath.
+	EnvConfig = "BIGIP_CONFIG"
+	// EnvContext is the name of the context used instead of the current one.
+	EnvContext = "BIGIP_CONTEXT"
+
+	// The following override the settings of a context of the same name.
+	EnvHost          = "BIGIP_HOST"
+	EnvPort          = "BIGIP_PORT"
+	EnvAuth          = "BIGIP_AUTH"
+	EnvLoginProvider = "BIGIP_LOGIN_PROVIDER"
+	EnvPartition     = "BIGIP_PARTITION"
+	EnvUsername      = "BIGIP_USERNAME"
+	EnvPassword      = "BIGIP_PASSWORD"
+	EnvInsecure      = "BIGIP_INSECURE"
+	EnvCAFile        = "BIGIP_CA_FILE"
+	EnvServerName    = "BIGIP_SERVER_NAME"
+)
+
+// Authentication methods of a context.
+const (
+	AuthBasic = "basic"
+	AuthToken = "token"
+)
+
+// DefaultLoginProvider is the login provider of local users, used for token
+// authentication when a context names none.
+const DefaultLoginProvider = "tmos"
+
+// Config is the content of a config file.
+type Config struct {
+	// CurrentContext is the name of the context used when none is asked for.
+	CurrentContext string    `json:"current-context,omitempty"`
+	Contexts       []Context `json:"contexts,omitempty"`
+}
+
+// Context describes how to connect to a device.
+type Context struct {
+	Name string `json:"name"`
+	// Host is the address of the device, optionally with a scheme and port.
+	Host string `json:"host,omitempty"`
+	// Port is the port of the REST API, when it is not the one of Host or 443.
+	Port int `json:"port,omitempty"`
+	// Auth is AuthBasic, the default, or AuthToken.
+	Auth string `json:"auth,omitempty"`
+	// LoginProvider is the provider token authentication logs in against,
+	// DefaultLoginProvider when empty.
+	LoginProvider string `json:"login-provider,omitempty"`
+	// Partition is the default partition of the session, see bigip.WithPartition.
+	Partition   string      `json:"partition,omitempty"`
+	TLS         TLS         `json:"tls"`
+	Credentials Credentials `json:"credentials"`
+}
+
+// TLS holds the TLS settings of a context. Without any of them the certificate of
+// the device is not verified, as for a session created without TLS options.
+type TLS struct {
+	InsecureSkipVerify bool     `json:"insecure-skip-verify,omitempty"`
+	CAFile             string   `json:"ca-file,omitempty"`
+	ServerName         string   `json:"server-name,omitempty"`
+	Pins               []string `json:"pins,omitempty"`
+	// MinVersion is the minimum TLS version accepted, "1.2" or "1.3".
+	MinVersion        string `json:"min-version,omitempty"`
+	ClientCertificate string `json:"client-certificate,omitempty"`
+	ClientKey         string `json:"client-key,omitempty"`
+}
+
+// Credentials holds the username of a context and where its password comes from:
+// the file itself, an environment variable or a file of its own, in that order.
+type Credentials struct {
+	Username     string `json:"username,omitempty"`
+	Password     string `json:"password,omitempty"`
+	PasswordEnv  string `json:"password-env,omitempty"`
+	PasswordFile string `json:"password-file,omitempty"`
+}
+
+// DefaultPath returns the path of the config file: the value of BIGIP_CONFIG if
+// set, or .bigip/config in the home directory.
+func DefaultPath() string {
+	if p := os.Getenv(EnvConfig); p != "" {
+		return p
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".bigip", "config")
+}
+
+// Load reads the config file at path.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return c, nil
+}
+
+// LoadDefault reads the config file at DefaultPath. A missing file yields an empty
+// config, so that contexts can be given through the environment alone.
+func LoadDefault() (*Config, error) {
+	c, err := Load(DefaultPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return &Config{}, nil
+	}
+	return c, err
+}
+
+// Parse parses a config file, which is written in JSON. Fields the package does
+// not know are rejected rather than ignored.
+func Parse(data []byte) (*Config, error) {
+	var c Config
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&c); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, errors.New("failed to unmarshal JSON data: unexpected data after the config")
+	}
+	return &c, nil
+}
+
+// Context returns the context with the given name with the environment overrides
+// applied. An empty name stands for the value of BIGIP_CONTEXT, or the current
+// context if that is not set either. If the config has no context to use, the
+// context is made of the environment alone.
+func (c *Config) Context(name string) (Context, error) {
+	if name == "" {
+		name = os.Getenv(EnvContext)
+	}
+	if name == "" {
+		name = c.CurrentContext
+	}
+	var ctx Context
+	if name != "" {
+		found := false
+		for _, candidate := range c.Contexts {
+			if candidate.Name == name {
+				ctx, found = candidate, true
+				break
+			}
+		}
+		if !found {
+			return Context{}, fmt.Errorf("config: context %q not found", name)
+		}
+	}
+	if err := ctx.ApplyEnv(); err != nil {
+		return Context{}, err
+	}
+	return ctx, nil
+}
+
+// NewSession creates a session with the device of the context with the given name,
+// see Context. opts are applied after the options of the context.
+func (c *Config) NewSession(name string, opts ...bigip.Option) (*bigip.BigIP, error) {
+	ctx, err := c.Context(name)
+	if err != nil {
+		return nil, err
+	}
+	return ctx.NewSession(opts...)
+}
+
+// NewSession loads the config file at DefaultPath and creates a session with the
+// device of the context with the given name, see Config.Context.
+func NewSession(name string, opts ...bigip.Option) (*bigip.BigIP, error) {
+	c, err := LoadDefault()
+	if err != nil {
+		return nil, err
+	}
+	return c.NewSession(name, opts...)
+}
+
+// ApplyEnv overrides the settings of ctx with the BIGIP_* environment variables
+// that are set. BIGIP_INSECURE takes a boolean such as true or 1.
+func (ctx *Context) ApplyEnv() error {
+	for env, field := range map[string]*string{
+		EnvHost:          &ctx.Host,
+		EnvAuth:          &ctx.Auth,
+		EnvLoginProvider: &ctx.LoginProvider,
+		EnvPartition:     &ctx.Partition,
+		EnvUsername:      &ctx.Credentials.Username,
+		EnvPassword:      &ctx.Credentials.Password,
+		EnvCAFile:        &ctx.TLS.CAFile,
+		EnvServerName:    &ctx.TLS.ServerName,
+	} {
+		if value, ok := os.LookupEnv(env); ok {
+			*field = value
+		}
+	}
+	if value, ok := os.LookupEnv(EnvPort); ok {
+		port, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("config: invalid %s %q", EnvPort, value)
+		}
+		ctx.Port = port
+	}
+	if value, ok := os.LookupEnv(EnvInsecure); ok {
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("config: invalid %s %q", EnvInsecure, value)
+		}
+		ctx.TLS.InsecureSkipVerify = insecure
+	}
+	return nil
+}
+
+// Address returns the address of the device as given to bigip.New, e.g.
+// https://10.0.0.1:8443.
+func (ctx Context) Address() string {
+	host := ctx.Host
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	if ctx.Port == 0 {
+		return host
+	}
+	scheme, hostport, _ := strings.Cut(host, "://")
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		hostport = h
+	}
+	hostport = strings.Trim(hostport, "[]")
+	return scheme + "://" + net.JoinHostPort(hostport, strconv.Itoa(ctx.Port))
+}
+
+// LookupPassword returns the password from the first source that has one.
+func (c Credentials) LookupPassword() (string, error) {
+	switch {
+	case c.Password != "":
+		return c.Password, nil
+	case c.PasswordEnv != "":
+		if password, ok := os.LookupEnv(c.PasswordEnv); ok {
+			return password, nil
+		}
+		return "", fmt.Errorf("config: environment variable %s is not set", c.PasswordEnv)
+	case c.PasswordFile != "":
+		data, err := os.ReadFile(c.PasswordFile)
+		if err != nil {
+			return "", fmt.Errorf("config: reading password: %w", err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+	return "", nil
+}
+
+// Options returns the options creating a session as described by ctx.
+func (ctx Context) Options() ([]bigip.Option, error) {
+	if ctx.Credentials.Username == "" {
+		return nil, fmt.Errorf("config: context %q has no username", ctx.Name)
+	}
+	password, err := ctx.Credentials.LookupPassword()
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []bigip.Option
+	switch ctx.Auth {
+	case "", AuthBasic:
+		opts = append(opts, bigip.WithBasicAuth(ctx.Credentials.Username, password))
+	case AuthToken:
+		provider := ctx.LoginProvider
+		if provider == "" {
+			provider = DefaultLoginProvider
+		}
+		opts = append(opts, bigip.WithTokenAuth(ctx.Credentials.Username, password, provider))
+	default:
+		return nil, fmt.Errorf("config: context %q has unknown auth method %q", ctx.Name, ctx.Auth)
+	}
+
+	if ctx.Partition != "" {
+		opts = append(opts, bigip.WithPartition(ctx.Partition))
+	}
+
+	t := ctx.TLS
+	config := &transport.TLSConfig{
+		Insecure:   t.InsecureSkipVerify,
+		CAFile:     t.CAFile,
+		ServerName: t.ServerName,
+		Pins:       t.Pins,
+		CertFile:   t.ClientCertificate,
+		KeyFile:    t.ClientKey,
+	}
+	switch t.MinVersion {
+	case "":
+	case "1.2":
+		config.MinVersion = tls.VersionTLS12
+	case "1.3":
+		config.MinVersion = tls.VersionTLS13
+	default:
+		return nil, fmt.Errorf("config: context %q has unsupported TLS version %q", ctx.Name, t.MinVersion)
+	}
+	if t.InsecureSkipVerify || t.CAFile != "" || t.ServerName != "" || len(t.Pins) != 0 || t.MinVersion != "" ||
+		t.ClientCertificate != "" || t.ClientKey != "" {
+		opts = append(opts, bigip.WithTLSConfig(config))
+	}
+	return opts, nil
+}
+
+// NewSession creates a session with the device of ctx. opts are applied after the
+// options of ctx.
+func (ctx Context) NewSession(opts ...bigip.Option) (*bigip.BigIP, error) {
+	if ctx.Host == "" {
+		return nil, fmt.Errorf("config: context %q has no host", ctx.Name)
+	}
+	options, err := ctx.Options()
+	if err != nil {
+		return nil, err
+	}
+	return bigip.New(ctx.Address(), append(options, opts...)...)
+}
@@ -0,0 +1,147 @@
+package config
+
+import (
+	"fmt"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testConfig = `{
+  "current-context": "lab",
+  "contexts": [
+    {"name": "lab", "host": "10.0.0.1", "port": 8443, "auth": "token", "login-provider": "tmos", "partition": "Tenant",
+     "tls": {"insecure-skip-verify": true, "pins": ["sha256/AAAA", "ab:cd"], "min-version": "1.2"},
+     "credentials": {"username": "admin", "password": "0123"}},
+    {"name": "prod", "host": "https://bigip.example.com", "tls": {"ca-file": "/etc/bigip/ca.pem"},
+     "credentials": {"username": "o'brien", "password-env": "PROD_PASSWORD"}}
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	c, err := Parse([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Config{
+		CurrentContext: "lab",
+		Contexts: []Context{
+			{
+				Name: "lab", Host: "10.0.0.1", Port: 8443, Auth: AuthToken, LoginProvider: "tmos", Partition: "Tenant",
+				TLS:         TLS{InsecureSkipVerify: true, Pins: []string{"sha256/AAAA", "ab:cd"}, MinVersion: "1.2"},
+				Credentials: Credentials{Username: "admin", Password: "0123"},
+			},
+			{
+				Name: "prod", Host: "https://bigip.example.com",
+				TLS:         TLS{CAFile: "/etc/bigip/ca.pem"},
+				Credentials: Credentials{Username: "o'brien", PasswordEnv: "PROD_PASSWORD"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(c, expected) {
+		t.Errorf("expected %+v, got %+v", expected, c)
+	}
+
+	for _, invalid := range []string{
+		"",
+		"current-context: lab\n",
+		`{"contexts": [{"name": "lab", "port": "https"}]}`,
+		`{"contexts": [{"name": "lab", "partition": 1}]}`,
+		`{"contexts": [{"name": "lab", "tls": {"insecure": true}}]}`,
+		`{"current-context": "lab"} {}`,
+	} {
+		if _, err := Parse([]byte(invalid)); err == nil {
+			t.Errorf("expected an error parsing %q", invalid)
+		}
+	}
+}
+
+func TestContext(t *testing.T) {
+	c, err := Parse([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, err := c.Context("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctx.Name != "lab" || ctx.Address() != "https://10.0.0.1:8443" {
+		t.Errorf("unexpected context %+v at %s", ctx, ctx.Address())
+	}
+
+	t.Setenv(EnvContext, "prod")
+	t.Setenv(EnvPort, "9443")
+	t.Setenv(EnvPartition, "Other")
+	t.Setenv("PROD_PASSWORD", "secret")
+	ctx, err = c.Context("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctx.Name != "prod" || ctx.Partition != "Other" || ctx.Address() != "https://bigip.example.com:9443" {
+		t.Errorf("unexpected context %+v at %s", ctx, ctx.Address())
+	}
+	if password, err := ctx.Credentials.LookupPassword(); err != nil || password != "secret" {
+		t.Errorf("expected the password from the environment, got %q, %v", password, err)
+	}
+
+	if _, err := c.Context("staging"); err == nil {
+		t.Error("expected an unknown context to be rejected")
+	}
+	t.Setenv(EnvPort, "https")
+	if _, err := c.Context("lab"); err == nil {
+		t.Errorf("expected an invalid %s to be rejected", EnvPort)
+	}
+}
+
+func TestNewSession(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password")
+	if err := os.WriteFile(passwordFile, []byte(s.Password+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config := fmt.Sprintf(`{
+  "current-context": "other",
+  "contexts": [
+    {"name": "lab", "host": %q, "port": %s, "auth": "token", "partition": "Tenant",
+     "tls": {"insecure-skip-verify": true},
+     "credentials": {"username": %q, "password-file": %q}}
+  ]
+}`, u.Hostname(), u.Port(), s.Username, passwordFile)
+	path := filepath.Join(dir, "config")
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvConfig, path)
+
+	b, err := NewSession("lab")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	if token, err := b.Token(); err != nil || token == "" {
+		t.Errorf("expected a token session, got %q, %v", token, err)
+	}
+	if b.Partition() != "Tenant" {
+		t.Errorf("expected the partition Tenant, got %q", b.Partition())
+	}
+	if _, err := b.Dynamic().Resource("auth/partition").Get("Common"); err != nil {
+		t.Error(err)
+	}
+
+	t.Setenv(EnvHost, "")
+	t.Setenv(EnvUsername, "")
+	if _, err := NewSession("lab"); err == nil {
+		t.Error("expected a context without a host to be rejected")
+	}
+}
@@ -0,0 +1,305 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Object is a BIG-IP object, or a collection of them, as unmarshalled from JSON
+// into a map. Numbers are float64, lists []any and nested objects map[string]any.
+type Object map[string]any
+
+// ObjectList is a collection of unstructured objects.
+type ObjectList struct {
+	Items    []Object `json:"items,omitempty"`
+	Kind     string   `json:"kind,omitempty"`
+	SelfLink string   `json:"selfLink,omitempty"`
+}
+
+func (o Object) str(key string) string {
+	s, _ := o[key].(string)
+	return s
+}
+
+// Name returns the name attribute of o.
+func (o Object) Name() string {
+	return o.str("name")
+}
+
+// Partition returns the partition attribute of o.
+func (o Object) Partition() string {
+	return o.str("partition")
+}
+
+// Kind returns the kind attribute of o, e.g. tm:ltm:pool:poolstate.
+func (o Object) Kind() string {
+	return o.str("kind")
+}
+
+// FullPath returns the full path of o, e.g. /Common/name. Objects that have no
+// fullPath attribute are addressed by their partition and name, or their name alone.
+func (o Object) FullPath() string {
+	if fullPath := o.str("fullPath"); fullPath != "" {
+		return fullPath
+	}
+	if partition := o.Partition(); partition != "" {
+		return "/" + partition + "/" + o.Name()
+	}
+	return o.Name()
+}
+
+// SelfLink returns the link of o, which Dynamic.GetLink retrieves it again from.
+func (o Object) SelfLink() string {
+	return o.str("selfLink")
+}
+
+// SubcollectionLink returns the link of the subcollection name of o, e.g. the
+// members of a pool, or "" if o has no such subcollection.
+func (o Object) SubcollectionLink(name string) string {
+	ref, _ := o[name+"Reference"].(map[string]any)
+	link, _ := ref["link"].(string)
+	return link
+}
+
+// Subcollection returns the items of the subcollection name of o, which are only
+// included when o is requested with ListOptions.ExpandSubcollections.
+func (o Object) Subcollection(name string) []Object {
+	ref, _ := o[name+"Reference"].(map[string]any)
+	return Object(ref).Items()
+}
+
+// Items returns the items of o if it is a collection.
+func (o Object) Items() []Object {
+	values, _ := o["items"].([]any)
+	items := make([]Object, 0, len(values))
+	for _, v := range values {
+		if item, ok := v.(map[string]any); ok {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Dynamic sends requests to any endpoint of the iControl REST API, including those
+// this package has no types for, working on unstructured objects:
+//
+//	policies := b.Dynamic().Resource("ltm/policy")
+//	list, err := policies.List()
+//	...
+//	rules := policies.Subcollection("/Common/policy", "rules")
+//	err = rules.Patch("rule1", bigip.Object{"description": "redirect"})
+type Dynamic struct {
+	b *BigIP
+}
+
+// Dynamic returns an unstructured client sending its requests through b.
+func (b *BigIP) Dynamic() *Dynamic {
+	return &Dynamic{b: b}
+}
+
+// Resource returns the collection at p, which is relative to /mgmt/tm, e.g.
+// ltm/policy or sys/file/ssl-cert, unless it is an absolute path starting with
+// /mgmt/.
+func (d *Dynamic) Resource(p string) *DynamicResource {
+	if !strings.HasPrefix(p, "/"+GetBaseResource()+"/") {
+		p = path.Join("/", GetBaseResource(), GetTMResource(), p)
+	}
+	return &DynamicResource{b: d.b, path: path.Clean(p)}
+}
+
+// GetLink retrieves the object or collection at link, such as the selfLink of an
+// object or the link of one of its subcollections.
+func (d *Dynamic) GetLink(link string) (Object, error) {
+	return d.GetLinkContext(context.Background(), link)
+}
+
+// GetLinkContext is like GetLink but uses ctx for the request.
+func (d *Dynamic) GetLinkContext(ctx context.Context, link string) (Object, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, fmt.Errorf("invalid link %q: %w", link, err)
+	}
+	req := d.b.RestClient.Get().Prefix(u.Path)
+	for key, values := range u.Query() {
+		// The version BIG-IP adds to its links is the one of the device anyway.
+		if key == "ver" {
+			continue
+		}
+		for _, value := range values {
+			req = req.SetParams(key, value)
+		}
+	}
+	res, err := req.DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalObject(res)
+}
+
+// DynamicResource is a collection of unstructured objects. Objects are identified
+// by their name or full path, e.g. /Common/name, the way Resource identifies them.
+type DynamicResource struct {
+	b    *BigIP
+	path string
+}
+
+// Path returns the path of the collection, e.g. /mgmt/tm/ltm/policy.
+func (r *DynamicResource) Path() string {
+	return r.path
+}
+
+// Subcollection returns the subcollection sub of the object identified by name,
+// e.g. the members of a pool or the rules of a policy.
+func (r *DynamicResource) Subcollection(name, sub string) *DynamicResource {
+	return &DynamicResource{b: r.b, path: path.Join(r.path, instancePath(name), sub)}
+}
+
+// Collection begins a request with the given verb against the collection path.
+func (r *DynamicResource) Collection(verb string) *rest.Request {
+	return r.b.RestClient.Verb(verb).Prefix(r.path)
+}
+
+// Instance begins a request with the given verb against a single object of the collection.
+func (r *DynamicResource) Instance(verb, name string) *rest.Request {
+	return r.b.RestClient.Verb(verb).Prefix(r.path, instancePath(name))
+}
+
+// List retrieves the objects of the collection, all of them unless opts say otherwise.
+func (r *DynamicResource) List(opts ...ListOptions) (*ObjectList, error) {
+	return r.ListContext(context.Background(), opts...)
+}
+
+// ListContext is like List but uses ctx for the request.
+func (r *DynamicResource) ListContext(ctx context.Context, opts ...ListOptions) (*ObjectList, error) {
+	res, err := ApplyListOptions(r.Collection(http.MethodGet), opts...).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var list ObjectList
+	if err := json.Unmarshal(res, &list); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &list, nil
+}
+
+// Iterate returns an Iterator over the objects of the collection. See NewIterator
+// for the meaning of opts.
+func (r *DynamicResource) Iterate(ctx context.Context, opts ...ListOptions) *Iterator[Object] {
+	return NewIterator[Object](ctx, func() *rest.Request {
+		return r.Collection(http.MethodGet)
+	}, opts...)
+}
+
+// Get retrieves a single object identified by its name or full path.
+func (r *DynamicResource) Get(name string) (Object, error) {
+	return r.GetContext(context.Background(), name)
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (r *DynamicResource) GetContext(ctx context.Context, name string) (Object, error) {
+	res, err := r.Instance(http.MethodGet, name).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalObject(res)
+}
+
+// Create creates a new object in the collection and returns it as created by the device.
+func (r *DynamicResource) Create(obj Object) (Object, error) {
+	return r.CreateContext(context.Background(), obj)
+}
+
+// CreateContext is like Create but uses ctx for the request.
+func (r *DynamicResource) CreateContext(ctx context.Context, obj Object) (Object, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	res, err := r.Collection(http.MethodPost).Body(data).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalObject(res)
+}
+
+// Update replaces the configuration of the object identified by name.
+func (r *DynamicResource) Update(name string, obj Object) error {
+	return r.UpdateContext(context.Background(), name, obj)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *DynamicResource) UpdateContext(ctx context.Context, name string, obj Object) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	_, err = r.Instance(http.MethodPut, name).Body(data).DoRaw(ctx)
+	return err
+}
+
+// Patch modifies only the given attributes of the object identified by name. See
+// PatchBody for fields and mask.
+func (r *DynamicResource) Patch(name string, fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), name, fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *DynamicResource) PatchContext(ctx context.Context, name string, fields any, mask ...string) error {
+	data, err := PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.Instance(http.MethodPatch, name).Body(data).DoRaw(ctx)
+	return err
+}
+
+// Delete removes the object identified by name.
+func (r *DynamicResource) Delete(name string) error {
+	return r.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (r *DynamicResource) DeleteContext(ctx context.Context, name string) error {
+	_, err := r.Instance(http.MethodDelete, name).DoRaw(ctx)
+	return err
+}
+
+// Exists reports whether the object identified by name is configured on the device.
+func (r *DynamicResource) Exists(name string) (bool, error) {
+	return r.ExistsContext(context.Background(), name)
+}
+
+// ExistsContext is like Exists but uses ctx for the request.
+func (r *DynamicResource) ExistsContext(ctx context.Context, name string) (bool, error) {
+	_, err := r.Instance(http.MethodGet, name).DoRaw(ctx)
+	if err == nil {
+		return true, nil
+	}
+	if rest.IsNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// instancePath returns the path segment addressing the object identified by name,
+// which is its full path with the slashes replaced by tildes, e.g. ~Common~name.
+func instancePath(name string) string {
+	return strings.ReplaceAll(name, "/", "~")
+}
+
+func unmarshalObject(data []byte) (Object, error) {
+	var obj Object
+	if len(data) == 0 {
+		return obj, nil
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return obj, nil
+}
@@ -0,0 +1,103 @@
+package bigip
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDynamic(t *testing.T) {
+	var requests []string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.RequestURI()+" "+string(body)))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /mgmt/tm/ltm/policy":
+			w.Write([]byte(`{"kind":"tm:ltm:policy:policycollectionstate","items":[{"name":"p1","partition":"Common","fullPath":"/Common/p1"}]}`))
+		case "GET /mgmt/tm/ltm/policy/~Common~p1":
+			w.Write([]byte(`{"name":"p1","partition":"Common","selfLink":"https://localhost/mgmt/tm/ltm/policy/~Common~p1?ver=16.1.0",
+				"rulesReference":{"link":"https://localhost/mgmt/tm/ltm/policy/~Common~p1/rules?ver=16.1.0","isSubcollection":true,
+				"items":[{"name":"r1"},{"name":"r2"}]}}`))
+		case "GET /mgmt/tm/ltm/policy/~Common~p1/rules":
+			w.Write([]byte(`{"items":[{"name":"r1"},{"name":"r2"}]}`))
+		case "POST /mgmt/tm/sys/file/ssl-cert":
+			w.Write([]byte(`{"name":"cert","partition":"Common","generation":12}`))
+		case "GET /mgmt/tm/ltm/policy/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"01020036:3: The requested policy (/Common/missing) was not found."}`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer ts.Close()
+
+	b, err := NewSession(ts.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	d := b.Dynamic()
+	policies := d.Resource("ltm/policy")
+	if policies.Path() != "/mgmt/tm/ltm/policy" {
+		t.Errorf("unexpected path %s", policies.Path())
+	}
+
+	list, err := policies.List(ListOptions{Select: []string{"name"}})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].FullPath() != "/Common/p1" {
+		t.Errorf("unexpected items %v", list.Items)
+	}
+
+	policy, err := policies.Get("/Common/p1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if policy.FullPath() != "/Common/p1" || len(policy.Subcollection("rules")) != 2 {
+		t.Errorf("unexpected policy %v", policy)
+	}
+	rules, err := d.GetLink(policy.SubcollectionLink("rules"))
+	if err != nil {
+		t.Fatalf("GetLink: %v", err)
+	}
+	if items := rules.Items(); len(items) != 2 || items[1].Name() != "r2" {
+		t.Errorf("unexpected rules %v", rules)
+	}
+	if _, err := d.GetLink(policy.SelfLink()); err != nil {
+		t.Fatalf("GetLink: %v", err)
+	}
+
+	if err := policies.Subcollection("/Common/p1", "rules").Patch("r1", Object{"description": ""}); err != nil {
+		t.Fatalf("Patch: %v", err)
+	}
+	cert, err := d.Resource("sys/file/ssl-cert").Create(Object{"name": "cert", "sourcePath": "file:/var/tmp/cert.pem"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if cert["generation"] != float64(12) {
+		t.Errorf("unexpected object %v", cert)
+	}
+	if err := d.Resource("/mgmt/shared/file-transfer/uploads").Delete("cert.pem"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if exists, err := policies.Exists("missing"); err != nil || exists {
+		t.Errorf("Exists(missing) = %v, %v", exists, err)
+	}
+
+	expected := []string{
+		"GET /mgmt/tm/ltm/policy?$select=name",
+		"GET /mgmt/tm/ltm/policy/~Common~p1",
+		"GET /mgmt/tm/ltm/policy/~Common~p1/rules",
+		"GET /mgmt/tm/ltm/policy/~Common~p1",
+		`PATCH /mgmt/tm/ltm/policy/~Common~p1/rules/r1 {"description":""}`,
+		`POST /mgmt/tm/sys/file/ssl-cert {"name":"cert","sourcePath":"file:/var/tmp/cert.pem"}`,
+		"DELETE /mgmt/shared/file-transfer/uploads/cert.pem",
+		"GET /mgmt/tm/ltm/policy/missing",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected requests:\n%s", strings.Join(requests, "\n"))
+	}
+}
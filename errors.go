@@ -0,0 +1,92 @@
+package bigip
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/transport"
+	"net/http"
+)
+
+// Errors reported when a session can not be established. They are wrapped in a
+// *SessionError and are meant to be tested for with errors.Is.
+var (
+	// ErrUnreachable means the device, or its REST API, could not be reached.
+	ErrUnreachable = errors.New("bigip: device unreachable")
+	// ErrTLS means the TLS handshake with the device failed, for example because
+	// its certificate is not trusted.
+	ErrTLS = errors.New("bigip: TLS handshake failed")
+	// ErrAuthFailed means the device rejected the login.
+	ErrAuthFailed = errors.New("bigip: authentication failed")
+	// ErrBadCredentials means the device rejected the username or password. It
+	// matches ErrAuthFailed as well.
+	ErrBadCredentials = fmt.Errorf("%w: invalid username or password", ErrAuthFailed)
+)
+
+// SessionError is returned when a session can not be established with a device.
+type SessionError struct {
+	// Host is the device the session was created for.
+	Host string
+	// Kind is one of ErrUnreachable, ErrTLS, ErrAuthFailed or ErrBadCredentials.
+	Kind error
+	// Err is the underlying error, if any.
+	Err error
+}
+
+func (e *SessionError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%v (host %s)", e.Kind, e.Host)
+	}
+	return fmt.Sprintf("%v (host %s): %v", e.Kind, e.Host, e.Err)
+}
+
+// Is reports whether target is the kind of e.
+func (e *SessionError) Is(target error) bool {
+	return errors.Is(e.Kind, target)
+}
+
+func (e *SessionError) Unwrap() error {
+	return e.Err
+}
+
+// newSessionError classifies err, returned by an HTTP client talking to host, as a
+// TLS failure or an unreachable device.
+func newSessionError(host string, err error) error {
+	var sessionErr *SessionError
+	if errors.As(err, &sessionErr) {
+		return err
+	}
+	var (
+		verifyErr    *tls.CertificateVerificationError
+		authorityErr x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		invalidErr   x509.CertificateInvalidError
+		recordErr    tls.RecordHeaderError
+		pinErr       *transport.PinError
+	)
+	kind := ErrUnreachable
+	switch {
+	case errors.As(err, &verifyErr), errors.As(err, &authorityErr), errors.As(err, &hostnameErr),
+		errors.As(err, &invalidErr), errors.As(err, &recordErr), errors.As(err, &pinErr):
+		kind = ErrTLS
+	}
+	return &SessionError{Host: host, Kind: kind, Err: err}
+}
+
+// statusError returns an error if resp shows that host rejected the credentials
+// of a session.
+func statusError(host string, resp *http.Response) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+	kind := ErrAuthFailed
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		kind = ErrBadCredentials
+	case resp.StatusCode >= http.StatusInternalServerError:
+		kind = ErrUnreachable
+	}
+	return &SessionError{Host: host, Kind: kind, Err: rest.NewAPIError(resp)}
+}
@@ -596,7 +596,7 @@ func (bs *bigipTest) updateVirtualServer() {
 		Destination:              "192.168.83.26:9090",
 		Mask:                     "255.255.255.255",
 		SourceAddressTranslation: ltm.SourceAddressTranslation{Type: "automap"},
-		ConnectionLimit:          1000,
+		ConnectionLimit:          bigip.Some(1000),
 	}
 
 	if err := bg.Virtual().Update(name, item); err != nil {
@@ -774,8 +774,8 @@ func (bs *bigipTest) updatePoolMembers() {
 	poolName := "/Common/hello-pool"
 	memberName := "/Common/142.10.3.2:4523"
 	item := ltm.PoolMembers{
-		ConnectionLimit: 1000,
-		Ratio:           10,
+		ConnectionLimit: bigip.Some[int64](1000),
+		Ratio:           bigip.Some[int64](10),
 		//:         "enable",
 	}
 
@@ -0,0 +1,222 @@
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Extra holds the attributes of a resource that its struct does not model, such
+// as attributes added by newer TMOS versions. They are sent back as they were
+// received, so that a Get followed by an Update does not reset them to their
+// defaults on the device.
+type Extra map[string]json.RawMessage
+
+// UnmarshalExtra unmarshals data into v, a pointer to a struct, and stores the
+// attributes no field of v takes in extra. Resource structs call it from their
+// UnmarshalJSON method with a type that has no methods of its own:
+//
+//	func (p *Pool) UnmarshalJSON(data []byte) error {
+//		type plain Pool
+//		return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
+//	}
+func UnmarshalExtra(data []byte, v any, extra *Extra) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return err
+	}
+	known := jsonFieldNames(reflect.TypeOf(v).Elem())
+	for name := range attrs {
+		if known[strings.ToLower(name)] {
+			delete(attrs, name)
+		}
+	}
+	if len(attrs) == 0 {
+		attrs = nil
+	}
+	*extra = attrs
+	return nil
+}
+
+// MarshalExtra marshals v, a struct, adding the attributes in extra that no field
+// of v models. Fields of type Opt that are unset and tagged omitempty are omitted,
+// as encoding/json only omits empty values that are not structs. Resource structs
+// call it from their MarshalJSON method:
+//
+//	func (p Pool) MarshalJSON() ([]byte, error) {
+//		type plain Pool
+//		return bigip.MarshalExtra(plain(p), p.Extra)
+//	}
+func MarshalExtra(v any, extra Extra) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if unset := unsetOpts(reflect.Indirect(reflect.ValueOf(v)), nil); len(unset) != 0 {
+		if data, err = removeAttributes(data, unset); err != nil {
+			return nil, err
+		}
+	}
+	if len(extra) == 0 {
+		return data, nil
+	}
+
+	known := jsonFieldNames(reflect.TypeOf(v))
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		if !known[strings.ToLower(name)] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	for i, name := range names {
+		if i > 0 || len(data) > 2 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		value := extra[name]
+		if len(value) == 0 {
+			value = json.RawMessage("null")
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// unsetOpts adds the JSON names of the unset Opt fields of the struct v that are
+// tagged omitempty, including those of embedded structs, to names.
+func unsetOpts(v reflect.Value, names map[string]bool) map[string]bool {
+	if v.Kind() != reflect.Struct {
+		return names
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			embedded := v.Field(i)
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				names = unsetOpts(embedded, names)
+			}
+			continue
+		}
+		if !f.IsExported() || !strings.Contains(","+opts+",", ",omitempty,") {
+			continue
+		}
+		if opt, ok := v.Field(i).Interface().(interface{ isUnset() bool }); ok && opt.isUnset() {
+			if name == "" {
+				name = f.Name
+			}
+			if names == nil {
+				names = make(map[string]bool)
+			}
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// removeAttributes removes the attributes with the given names from the JSON
+// object data, keeping the order of the others.
+func removeAttributes(data []byte, names map[string]bool) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	if _, err := d.Token(); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for d.More() {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := d.Decode(&value); err != nil {
+			return nil, err
+		}
+		name, _ := token.(string)
+		if names[name] {
+			continue
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// fieldNames caches the result of jsonFieldNames by type.
+var fieldNames sync.Map
+
+// jsonFieldNames returns the lower case names under which encoding/json marshals
+// the fields of the struct type t, including those of embedded structs.
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	if names, ok := fieldNames.Load(t); ok {
+		return names.(map[string]bool)
+	}
+	names := make(map[string]bool)
+	addJSONFieldNames(t, names)
+	fieldNames.Store(t, names)
+	return names
+}
+
+func addJSONFieldNames(t reflect.Type, names map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addJSONFieldNames(ft, names)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names[strings.ToLower(name)] = true
+	}
+}
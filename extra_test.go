@@ -0,0 +1,65 @@
+package bigip
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type extraItem struct {
+	Name        string      `json:"name,omitempty"`
+	Description Opt[string] `json:"description,omitempty"`
+	Internal    string      `json:"-"`
+	Extra       Extra       `json:"-"`
+}
+
+func (e *extraItem) UnmarshalJSON(data []byte) error {
+	type plain extraItem
+	return UnmarshalExtra(data, (*plain)(e), &e.Extra)
+}
+
+func (e extraItem) MarshalJSON() ([]byte, error) {
+	type plain extraItem
+	return MarshalExtra(plain(e), e.Extra)
+}
+
+func TestExtra(t *testing.T) {
+	data := []byte(`{"Name":"p1","description":"web","serviceDownAction":"reset","minUpMembers":2,"-":"x"}`)
+	var item extraItem
+	if err := json.Unmarshal(data, &item); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if item.Name != "p1" || item.Description.Value() != "web" {
+		t.Errorf("unexpected item %+v", item)
+	}
+	if len(item.Extra) != 3 || string(item.Extra["serviceDownAction"]) != `"reset"` {
+		t.Errorf("unexpected extra attributes %v", item.Extra)
+	}
+
+	item.Description = Some("")
+	out, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if expected := `{"name":"p1","description":"","-":"x","minUpMembers":2,"serviceDownAction":"reset"}`; string(out) != expected {
+		t.Errorf("expected %s, got %s", expected, out)
+	}
+
+	// Modelled attributes win over extra ones of the same name.
+	out, err = json.Marshal(extraItem{Extra: Extra{"NAME": json.RawMessage(`"p2"`), "ratio": json.RawMessage(`1`)}})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if expected := `{"ratio":1}`; string(out) != expected {
+		t.Errorf("expected %s, got %s", expected, out)
+	}
+
+	var list struct {
+		Items []extraItem `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(`{"items":[{"name":"p1"},{"name":"p2","ratio":1}]}`), &list); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if list.Items[0].Extra != nil || string(list.Items[1].Extra["ratio"]) != "1" {
+		t.Errorf("unexpected items %+v", list.Items)
+	}
+}
@@ -0,0 +1,407 @@
+package bigip
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DefaultChunkSize is the size of the chunks files are transferred in. BIG-IP
+// rejects chunks larger than 1MB.
+const DefaultChunkSize = 1024 * 1024
+
+// Managers and endpoints of the file transfer APIs.
+const (
+	FileTransferManager          = "file-transfer"
+	AutodeployManager            = "autodeploy"
+	UploadsEndpoint              = "uploads"
+	UCSUploadsEndpoint           = "ucs-uploads"
+	SoftwareImageUploadsEndpoint = "software-image-uploads"
+	BulkEndpoint                 = "bulk"
+	UCSDownloadsEndpoint         = "ucs-downloads"
+	QkviewDownloadsEndpoint      = "qkview-downloads"
+)
+
+// Directories of the device the files are transferred to and from.
+const (
+	UploadsDirectory        = "/var/config/rest/downloads/"
+	UCSDirectory            = "/var/local/ucs/"
+	SoftwareImagesDirectory = "/shared/images/"
+	BulkDirectory           = "/var/config/rest/bulk/"
+	QkviewDirectory         = "/var/tmp/"
+)
+
+// Errors returned when a transferred file differs from the original.
+var (
+	ErrChecksumMismatch = errors.New("bigip: checksum mismatch")
+	ErrSizeMismatch     = errors.New("bigip: size mismatch")
+)
+
+// TransferOptions configures a file transfer.
+type TransferOptions struct {
+	// ChunkSize is the number of bytes sent per request. It is DefaultChunkSize
+	// when zero and may not be larger.
+	ChunkSize int64
+	// Offset resumes a transfer that failed after Offset bytes, as reported by
+	// the TransferError it returned.
+	Offset int64
+	// Progress, when set, is called after every chunk with the number of bytes
+	// transferred so far and the size of the file.
+	Progress func(done, total int64)
+	// Verify compares the SHA-256 checksum of the file on the device with that of
+	// the content once the transfer completed. Resumed downloads can not be verified.
+	Verify bool
+	// Size is the size a downloaded file is expected to have, e.g. as reported by
+	// sys.UCSResource, if known.
+	Size int64
+}
+
+// TransferError is returned when a file transfer failed. The transfer can be
+// resumed by repeating it with TransferOptions.Offset set to Offset.
+type TransferError struct {
+	Name string
+	// Offset is the number of bytes transferred before the failure.
+	Offset int64
+	Err    error
+}
+
+func (e *TransferError) Error() string {
+	return fmt.Sprintf("transfer of %s failed at offset %d: %v", e.Name, e.Offset, e.Err)
+}
+
+func (e *TransferError) Unwrap() error {
+	return e.Err
+}
+
+// TransferResult describes a file transferred to or from the device.
+type TransferResult struct {
+	// Path is the location of the file on the device, e.g.
+	// /var/config/rest/downloads/app.tgz.
+	Path string
+	Size int64
+	// SHA256 is the hex encoded checksum of the content. It is not set for resumed
+	// downloads.
+	SHA256 string
+}
+
+// uploadStatus is the response of the device to a chunk of an upload.
+type uploadStatus struct {
+	RemainingByteCount int64  `json:"remainingByteCount"`
+	TotalByteCount     int64  `json:"totalByteCount"`
+	LocalFilePath      string `json:"localFilePath"`
+}
+
+// FileTransferResource transfers files to and from the device.
+type FileTransferResource struct {
+	b *BigIP
+}
+
+// FileTransfer returns a FileTransferResource to transfer files through
+// /mgmt/shared/file-transfer and /mgmt/cm/autodeploy.
+func (b *BigIP) FileTransfer() *FileTransferResource {
+	return &FileTransferResource{b: b}
+}
+
+// Upload sends the size bytes of content to the file name in UploadsDirectory,
+// from where it can be referenced by ltm ifiles, sys crypto certificates and keys
+// and other objects created from a file. size may be -1 if content is an io.Seeker.
+func (r *FileTransferResource) Upload(name string, content io.Reader, size int64, opts TransferOptions) (*TransferResult, error) {
+	return r.UploadContext(context.Background(), name, content, size, opts)
+}
+
+// UploadContext is like Upload but uses ctx for the requests.
+func (r *FileTransferResource) UploadContext(ctx context.Context, name string, content io.Reader, size int64, opts TransferOptions) (*TransferResult, error) {
+	return r.upload(ctx, GetShareResource(), FileTransferManager, UploadsEndpoint, UploadsDirectory, name, content, size, opts)
+}
+
+// UploadUCS sends the UCS archive in content to the file name in UCSDirectory, from
+// where sys.UCSResource can load it.
+func (r *FileTransferResource) UploadUCS(name string, content io.Reader, size int64, opts TransferOptions) (*TransferResult, error) {
+	return r.UploadUCSContext(context.Background(), name, content, size, opts)
+}
+
+// UploadUCSContext is like UploadUCS but uses ctx for the requests.
+func (r *FileTransferResource) UploadUCSContext(ctx context.Context, name string, content io.Reader, size int64, opts TransferOptions) (*TransferResult, error) {
+	return r.upload(ctx, GetShareResource(), FileTransferManager, UCSUploadsEndpoint, UCSDirectory, name, content, size, opts)
+}
+
+// UploadImage sends the software image or hotfix ISO in content to the file name
+// in SoftwareImagesDirectory, from where it can be installed.
+func (r *FileTransferResource) UploadImage(name string, content io.Reader, size int64, opts TransferOptions) (*TransferResult, error) {
+	return r.UploadImageContext(context.Background(), name, content, size, opts)
+}
+
+// UploadImageContext is like UploadImage but uses ctx for the requests.
+func (r *FileTransferResource) UploadImageContext(ctx context.Context, name string, content io.Reader, size int64, opts TransferOptions) (*TransferResult, error) {
+	return r.upload(ctx, GetCMResource(), AutodeployManager, SoftwareImageUploadsEndpoint, SoftwareImagesDirectory, name, content, size, opts)
+}
+
+// upload sends content in chunks with a Content-Range header to the endpoint of
+// manager in category.
+func (r *FileTransferResource) upload(ctx context.Context, category, manager, endpoint, dir, name string, content io.Reader, size int64, opts TransferOptions) (*TransferResult, error) {
+	if name == "" || strings.ContainsAny(name, "/'\"$`\\") {
+		return nil, fmt.Errorf("invalid file name %q", name)
+	}
+	if size < 0 {
+		seeker, ok := content.(io.Seeker)
+		if !ok {
+			return nil, fmt.Errorf("the size of the content of %s is unknown", name)
+		}
+		var err error
+		if size, err = remaining(seeker); err != nil {
+			return nil, err
+		}
+	}
+	chunkSize, err := opts.chunkSize()
+	if err != nil {
+		return nil, err
+	}
+	// An empty file is sent as a single empty chunk at offset 0, any other file in
+	// chunks starting before its end.
+	if opts.Offset < 0 || opts.Offset > size || opts.Offset == size && size != 0 {
+		return nil, fmt.Errorf("invalid offset %d of %s of %d bytes", opts.Offset, name, size)
+	}
+
+	// The bytes sent before are skipped, but still hashed when verifying.
+	h := sha256.New()
+	if _, err := io.CopyN(h, content, opts.Offset); err != nil {
+		return nil, &TransferError{Name: name, Offset: opts.Offset, Err: err}
+	}
+	result := &TransferResult{Path: dir + name, Size: size}
+	buf := make([]byte, chunkSize)
+	offset := opts.Offset
+	for {
+		n, err := io.ReadFull(content, buf[:minInt64(chunkSize, size-offset)])
+		if err != nil {
+			return nil, &TransferError{Name: name, Offset: offset, Err: err}
+		}
+		h.Write(buf[:n])
+		// An empty file is sent as a single empty chunk.
+		end := offset + int64(n) - 1
+		if n == 0 {
+			end = offset
+		}
+		res, err := r.b.RestClient.Post().Prefix(GetBaseResource()).ResourceCategory(category).ManagerName(manager).
+			Resource(endpoint).ResourceInstance(name).
+			SetHeader("Content-Type", "application/octet-stream").
+			SetHeader("Content-Range", fmt.Sprintf("%d-%d/%d", offset, end, size)).
+			Body(buf[:n]).DoRaw(ctx)
+		if err != nil {
+			return nil, &TransferError{Name: name, Offset: offset, Err: err}
+		}
+		var status uploadStatus
+		if json.Unmarshal(res, &status) == nil && status.LocalFilePath != "" {
+			result.Path = status.LocalFilePath
+		}
+		offset += int64(n)
+		if opts.Progress != nil {
+			opts.Progress(offset, size)
+		}
+		if offset >= size {
+			break
+		}
+	}
+
+	result.SHA256 = hex.EncodeToString(h.Sum(nil))
+	if opts.Verify {
+		if err := r.verify(ctx, result.Path, result.SHA256); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// chunkSize returns the size of the chunks of a transfer with opts.
+func (opts TransferOptions) chunkSize() (int64, error) {
+	switch {
+	case opts.ChunkSize <= 0:
+		return DefaultChunkSize, nil
+	case opts.ChunkSize > DefaultChunkSize:
+		return 0, fmt.Errorf("chunk size %d is larger than %d", opts.ChunkSize, DefaultChunkSize)
+	}
+	return opts.ChunkSize, nil
+}
+
+// Download writes the file name in BulkDirectory to w.
+func (r *FileTransferResource) Download(name string, w io.Writer, opts TransferOptions) (*TransferResult, error) {
+	return r.DownloadContext(context.Background(), name, w, opts)
+}
+
+// DownloadContext is like Download but uses ctx for the requests.
+func (r *FileTransferResource) DownloadContext(ctx context.Context, name string, w io.Writer, opts TransferOptions) (*TransferResult, error) {
+	return r.download(ctx, GetShareResource(), FileTransferManager, BulkEndpoint, BulkDirectory, name, w, opts)
+}
+
+// DownloadUCS writes the UCS archive name in UCSDirectory to w.
+func (r *FileTransferResource) DownloadUCS(name string, w io.Writer, opts TransferOptions) (*TransferResult, error) {
+	return r.DownloadUCSContext(context.Background(), name, w, opts)
+}
+
+// DownloadUCSContext is like DownloadUCS but uses ctx for the requests.
+func (r *FileTransferResource) DownloadUCSContext(ctx context.Context, name string, w io.Writer, opts TransferOptions) (*TransferResult, error) {
+	return r.download(ctx, GetShareResource(), FileTransferManager, UCSDownloadsEndpoint, UCSDirectory, name, w, opts)
+}
+
+// DownloadQkview writes the qkview name in QkviewDirectory to w.
+func (r *FileTransferResource) DownloadQkview(name string, w io.Writer, opts TransferOptions) (*TransferResult, error) {
+	return r.DownloadQkviewContext(context.Background(), name, w, opts)
+}
+
+// DownloadQkviewContext is like DownloadQkview but uses ctx for the requests.
+func (r *FileTransferResource) DownloadQkviewContext(ctx context.Context, name string, w io.Writer, opts TransferOptions) (*TransferResult, error) {
+	return r.download(ctx, GetCMResource(), AutodeployManager, QkviewDownloadsEndpoint, QkviewDirectory, name, w, opts)
+}
+
+// download requests the file in chunks with a Content-Range header from the
+// endpoint of manager in category. The device answers every chunk with the range
+// it holds and the size of the file.
+func (r *FileTransferResource) download(ctx context.Context, category, manager, endpoint, dir, name string, w io.Writer, opts TransferOptions) (*TransferResult, error) {
+	if name == "" || strings.ContainsAny(name, "/'\"$`\\") {
+		return nil, fmt.Errorf("invalid file name %q", name)
+	}
+	if opts.Verify && opts.Offset != 0 {
+		return nil, fmt.Errorf("the resumed download of %s can not be verified", name)
+	}
+	chunkSize, err := opts.chunkSize()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Offset < 0 {
+		return nil, fmt.Errorf("invalid offset %d of %s", opts.Offset, name)
+	}
+
+	h := sha256.New()
+	result := &TransferResult{Path: dir + name, Size: -1}
+	offset := opts.Offset
+	for result.Size < 0 || offset < result.Size {
+		end := offset + chunkSize - 1
+		if result.Size >= 0 && end >= result.Size {
+			end = result.Size - 1
+		}
+		res := r.b.RestClient.Get().Prefix(GetBaseResource()).ResourceCategory(category).ManagerName(manager).
+			Resource(endpoint).ResourceInstance(name).
+			SetHeader("Content-Range", fmt.Sprintf("%d-%d/%d", offset, end, maxInt64(result.Size, 0))).Do(ctx)
+		if res.Err != nil {
+			return nil, &TransferError{Name: name, Offset: offset, Err: res.Err}
+		}
+		start, size, err := parseContentRange(res.Header.Get("Content-Range"))
+		switch {
+		case err != nil:
+			return nil, &TransferError{Name: name, Offset: offset, Err: err}
+		case start != offset:
+			return nil, &TransferError{Name: name, Offset: offset, Err: fmt.Errorf("received the range starting at %d", start)}
+		case opts.Size > 0 && size != opts.Size:
+			return nil, fmt.Errorf("%w: %s has %d bytes on the device, expected %d", ErrSizeMismatch, name, size, opts.Size)
+		case int64(len(res.Body)) > size-offset || len(res.Body) == 0 && size != 0:
+			return nil, &TransferError{Name: name, Offset: offset, Err: fmt.Errorf("received %d bytes", len(res.Body))}
+		}
+		result.Size = size
+		if _, err := w.Write(res.Body); err != nil {
+			return nil, &TransferError{Name: name, Offset: offset, Err: err}
+		}
+		h.Write(res.Body)
+		offset += int64(len(res.Body))
+		if opts.Progress != nil {
+			opts.Progress(offset, size)
+		}
+	}
+
+	if opts.Offset == 0 {
+		result.SHA256 = hex.EncodeToString(h.Sum(nil))
+	}
+	if opts.Verify {
+		if err := r.verify(ctx, result.Path, result.SHA256); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// parseContentRange returns the start of the range and the size of the file in the
+// value of a Content-Range header, e.g. "0-1048575/4194304".
+func parseContentRange(value string) (start, size int64, err error) {
+	var end int64
+	if _, err := fmt.Sscanf(strings.TrimPrefix(value, "bytes "), "%d-%d/%d", &start, &end, &size); err != nil {
+		return 0, 0, fmt.Errorf("invalid Content-Range %q", value)
+	}
+	return start, size, nil
+}
+
+// verify compares the checksum of the file at path on the device with expected.
+func (r *FileTransferResource) verify(ctx context.Context, path, expected string) error {
+	sum, err := r.SHA256Context(ctx, path)
+	if err != nil {
+		return err
+	}
+	if sum != expected {
+		return fmt.Errorf("%w: %s has SHA-256 %s on the device, expected %s", ErrChecksumMismatch, path, sum, expected)
+	}
+	return nil
+}
+
+// SHA256 returns the hex encoded SHA-256 checksum of the file at path on the device.
+func (r *FileTransferResource) SHA256(path string) (string, error) {
+	return r.SHA256Context(context.Background(), path)
+}
+
+// SHA256Context is like SHA256 but uses ctx for the request.
+func (r *FileTransferResource) SHA256Context(ctx context.Context, path string) (string, error) {
+	if path == "" || strings.ContainsAny(path, "'\"$`\\") {
+		return "", fmt.Errorf("invalid path %q", path)
+	}
+	data, err := json.Marshal(map[string]string{"command": "run", "utilCmdArgs": `-c 'sha256sum "` + path + `"'`})
+	if err != nil {
+		return "", err
+	}
+	res, err := r.b.RestClient.Post().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).ManagerName("util").
+		Resource("bash").Body(data).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		CommandResult string `json:"commandResult"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return "", fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	fields := strings.Fields(result.CommandResult)
+	if len(fields) == 0 || len(fields[0]) != sha256.Size*2 {
+		return "", fmt.Errorf("failed to compute the checksum of %s: %s", path, strings.TrimSpace(result.CommandResult))
+	}
+	return fields[0], nil
+}
+
+// remaining returns the number of bytes between the current offset of s and its end.
+func remaining(s io.Seeker) (int64, error) {
+	current, err := s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	end, err := s.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := s.Seek(current, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return end - current, nil
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,146 @@
+package bigip
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestUpload(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+	s.HandleFunc("/mgmt/tm/util/bash", func(w http.ResponseWriter, r *http.Request) {
+		var cmd struct{ UtilCmdArgs string }
+		json.NewDecoder(r.Body).Decode(&cmd)
+		path := strings.TrimSuffix(strings.TrimPrefix(cmd.UtilCmdArgs, `-c 'sha256sum "`), `"'`)
+		data, _ := s.File(path)
+		sum := sha256.Sum256(data)
+		json.NewEncoder(w).Encode(map[string]string{"commandResult": hex.EncodeToString(sum[:]) + "  " + path + "\n"})
+	})
+	b, err := NewSession(s.URL, s.Username, s.Password)
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+
+	content := bytes.Repeat([]byte("0123456789"), 250)
+	var progress []int64
+	result, err := b.FileTransfer().Upload("app.tgz", bytes.NewReader(content), -1, TransferOptions{
+		ChunkSize: 1000,
+		Progress:  func(done, total int64) { progress = append(progress, done) },
+		Verify:    true,
+	})
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	sum := sha256.Sum256(content)
+	if result.Path != "/var/config/rest/downloads/app.tgz" || result.Size != 2500 || result.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("unexpected result %+v", result)
+	}
+	if len(progress) != 3 || progress[2] != 2500 {
+		t.Errorf("unexpected progress %v", progress)
+	}
+	if data, _ := s.File(result.Path); !bytes.Equal(data, content) {
+		t.Errorf("unexpected file of %d bytes", len(data))
+	}
+
+	s.FailNext(http.StatusServiceUnavailable, "restjavad is busy")
+	_, err = b.FileTransfer().UploadUCS("backup.ucs", bytes.NewReader(content), int64(len(content)), TransferOptions{ChunkSize: 1000})
+	var transferErr *TransferError
+	if !errors.As(err, &transferErr) || transferErr.Offset != 0 {
+		t.Fatalf("expected a transfer error at offset 0, got %v", err)
+	}
+	// An upload interrupted after the first chunk is resumed.
+	s.SetFile("/var/local/ucs/backup.ucs", content[:1000])
+	result, err = b.FileTransfer().UploadUCS("backup.ucs", bytes.NewReader(content), int64(len(content)), TransferOptions{ChunkSize: 1000, Offset: 1000, Verify: true})
+	if err != nil {
+		t.Fatalf("UploadUCS: %v", err)
+	}
+	if result.Path != "/var/local/ucs/backup.ucs" || result.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	s.SetFile("/shared/images/BIGIP.iso", make([]byte, 2000))
+	_, err = b.FileTransfer().UploadImage("BIGIP.iso", bytes.NewReader(content), int64(len(content)), TransferOptions{Offset: 2000, Verify: true})
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected a checksum mismatch, got %v", err)
+	}
+
+	requests := len(s.Requests())
+	for _, opts := range []TransferOptions{{Offset: 2500}, {Offset: 3000}, {Offset: -1}, {ChunkSize: DefaultChunkSize + 1}} {
+		if _, err := b.FileTransfer().Upload("app.tgz", bytes.NewReader(content), int64(len(content)), opts); err == nil {
+			t.Errorf("expected an error uploading with %+v", opts)
+		}
+	}
+	if n := len(s.Requests()); n != requests {
+		t.Errorf("expected invalid uploads to send no request, got %d", n-requests)
+	}
+	if _, err := b.FileTransfer().Upload("empty", bytes.NewReader(nil), 0, TransferOptions{}); err != nil {
+		t.Errorf("Upload of an empty file: %v", err)
+	}
+}
+
+func TestDownload(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+	b, err := NewSession(s.URL, s.Username, s.Password)
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	content := bytes.Repeat([]byte("0123456789"), 250)
+	s.SetFile("/var/local/ucs/backup.ucs", content)
+	s.SetFile("/var/tmp/case.qkview", content[:10])
+	s.SetFile("/var/config/rest/bulk/empty", nil)
+
+	var buf bytes.Buffer
+	var progress []int64
+	result, err := b.FileTransfer().DownloadUCS("backup.ucs", &buf, TransferOptions{
+		ChunkSize: 1000,
+		Size:      2500,
+		Progress:  func(done, total int64) { progress = append(progress, done) },
+	})
+	if err != nil {
+		t.Fatalf("DownloadUCS: %v", err)
+	}
+	sum := sha256.Sum256(content)
+	if !bytes.Equal(buf.Bytes(), content) || result.Size != 2500 || result.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("unexpected result %+v of %d bytes", result, buf.Len())
+	}
+	if len(progress) != 3 || progress[2] != 2500 {
+		t.Errorf("unexpected progress %v", progress)
+	}
+
+	// A download interrupted after the first chunk is resumed.
+	buf.Reset()
+	buf.Write(content[:1000])
+	if _, err := b.FileTransfer().DownloadUCS("backup.ucs", &buf, TransferOptions{ChunkSize: 1000, Offset: 1000}); err != nil {
+		t.Fatalf("DownloadUCS: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), content) {
+		t.Errorf("unexpected content of %d bytes", buf.Len())
+	}
+
+	if _, err := b.FileTransfer().DownloadUCS("backup.ucs", io.Discard, TransferOptions{Size: 3000}); !errors.Is(err, ErrSizeMismatch) {
+		t.Errorf("expected a size mismatch, got %v", err)
+	}
+	if _, err := b.FileTransfer().DownloadUCS("backup.ucs", io.Discard, TransferOptions{ChunkSize: DefaultChunkSize + 1}); err == nil {
+		t.Error("expected an error for a chunk size above DefaultChunkSize")
+	}
+	buf.Reset()
+	if _, err := b.FileTransfer().DownloadQkview("case.qkview", &buf, TransferOptions{}); err != nil || buf.String() != "0123456789" {
+		t.Errorf("DownloadQkview: %q, %v", buf.String(), err)
+	}
+	if result, err := b.FileTransfer().Download("empty", io.Discard, TransferOptions{}); err != nil || result.Size != 0 {
+		t.Errorf("Download: %+v, %v", result, err)
+	}
+	if _, err := b.FileTransfer().Download("missing", io.Discard, TransferOptions{}); !rest.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
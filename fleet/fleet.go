@@ -0,0 +1,445 @@
+// Package fleet operates on many BIG-IP devices at once. A Fleet holds a named set
+// of devices, each with its own credentials and options, opens a session with each
+// of them on first use and runs an operation on all of them, or on those selected
+// by their tags, with a bounded number in flight:
+//
+//	f, err := fleet.New([]fleet.Device{
+//		{Name: "lb1", Host: "10.0.0.1", Options: []bigip.Option{bigip.WithTokenAuth(user, password, "tmos")},
+//			Tags: map[string]string{"site": "fra", "role": "edge"}},
+//		// ...
+//	}, fleet.WithConcurrency(20), fleet.WithTimeout(time.Minute))
+//	if err != nil {
+//		return err
+//	}
+//	defer f.Close()
+//
+//	pools, err := fleet.Run(ctx, f.Select(fleet.Tag("site", "fra")),
+//		func(ctx context.Context, d fleet.Device, b *bigip.BigIP) (*bigip.ObjectList, error) {
+//			return b.Dynamic().Resource("ltm/pool").ListContext(ctx)
+//		})
+//
+// Run returns the results of the devices that succeeded and, if any failed, an
+// *Error holding the error of each of them by name.
+package fleet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lefeck/go-bigip"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultConcurrency is the number of devices a Fleet operates on at once unless
+// configured otherwise.
+const DefaultConcurrency = 10
+
+// Device is a member of a fleet.
+type Device struct {
+	// Name identifies the device in the fleet and in the results of Run.
+	Name string
+	// Host is the address of the device, as given to bigip.New.
+	Host string
+	// Options configure the session with the device, e.g. its credentials with
+	// bigip.WithTokenAuth. They are applied after the options of the fleet.
+	Options []bigip.Option
+	// Tags describe the device, e.g. its site, role or HA pair, for Select.
+	Tags map[string]string
+	// Session is used as the session with the device when set, instead of one
+	// created from Host and Options. The fleet does not close it.
+	Session *bigip.BigIP
+}
+
+// Option configures a Fleet created with New.
+type Option func(f *Fleet)
+
+// WithConcurrency sets the number of devices operated on at once.
+func WithConcurrency(n int) Option {
+	return func(f *Fleet) {
+		f.concurrency = n
+	}
+}
+
+// WithTimeout bounds the time an operation may take on each device, including
+// opening the session with it. The timeout applies to the context the operation
+// is called with.
+func WithTimeout(timeout time.Duration) Option {
+	return func(f *Fleet) {
+		f.timeout = timeout
+	}
+}
+
+// WithSessionOptions adds options to the sessions with every device, e.g. a rate
+// limit or a logger, before the options of the device itself.
+func WithSessionOptions(opts ...bigip.Option) Option {
+	return func(f *Fleet) {
+		f.options = append(f.options, opts...)
+	}
+}
+
+// Fleet is a named set of devices. It is safe for concurrent use.
+type Fleet struct {
+	concurrency int
+	timeout     time.Duration
+	options     []bigip.Option
+
+	// members is shared with the fleets returned by Select.
+	members *members
+	names   []string
+}
+
+// members holds the devices of a fleet and their sessions.
+type members struct {
+	mu      sync.Mutex
+	devices map[string]*member
+}
+
+type member struct {
+	device Device
+
+	mu      sync.Mutex
+	session *bigip.BigIP
+	// owned is set for sessions the fleet created and must close.
+	owned bool
+	// opening is the session being opened, which concurrent callers wait for
+	// instead of opening one themselves.
+	opening *opening
+}
+
+type opening struct {
+	done chan struct{}
+	err  error
+}
+
+// New creates a fleet of devices configured by opts. Sessions are only opened when
+// a device is first operated on.
+func New(devices []Device, opts ...Option) (*Fleet, error) {
+	f := &Fleet{
+		concurrency: DefaultConcurrency,
+		members:     &members{devices: make(map[string]*member)},
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	for _, d := range devices {
+		if err := f.Add(d); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// Add adds d to the fleet. Its name must not be taken already.
+func (f *Fleet) Add(d Device) error {
+	if d.Name == "" {
+		return errors.New("fleet: device without a name")
+	}
+	if d.Host == "" && d.Session == nil {
+		return fmt.Errorf("fleet: device %s has neither a host nor a session", d.Name)
+	}
+	f.members.mu.Lock()
+	defer f.members.mu.Unlock()
+	if _, ok := f.members.devices[d.Name]; ok {
+		return fmt.Errorf("fleet: duplicate device %s", d.Name)
+	}
+	f.members.devices[d.Name] = &member{device: d, session: d.Session}
+	f.names = append(f.names, d.Name)
+	sort.Strings(f.names)
+	return nil
+}
+
+// Remove removes the device with the given name from the fleet and closes its
+// session.
+func (f *Fleet) Remove(name string) error {
+	f.members.mu.Lock()
+	m, ok := f.members.devices[name]
+	delete(f.members.devices, name)
+	for i, n := range f.names {
+		if n == name {
+			f.names = append(f.names[:i:i], f.names[i+1:]...)
+			break
+		}
+	}
+	f.members.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return m.close()
+}
+
+// Names returns the names of the devices of the fleet in order.
+func (f *Fleet) Names() []string {
+	f.members.mu.Lock()
+	defer f.members.mu.Unlock()
+	names := make([]string, 0, len(f.names))
+	for _, name := range f.names {
+		if _, ok := f.members.devices[name]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Device returns the device with the given name.
+func (f *Fleet) Device(name string) (Device, bool) {
+	m, ok := f.member(name)
+	if !ok {
+		return Device{}, false
+	}
+	return m.device, true
+}
+
+// Session returns the session with the device with the given name, opening it if
+// it is not open yet.
+func (f *Fleet) Session(name string) (*bigip.BigIP, error) {
+	m, ok := f.member(name)
+	if !ok {
+		return nil, fmt.Errorf("fleet: unknown device %s", name)
+	}
+	return m.open(context.Background(), f.options)
+}
+
+// Selector selects devices of a fleet.
+type Selector func(d Device) bool
+
+// Tag selects the devices whose tag key has one of values, or any value if none
+// is given.
+func Tag(key string, values ...string) Selector {
+	return func(d Device) bool {
+		value, ok := d.Tags[key]
+		if !ok || len(values) == 0 {
+			return ok
+		}
+		for _, v := range values {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Select returns the fleet of the devices matching all selectors. It shares its
+// sessions and settings with f, so it must not be closed separately.
+func (f *Fleet) Select(selectors ...Selector) *Fleet {
+	selected := &Fleet{concurrency: f.concurrency, timeout: f.timeout, options: f.options, members: f.members}
+	for _, name := range f.Names() {
+		d, ok := f.Device(name)
+		if !ok {
+			continue
+		}
+		matches := true
+		for _, s := range selectors {
+			if !s(d) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			selected.names = append(selected.names, name)
+		}
+	}
+	return selected
+}
+
+// Do runs fn on every device of the fleet, see Run.
+func (f *Fleet) Do(ctx context.Context, fn func(ctx context.Context, d Device, b *bigip.BigIP) error) error {
+	_, err := Run(ctx, f, func(ctx context.Context, d Device, b *bigip.BigIP) (struct{}, error) {
+		return struct{}{}, fn(ctx, d, b)
+	})
+	return err
+}
+
+// Run runs fn on every device of f, on as many at once as the concurrency of f
+// allows, with the session with the device and a context bounded by the timeout
+// of f. It returns the results of the devices fn succeeded on by name and, if it
+// failed on any, or their session could not be opened, an *Error. Devices that
+// were not operated on yet when ctx is done fail with its error.
+func Run[T any](ctx context.Context, f *Fleet, fn func(ctx context.Context, d Device, b *bigip.BigIP) (T, error)) (map[string]T, error) {
+	names := f.Names()
+	concurrency := f.concurrency
+	if concurrency <= 0 {
+		concurrency = len(names)
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]T, len(names))
+		errs    = make(map[string]error)
+		slots   = make(chan struct{}, concurrency)
+	)
+	for _, name := range names {
+		m, ok := f.member(name)
+		if !ok {
+			continue
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			errs[name] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			result, err := run(ctx, f, m, fn)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[m.device.Name] = err
+				return
+			}
+			results[m.device.Name] = result
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) != 0 {
+		return results, &Error{Devices: len(names), Errors: errs}
+	}
+	return results, nil
+}
+
+// run runs fn on the device of m, opening its session first.
+func run[T any](ctx context.Context, f *Fleet, m *member, fn func(ctx context.Context, d Device, b *bigip.BigIP) (T, error)) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+	if f.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.timeout)
+		defer cancel()
+	}
+	b, err := m.open(ctx, f.options)
+	if err != nil {
+		return zero, err
+	}
+	return fn(ctx, m.device, b)
+}
+
+// Close closes the sessions the fleet opened. The fleet can be used again
+// afterwards, opening new sessions.
+func (f *Fleet) Close() error {
+	f.members.mu.Lock()
+	all := make([]*member, 0, len(f.members.devices))
+	for _, m := range f.members.devices {
+		all = append(all, m)
+	}
+	f.members.mu.Unlock()
+
+	errs := make(map[string]error)
+	for _, m := range all {
+		if err := m.close(); err != nil {
+			errs[m.device.Name] = err
+		}
+	}
+	if len(errs) != 0 {
+		return &Error{Devices: len(all), Errors: errs}
+	}
+	return nil
+}
+
+func (f *Fleet) member(name string) (*member, bool) {
+	f.members.mu.Lock()
+	defer f.members.mu.Unlock()
+	m, ok := f.members.devices[name]
+	return m, ok
+}
+
+// open returns the session with the device, creating it with the options of the
+// fleet followed by those of the device if it is not open yet. The login is made
+// with ctx, and callers waiting for another one to open the session give up when
+// their ctx is done.
+func (m *member) open(ctx context.Context, options []bigip.Option) (*bigip.BigIP, error) {
+	for {
+		m.mu.Lock()
+		if m.session != nil {
+			b := m.session
+			m.mu.Unlock()
+			return b, nil
+		}
+		if o := m.opening; o != nil {
+			m.mu.Unlock()
+			select {
+			case <-o.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			// If opening failed because the context of the caller opening it was
+			// done, which says nothing about this one, try again.
+			if o.err != nil && !errors.Is(o.err, context.Canceled) && !errors.Is(o.err, context.DeadlineExceeded) {
+				return nil, o.err
+			}
+			continue
+		}
+		o := &opening{done: make(chan struct{})}
+		m.opening = o
+		m.mu.Unlock()
+
+		opts := append(append([]bigip.Option(nil), options...), m.device.Options...)
+		b, err := bigip.NewContext(ctx, m.device.Host, opts...)
+		m.mu.Lock()
+		m.opening = nil
+		if err == nil {
+			m.session, m.owned = b, true
+		}
+		m.mu.Unlock()
+		o.err = err
+		close(o.done)
+		return b, err
+	}
+}
+
+// close closes the session with the device if the fleet opened it.
+func (m *member) close() error {
+	m.mu.Lock()
+	b := m.session
+	if !m.owned {
+		m.mu.Unlock()
+		return nil
+	}
+	m.session, m.owned = nil, false
+	m.mu.Unlock()
+	return b.Close()
+}
+
+// Error is returned when an operation failed on some devices of a fleet.
+type Error struct {
+	// Devices is the number of devices operated on.
+	Devices int
+	// Errors holds the error of every device that failed by name.
+	Errors map[string]error
+}
+
+func (e *Error) Error() string {
+	names := make([]string, 0, len(e.Errors))
+	for name := range e.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	msgs := make([]string, len(names))
+	for i, name := range names {
+		msgs[i] = name + ": " + e.Errors[name].Error()
+	}
+	return fmt.Sprintf("fleet: %d of %d devices failed: %s", len(names), e.Devices, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of the devices, so that errors.Is and errors.As match
+// any of them.
+func (e *Error) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
@@ -0,0 +1,153 @@
+package fleet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFleet(t *testing.T) {
+	var devices []Device
+	for i, site := range []string{"fra", "fra", "ams", "ams", "nyc"} {
+		s := bigiptest.NewServer()
+		defer s.Close()
+		s.Add("ltm/pool", map[string]any{"name": "web", "description": fmt.Sprintf("pool %d", i)})
+		d := Device{
+			Name:    fmt.Sprintf("lb%d", i+1),
+			Host:    s.URL,
+			Options: []bigip.Option{bigip.WithBasicAuth(s.Username, s.Password)},
+			Tags:    map[string]string{"site": site},
+		}
+		if i == 4 {
+			d.Options = []bigip.Option{bigip.WithBasicAuth(s.Username, "wrong")}
+		}
+		devices = append(devices, d)
+	}
+	f, err := New(devices, WithConcurrency(2), WithTimeout(500*time.Millisecond), WithSessionOptions(bigip.WithInsecureSkipVerify()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := f.Add(Device{Name: "lb1", Host: "10.0.0.1"}); err == nil {
+		t.Error("expected a duplicate device to be rejected")
+	}
+
+	var inFlight, maxInFlight int32
+	descriptions, err := Run(context.Background(), f, func(ctx context.Context, d Device, b *bigip.BigIP) (string, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		pool, err := b.Dynamic().Resource("ltm/pool").GetContext(ctx, "web")
+		if err != nil {
+			return "", err
+		}
+		return pool["description"].(string), nil
+	})
+	expected := map[string]string{"lb1": "pool 0", "lb2": "pool 1", "lb3": "pool 2", "lb4": "pool 3"}
+	if !reflect.DeepEqual(descriptions, expected) {
+		t.Errorf("expected descriptions %v, got %v", expected, descriptions)
+	}
+	var fleetErr *Error
+	if !errors.As(err, &fleetErr) {
+		t.Fatalf("expected a fleet error, got %v", err)
+	}
+	if len(fleetErr.Errors) != 1 || !errors.Is(fleetErr.Errors["lb5"], bigip.ErrBadCredentials) || !errors.Is(err, bigip.ErrBadCredentials) {
+		t.Errorf("expected lb5 to fail with bad credentials, got %v", err)
+	}
+	if maxInFlight > 2 {
+		t.Errorf("expected 2 devices in flight at most, got %d", maxInFlight)
+	}
+
+	ams := f.Select(Tag("site", "ams", "nyc"), func(d Device) bool { return d.Name != "lb5" })
+	if names := ams.Names(); !reflect.DeepEqual(names, []string{"lb3", "lb4"}) {
+		t.Errorf("expected lb3 and lb4 to be selected, got %q", names)
+	}
+	err = ams.Do(context.Background(), func(ctx context.Context, d Device, b *bigip.BigIP) error {
+		if d.Name == "lb4" {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	})
+	if !errors.As(err, &fleetErr) || len(fleetErr.Errors) != 1 || !errors.Is(fleetErr.Errors["lb4"], context.DeadlineExceeded) {
+		t.Errorf("expected lb4 to time out, got %v", err)
+	}
+	if msg := "fleet: 1 of 2 devices failed: lb4: context deadline exceeded"; err.Error() != msg {
+		t.Errorf("expected error %q, got %q", msg, err)
+	}
+}
+
+func TestRunCancelled(t *testing.T) {
+	var devices []Device
+	for i := 0; i < 4; i++ {
+		devices = append(devices, Device{Name: fmt.Sprintf("lb%d", i+1), Host: "10.0.0.1", Session: &bigip.BigIP{}})
+	}
+	f, err := New(devices, WithConcurrency(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	results, err := Run(ctx, f, func(ctx context.Context, d Device, b *bigip.BigIP) (string, error) {
+		if d.Name == "lb1" {
+			// The other devices are still queued when the run is cancelled.
+			cancel()
+			time.Sleep(10 * time.Millisecond)
+			return "", ctx.Err()
+		}
+		return d.Name, nil
+	})
+	var fleetErr *Error
+	if !errors.As(err, &fleetErr) || len(fleetErr.Errors) != 4 || len(results) != 0 || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the queued devices to be cancelled, got %v and %v", results, err)
+	}
+}
+
+func TestRunTimeoutWhileOpening(t *testing.T) {
+	hang := make(chan struct{})
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-hang:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	defer close(hang)
+
+	var devices []Device
+	for i := 0; i < 3; i++ {
+		devices = append(devices, Device{Name: fmt.Sprintf("lb%d", i+1), Host: ts.URL,
+			Options: []bigip.Option{bigip.WithTokenAuth("admin", "admin", "tmos"), bigip.WithInsecureSkipVerify()}})
+	}
+	f, err := New(devices, WithTimeout(100*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	err = f.Do(context.Background(), func(ctx context.Context, d Device, b *bigip.BigIP) error {
+		return nil
+	})
+	var fleetErr *Error
+	if !errors.As(err, &fleetErr) || len(fleetErr.Errors) != 3 || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected every device to time out, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected the devices to time out while logging in, took %s", elapsed)
+	}
+}
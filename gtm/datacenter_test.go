@@ -2,17 +2,16 @@ package gtm
 
 import (
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
 	"testing"
 )
 
 func TestCRUDDatacenter(t *testing.T) {
-	// Replace these variables with your F5 device details
-	user := "admin"
-	pass := "1qaz@WSX3edc"
-	deviceIP := "192.168.12.21"
+	s := bigiptest.NewServer()
+	defer s.Close()
 
 	// Connect to the device
-	bigIP, err := bigip.NewSession(deviceIP, user, pass)
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Error(err)
 	}
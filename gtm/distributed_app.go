@@ -1,11 +1,7 @@
 package gtm
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // DistributedAppList contains a list of DistributedApp.
@@ -31,82 +27,29 @@ type DistributedApp struct {
 	Wideips         []struct {
 		Name string `json:"name,omitempty"`
 	} `json:"wideips,omitempty"`
+	Extra bigip.Extra `json:"-"`
 }
 
-// DistributedAppEndpoint represents the REST resource for managing DistributedApp.
-const DistributedAppEndpoint = "distributed-app"
-
-// DistributedAppResource provides an API to manage DistributedApp configurations.
-type DistributedAppResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all DistributedApp details.
-func (r *DistributedAppResource) List() (*DistributedAppList, error) {
-	var items DistributedAppList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DistributedAppEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes DistributedApp does not model in Extra.
+func (d *DistributedApp) UnmarshalJSON(data []byte) error {
+	type plain DistributedApp
+	return bigip.UnmarshalExtra(data, (*plain)(d), &d.Extra)
 }
 
-// Get retrieves the details of a single DistributedApp by node name.
-func (r *DistributedAppResource) Get(name string) (*DistributedApp, error) {
-	var item DistributedApp
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DistributedAppEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of DistributedApp.
+func (d DistributedApp) MarshalJSON() ([]byte, error) {
+	type plain DistributedApp
+	return bigip.MarshalExtra(plain(d), d.Extra)
 }
 
-// Create creates a new DistributedApp item.
-func (r *DistributedAppResource) Create(item DistributedApp) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DistributedAppEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// DistributedAppEndpoint represents the REST resource for managing DistributedApp.
+const DistributedAppEndpoint = "distributed-app"
 
-// Update modifies the DistributedApp item identified by the DistributedApp name.
-func (r *DistributedAppResource) Update(name string, item DistributedApp) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DistributedAppEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// DistributedAppResource provides an API to manage DistributedApp configurations.
+type DistributedAppResource struct {
+	bigip.Resource[DistributedApp, DistributedAppList]
 }
 
-// Delete a single DistributedApp identified by the DistributedApp name. If it does not exist, return an error.
-func (r *DistributedAppResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DistributedAppEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newDistributedAppResource(b *bigip.BigIP) DistributedAppResource {
+	return DistributedAppResource{bigip.NewResource[DistributedApp, DistributedAppList](b, GTMManager, DistributedAppEndpoint)}
 }
@@ -10,29 +10,42 @@ import (
 
 // General holds the configuration of a single General.
 type General struct {
-	AutoDiscovery                     string `json:"autoDiscovery,omitempty"`
-	AutoDiscoveryInterval             int    `json:"autoDiscoveryInterval,omitempty"`
-	AutomaticConfigurationSaveTimeout int    `json:"automaticConfigurationSaveTimeout,omitempty"`
-	CacheLdnsServers                  string `json:"cacheLdnsServers,omitempty"`
-	DomainNameCheck                   string `json:"domainNameCheck,omitempty"`
-	DrainPersistentRequests           string `json:"drainPersistentRequests,omitempty"`
-	ForwardStatus                     string `json:"forwardStatus,omitempty"`
-	GtmSetsRecursion                  string `json:"gtmSetsRecursion,omitempty"`
-	HeartbeatInterval                 int    `json:"heartbeatInterval,omitempty"`
-	Kind                              string `json:"kind,omitempty"`
-	MonitorDisabledObjects            string `json:"monitorDisabledObjects,omitempty"`
-	NethsmTimeout                     int    `json:"nethsmTimeout,omitempty"`
-	SelfLink                          string `json:"selfLink,omitempty"`
-	SendWildcardRrs                   string `json:"sendWildcardRrs,omitempty"`
-	StaticPersistCidrIpv4             int    `json:"staticPersistCidrIpv4,omitempty"`
-	StaticPersistCidrIpv6             int    `json:"staticPersistCidrIpv6,omitempty"`
-	Synchronization                   string `json:"synchronization,omitempty"`
-	SynchronizationGroupName          string `json:"synchronizationGroupName,omitempty"`
-	SynchronizationTimeTolerance      int    `json:"synchronizationTimeTolerance,omitempty"`
-	SynchronizationTimeout            int    `json:"synchronizationTimeout,omitempty"`
-	SynchronizeZoneFiles              string `json:"synchronizeZoneFiles,omitempty"`
-	SynchronizeZoneFilesTimeout       int    `json:"synchronizeZoneFilesTimeout,omitempty"`
-	VirtualsDependOnServerState       string `json:"virtualsDependOnServerState,omitempty"`
+	AutoDiscovery                     string      `json:"autoDiscovery,omitempty"`
+	AutoDiscoveryInterval             int         `json:"autoDiscoveryInterval,omitempty"`
+	AutomaticConfigurationSaveTimeout int         `json:"automaticConfigurationSaveTimeout,omitempty"`
+	CacheLdnsServers                  string      `json:"cacheLdnsServers,omitempty"`
+	DomainNameCheck                   string      `json:"domainNameCheck,omitempty"`
+	DrainPersistentRequests           string      `json:"drainPersistentRequests,omitempty"`
+	ForwardStatus                     string      `json:"forwardStatus,omitempty"`
+	GtmSetsRecursion                  string      `json:"gtmSetsRecursion,omitempty"`
+	HeartbeatInterval                 int         `json:"heartbeatInterval,omitempty"`
+	Kind                              string      `json:"kind,omitempty"`
+	MonitorDisabledObjects            string      `json:"monitorDisabledObjects,omitempty"`
+	NethsmTimeout                     int         `json:"nethsmTimeout,omitempty"`
+	SelfLink                          string      `json:"selfLink,omitempty"`
+	SendWildcardRrs                   string      `json:"sendWildcardRrs,omitempty"`
+	StaticPersistCidrIpv4             int         `json:"staticPersistCidrIpv4,omitempty"`
+	StaticPersistCidrIpv6             int         `json:"staticPersistCidrIpv6,omitempty"`
+	Synchronization                   string      `json:"synchronization,omitempty"`
+	SynchronizationGroupName          string      `json:"synchronizationGroupName,omitempty"`
+	SynchronizationTimeTolerance      int         `json:"synchronizationTimeTolerance,omitempty"`
+	SynchronizationTimeout            int         `json:"synchronizationTimeout,omitempty"`
+	SynchronizeZoneFiles              string      `json:"synchronizeZoneFiles,omitempty"`
+	SynchronizeZoneFilesTimeout       int         `json:"synchronizeZoneFilesTimeout,omitempty"`
+	VirtualsDependOnServerState       string      `json:"virtualsDependOnServerState,omitempty"`
+	Extra                             bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes General does not model in Extra.
+func (g *General) UnmarshalJSON(data []byte) error {
+	type plain General
+	return bigip.UnmarshalExtra(data, (*plain)(g), &g.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of General.
+func (g General) MarshalJSON() ([]byte, error) {
+	type plain General
+	return bigip.MarshalExtra(plain(g), g.Extra)
 }
 
 // GlobalSettingsGeneralEndpoint represents the REST resource for managing GlobalSettingsGeneral.
@@ -45,9 +58,14 @@ type GeneralResource struct {
 
 // List  lists all the General configurations.
 func (r *GeneralResource) List() (*General, error) {
+	return r.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (r *GeneralResource) ListContext(ctx context.Context) (*General, error) {
 	var item General
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(GeneralEndpoint).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(GeneralEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -60,13 +78,38 @@ func (r *GeneralResource) List() (*General, error) {
 
 // Update a General configuration.
 func (r *GeneralResource) Update(item General) error {
+	return r.UpdateContext(context.Background(), item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *GeneralResource) UpdateContext(ctx context.Context, item General) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(GeneralEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(GeneralEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (r *GeneralResource) Patch(fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *GeneralResource) PatchContext(ctx context.Context, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(GlobalSettingsEndpoint).SubResource(GeneralEndpoint).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -10,25 +10,38 @@ import (
 
 // LoadBalancing holds the configuration of a single LoadBalancing.
 type LoadBalancing struct {
-	FailureRcode              string `json:"failureRcode,omitempty"`
-	FailureRcodeResponse      string `json:"failureRcodeResponse,omitempty"`
-	FailureRcodeTTL           int    `json:"failureRcodeTtl,omitempty"`
-	IgnorePathTTL             string `json:"ignorePathTtl,omitempty"`
-	Kind                      string `json:"kind,omitempty"`
-	QosFactorBps              int    `json:"qosFactorBps,omitempty"`
-	QosFactorHitRatio         int    `json:"qosFactorHitRatio,omitempty"`
-	QosFactorHops             int    `json:"qosFactorHops,omitempty"`
-	QosFactorLinkCapacity     int    `json:"qosFactorLinkCapacity,omitempty"`
-	QosFactorPacketRate       int    `json:"qosFactorPacketRate,omitempty"`
-	QosFactorRtt              int    `json:"qosFactorRtt,omitempty"`
-	QosFactorTopology         int    `json:"qosFactorTopology,omitempty"`
-	QosFactorVsCapacity       int    `json:"qosFactorVsCapacity,omitempty"`
-	QosFactorVsScore          int    `json:"qosFactorVsScore,omitempty"`
-	RespectFallbackDependency string `json:"respectFallbackDependency,omitempty"`
-	SelfLink                  string `json:"selfLink,omitempty"`
-	TopologyAllowZeroScores   string `json:"topologyAllowZeroScores,omitempty"`
-	TopologyLongestMatch      string `json:"topologyLongestMatch,omitempty"`
-	VerifyVsAvailability      string `json:"verifyVsAvailability,omitempty"`
+	FailureRcode              string      `json:"failureRcode,omitempty"`
+	FailureRcodeResponse      string      `json:"failureRcodeResponse,omitempty"`
+	FailureRcodeTTL           int         `json:"failureRcodeTtl,omitempty"`
+	IgnorePathTTL             string      `json:"ignorePathTtl,omitempty"`
+	Kind                      string      `json:"kind,omitempty"`
+	QosFactorBps              int         `json:"qosFactorBps,omitempty"`
+	QosFactorHitRatio         int         `json:"qosFactorHitRatio,omitempty"`
+	QosFactorHops             int         `json:"qosFactorHops,omitempty"`
+	QosFactorLinkCapacity     int         `json:"qosFactorLinkCapacity,omitempty"`
+	QosFactorPacketRate       int         `json:"qosFactorPacketRate,omitempty"`
+	QosFactorRtt              int         `json:"qosFactorRtt,omitempty"`
+	QosFactorTopology         int         `json:"qosFactorTopology,omitempty"`
+	QosFactorVsCapacity       int         `json:"qosFactorVsCapacity,omitempty"`
+	QosFactorVsScore          int         `json:"qosFactorVsScore,omitempty"`
+	RespectFallbackDependency string      `json:"respectFallbackDependency,omitempty"`
+	SelfLink                  string      `json:"selfLink,omitempty"`
+	TopologyAllowZeroScores   string      `json:"topologyAllowZeroScores,omitempty"`
+	TopologyLongestMatch      string      `json:"topologyLongestMatch,omitempty"`
+	VerifyVsAvailability      string      `json:"verifyVsAvailability,omitempty"`
+	Extra                     bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes LoadBalancing does not model in Extra.
+func (l *LoadBalancing) UnmarshalJSON(data []byte) error {
+	type plain LoadBalancing
+	return bigip.UnmarshalExtra(data, (*plain)(l), &l.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of LoadBalancing.
+func (l LoadBalancing) MarshalJSON() ([]byte, error) {
+	type plain LoadBalancing
+	return bigip.MarshalExtra(plain(l), l.Extra)
 }
 
 // LoadBalancingEndpoint represents the REST resource for managing LoadBalancing.
@@ -41,9 +54,14 @@ type LoadBalancingResource struct {
 
 // List  lists all the LoadBalancing configurations.
 func (r *LoadBalancingResource) List() (*LoadBalancing, error) {
+	return r.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (r *LoadBalancingResource) ListContext(ctx context.Context) (*LoadBalancing, error) {
 	var item LoadBalancing
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(LoadBalancingEndpoint).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(LoadBalancingEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -56,13 +74,38 @@ func (r *LoadBalancingResource) List() (*LoadBalancing, error) {
 
 // Update a LoadBalancing configuration.
 func (r *LoadBalancingResource) Update(item LoadBalancing) error {
+	return r.UpdateContext(context.Background(), item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *LoadBalancingResource) UpdateContext(ctx context.Context, item LoadBalancing) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(LoadBalancingEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(LoadBalancingEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (r *LoadBalancingResource) Patch(fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *LoadBalancingResource) PatchContext(ctx context.Context, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(GlobalSettingsEndpoint).SubResource(LoadBalancingEndpoint).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -10,21 +10,34 @@ import (
 
 // Metrics holds the configuration of a single Metrics.
 type Metrics struct {
-	DefaultProbeLimit             int      `json:"defaultProbeLimit,omitempty"`
-	HopsPacketLength              int      `json:"hopsPacketLength,omitempty"`
-	HopsSampleCount               int      `json:"hopsSampleCount,omitempty"`
-	HopsTimeout                   int      `json:"hopsTimeout,omitempty"`
-	HopsTTL                       int      `json:"hopsTtl,omitempty"`
-	InactiveLdnsTTL               int      `json:"inactiveLdnsTtl,omitempty"`
-	InactivePathsTTL              int      `json:"inactivePathsTtl,omitempty"`
-	Kind                          string   `json:"kind,omitempty"`
-	LdnsUpdateInterval            int      `json:"ldnsUpdateInterval,omitempty"`
-	MaxSynchronousMonitorRequests int      `json:"maxSynchronousMonitorRequests,omitempty"`
-	MetricsCaching                int      `json:"metricsCaching,omitempty"`
-	MetricsCollectionProtocols    []string `json:"metricsCollectionProtocols,omitempty"`
-	PathTTL                       int      `json:"pathTtl,omitempty"`
-	PathsRetry                    int      `json:"pathsRetry,omitempty"`
-	SelfLink                      string   `json:"selfLink,omitempty"`
+	DefaultProbeLimit             int         `json:"defaultProbeLimit,omitempty"`
+	HopsPacketLength              int         `json:"hopsPacketLength,omitempty"`
+	HopsSampleCount               int         `json:"hopsSampleCount,omitempty"`
+	HopsTimeout                   int         `json:"hopsTimeout,omitempty"`
+	HopsTTL                       int         `json:"hopsTtl,omitempty"`
+	InactiveLdnsTTL               int         `json:"inactiveLdnsTtl,omitempty"`
+	InactivePathsTTL              int         `json:"inactivePathsTtl,omitempty"`
+	Kind                          string      `json:"kind,omitempty"`
+	LdnsUpdateInterval            int         `json:"ldnsUpdateInterval,omitempty"`
+	MaxSynchronousMonitorRequests int         `json:"maxSynchronousMonitorRequests,omitempty"`
+	MetricsCaching                int         `json:"metricsCaching,omitempty"`
+	MetricsCollectionProtocols    []string    `json:"metricsCollectionProtocols,omitempty"`
+	PathTTL                       int         `json:"pathTtl,omitempty"`
+	PathsRetry                    int         `json:"pathsRetry,omitempty"`
+	SelfLink                      string      `json:"selfLink,omitempty"`
+	Extra                         bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Metrics does not model in Extra.
+func (m *Metrics) UnmarshalJSON(data []byte) error {
+	type plain Metrics
+	return bigip.UnmarshalExtra(data, (*plain)(m), &m.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Metrics.
+func (m Metrics) MarshalJSON() ([]byte, error) {
+	type plain Metrics
+	return bigip.MarshalExtra(plain(m), m.Extra)
 }
 
 // MetricsEndpoint represents the REST resource for managing Metrics.
@@ -37,9 +50,14 @@ type MetricsResource struct {
 
 // List  lists all the Metrics configurations.
 func (r *MetricsResource) List() (*Metrics, error) {
+	return r.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (r *MetricsResource) ListContext(ctx context.Context) (*Metrics, error) {
 	var item Metrics
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(MetricsEndpoint).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(MetricsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -52,13 +70,38 @@ func (r *MetricsResource) List() (*Metrics, error) {
 
 // Update a Metrics configuration.
 func (r *MetricsResource) Update(item Metrics) error {
+	return r.UpdateContext(context.Background(), item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *MetricsResource) UpdateContext(ctx context.Context, item Metrics) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(MetricsEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(MetricsEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (r *MetricsResource) Patch(fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *MetricsResource) PatchContext(ctx context.Context, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(GlobalSettingsEndpoint).SubResource(MetricsEndpoint).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
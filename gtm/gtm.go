@@ -32,18 +32,18 @@ type GTM struct {
 // New creates a new GTM client.
 func New(b *bigip.BigIP) GTM {
 	return GTM{
-		datacenter:       DatacenterResource{b: b},
+		datacenter:       newDatacenterResource(b),
 		syncStatus:       SyncStatusResource{b: b},
-		distributedApp:   DistributedAppResource{b: b},
-		link:             LinkResource{b: b},
-		listener:         ListenerResource{b: b},
-		listenerProfiles: ListenerProfilesResource{b: b},
+		distributedApp:   newDistributedAppResource(b),
+		link:             newLinkResource(b),
+		listener:         newListenerResource(b),
+		listenerProfiles: newListenerProfilesResource(b),
 		persist:          PersistResource{b: b},
-		proberPool:       ProberPoolResource{b: b},
-		region:           RegionResource{b: b},
-		rule:             RuleResource{b: b},
-		server:           ServerResource{b: b},
-		topology:         TopologyResource{b: b},
+		proberPool:       newProberPoolResource(b),
+		region:           newRegionResource(b),
+		rule:             newRuleResource(b),
+		server:           newServerResource(b),
+		topology:         newTopologyResource(b),
 
 		globalSettings: global_settings.NewGlobalSettings(b),
 		wideip:         wideip.NewWideip(b),
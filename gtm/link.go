@@ -1,11 +1,7 @@
 package gtm
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // LinkList holds a list of Link configuration.
@@ -41,85 +37,32 @@ type Link struct {
 		Name        string `json:"name,omitempty"`
 		Translation string `json:"translation,omitempty"`
 	} `json:"routerAddresses,omitempty"`
-	SelfLink      string `json:"selfLink,omitempty"`
-	UplinkAddress string `json:"uplinkAddress,omitempty"`
-	Weighting     string `json:"weighting,omitempty"`
+	SelfLink      string      `json:"selfLink,omitempty"`
+	UplinkAddress string      `json:"uplinkAddress,omitempty"`
+	Weighting     string      `json:"weighting,omitempty"`
+	Extra         bigip.Extra `json:"-"`
 }
 
-// LinkEndpoint represents the REST resource for managing Link.
-const LinkEndpoint = "link"
-
-// LinkResource provides an API to manage Link configurations.
-type LinkResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all Link details.
-func (r *LinkResource) List() (*LinkList, error) {
-	var items LinkList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(LinkEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Link does not model in Extra.
+func (l *Link) UnmarshalJSON(data []byte) error {
+	type plain Link
+	return bigip.UnmarshalExtra(data, (*plain)(l), &l.Extra)
 }
 
-// Get retrieves the details of a single Link by node name.
-func (r *LinkResource) Get(name string) (*Link, error) {
-	var item Link
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(LinkEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of Link.
+func (l Link) MarshalJSON() ([]byte, error) {
+	type plain Link
+	return bigip.MarshalExtra(plain(l), l.Extra)
 }
 
-// Create creates a new Link item.
-func (r *LinkResource) Create(item Link) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(LinkEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// LinkEndpoint represents the REST resource for managing Link.
+const LinkEndpoint = "link"
 
-// Update modifies the Link item identified by the Link name.
-func (r *LinkResource) Update(name string, item Link) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(LinkEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// LinkResource provides an API to manage Link configurations.
+type LinkResource struct {
+	bigip.Resource[Link, LinkList]
 }
 
-// Delete a single Link identified by the Link name. If it does not exist, return an error.
-func (r *LinkResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(LinkEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newLinkResource(b *bigip.BigIP) LinkResource {
+	return LinkResource{bigip.NewResource[Link, LinkList](b, GTMManager, LinkEndpoint)}
 }
@@ -1,11 +1,7 @@
 package gtm
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ListenerProfilesList holds a list of ListenerProfiles configurations.
@@ -24,82 +20,29 @@ type ListenerProfiles struct {
 	Generation int    `json:"generation,omitempty"`
 	SelfLink   string `json:"selfLink,omitempty"`
 	// ... Add other ListenerProfiles specific fields
+	Extra bigip.Extra `json:"-"`
 }
 
-// ListenerProfilesEndpoint represents the REST resource for managing ListenerProfiles.
-const ListenerProfilesEndpoint = "listenerprofiles"
-
-// ListenerProfilesResource provides an API to manage ListenerProfiles configurations.
-type ListenerProfilesResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all ListenerProfiles details.
-func (r *ListenerProfilesResource) List() (*ListenerProfilesList, error) {
-	var items ListenerProfilesList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerProfilesEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes ListenerProfiles does not model in Extra.
+func (l *ListenerProfiles) UnmarshalJSON(data []byte) error {
+	type plain ListenerProfiles
+	return bigip.UnmarshalExtra(data, (*plain)(l), &l.Extra)
 }
 
-// Get retrieves the details of a single ListenerProfiles by node name.
-func (r *ListenerProfilesResource) Get(name string) (*ListenerProfiles, error) {
-	var item ListenerProfiles
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerProfilesEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of ListenerProfiles.
+func (l ListenerProfiles) MarshalJSON() ([]byte, error) {
+	type plain ListenerProfiles
+	return bigip.MarshalExtra(plain(l), l.Extra)
 }
 
-// Create creates a new ListenerProfiles item.
-func (r *ListenerProfilesResource) Create(item ListenerProfiles) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerProfilesEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// ListenerProfilesEndpoint represents the REST resource for managing ListenerProfiles.
+const ListenerProfilesEndpoint = "listenerprofiles"
 
-// Update modifies the ListenerProfiles item identified by the ListenerProfiles name.
-func (r *ListenerProfilesResource) Update(name string, item ListenerProfiles) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerProfilesEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// ListenerProfilesResource provides an API to manage ListenerProfiles configurations.
+type ListenerProfilesResource struct {
+	bigip.Resource[ListenerProfiles, ListenerProfilesList]
 }
 
-// Delete a single ListenerProfiles identified by the ListenerProfiles name. If it does not exist, return an error.
-func (r *ListenerProfilesResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerProfilesEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newListenerProfilesResource(b *bigip.BigIP) ListenerProfilesResource {
+	return ListenerProfilesResource{bigip.NewResource[ListenerProfiles, ListenerProfilesList](b, GTMManager, ListenerProfilesEndpoint)}
 }
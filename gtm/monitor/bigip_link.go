@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // BigIPLinkList holds a list of BigIPLink uration.
@@ -17,97 +13,39 @@ type BigIPLinkList struct {
 
 // BigIPLink holds the uration of a single BigIPLink.
 type BigIPLink struct {
-	Destination        string `json:"destination,omitempty"`
-	FullPath           string `json:"fullPath,omitempty"`
-	Generation         int    `json:"generation,omitempty"`
-	IgnoreDownResponse string `json:"ignoreDownResponse,omitempty"`
-	Interval           int    `json:"interval,omitempty"`
-	Kind               string `json:"kind,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Partition          string `json:"partition,omitempty"`
-	SelfLink           string `json:"selfLink,omitempty"`
-	Timeout            int    `json:"timeout,omitempty"`
+	Destination        string      `json:"destination,omitempty"`
+	FullPath           string      `json:"fullPath,omitempty"`
+	Generation         int         `json:"generation,omitempty"`
+	IgnoreDownResponse string      `json:"ignoreDownResponse,omitempty"`
+	Interval           int         `json:"interval,omitempty"`
+	Kind               string      `json:"kind,omitempty"`
+	Name               string      `json:"name,omitempty"`
+	Partition          string      `json:"partition,omitempty"`
+	SelfLink           string      `json:"selfLink,omitempty"`
+	Timeout            int         `json:"timeout,omitempty"`
+	Extra              bigip.Extra `json:"-"`
 }
 
-// BigIPLinkEndpoint represents the REST resource for managing BigIPLink.
-const BigIPLinkEndpoint = "bigip-link"
-
-// BigIPLinkResource provides an API to manage BigIPLink urations.
-type BigIPLinkResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes BigIPLink does not model in Extra.
+func (b *BigIPLink) UnmarshalJSON(data []byte) error {
+	type plain BigIPLink
+	return bigip.UnmarshalExtra(data, (*plain)(b), &b.Extra)
 }
 
-// List returns a list of all BigIPLinkList resources
-func (r *BigIPLinkResource) List() (*BigIPLinkList, error) {
-	var mdcl BigIPLinkList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPLinkEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// MarshalJSON adds the attributes in Extra to those of BigIPLink.
+func (b BigIPLink) MarshalJSON() ([]byte, error) {
+	type plain BigIPLink
+	return bigip.MarshalExtra(plain(b), b.Extra)
 }
 
-// Get returns a specific BigIPLink resource identified by its fullPathName
-func (r *BigIPLinkResource) Get(fullPathName string) (*BigIPLink, error) {
-	var mdc BigIPLink
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPLinkEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
-}
-
-// Create adds a new BigIPLink resource provided by the item
-func (r *BigIPLinkResource) Create(item BigIPLink) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPLinkEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// BigIPLinkEndpoint represents the REST resource for managing BigIPLink.
+const BigIPLinkEndpoint = "bigip-link"
 
-// Update modifies an existing BigIPLink resource identified by name using the provided item
-func (r *BigIPLinkResource) Update(name string, item BigIPLink) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPLinkEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// BigIPLinkResource provides an API to manage BigIPLink urations.
+type BigIPLinkResource struct {
+	bigip.Resource[BigIPLink, BigIPLinkList]
 }
 
-// Delete removes a BigIPLink resource identified by its name
-func (r *BigIPLinkResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPLinkEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newBigIPLinkResource(b *bigip.BigIP) BigIPLinkResource {
+	return BigIPLinkResource{bigip.NewResource[BigIPLink, BigIPLinkList](b, GTMManager, MonitorEndpoint, BigIPLinkEndpoint)}
 }
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ExternalList holds a list of External uration.
@@ -17,98 +13,40 @@ type ExternalList struct {
 
 // External holds the uration of a single External.
 type External struct {
-	Destination        string `json:"destination,omitempty"`
-	FullPath           string `json:"fullPath,omitempty"`
-	Generation         int    `json:"generation,omitempty"`
-	IgnoreDownResponse string `json:"ignoreDownResponse,omitempty"`
-	Interval           int    `json:"interval,omitempty"`
-	Kind               string `json:"kind,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Partition          string `json:"partition,omitempty"`
-	ProbeTimeout       int    `json:"probeTimeout,omitempty"`
-	SelfLink           string `json:"selfLink,omitempty"`
-	Timeout            int    `json:"timeout,omitempty"`
+	Destination        string      `json:"destination,omitempty"`
+	FullPath           string      `json:"fullPath,omitempty"`
+	Generation         int         `json:"generation,omitempty"`
+	IgnoreDownResponse string      `json:"ignoreDownResponse,omitempty"`
+	Interval           int         `json:"interval,omitempty"`
+	Kind               string      `json:"kind,omitempty"`
+	Name               string      `json:"name,omitempty"`
+	Partition          string      `json:"partition,omitempty"`
+	ProbeTimeout       int         `json:"probeTimeout,omitempty"`
+	SelfLink           string      `json:"selfLink,omitempty"`
+	Timeout            int         `json:"timeout,omitempty"`
+	Extra              bigip.Extra `json:"-"`
 }
 
-// ExternalEndpoint represents the REST resource for managing External.
-const ExternalEndpoint = "external"
-
-// ExternalResource provides an API to manage External urations.
-type ExternalResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes External does not model in Extra.
+func (e *External) UnmarshalJSON(data []byte) error {
+	type plain External
+	return bigip.UnmarshalExtra(data, (*plain)(e), &e.Extra)
 }
 
-// List returns a list of all ExternalList resources
-func (r *ExternalResource) List() (*ExternalList, error) {
-	var mdcl ExternalList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// MarshalJSON adds the attributes in Extra to those of External.
+func (e External) MarshalJSON() ([]byte, error) {
+	type plain External
+	return bigip.MarshalExtra(plain(e), e.Extra)
 }
 
-// Get returns a specific External resource identified by its fullPathName
-func (r *ExternalResource) Get(fullPathName string) (*External, error) {
-	var mdc External
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
-}
-
-// Create adds a new External resource provided by the item
-func (r *ExternalResource) Create(item External) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// ExternalEndpoint represents the REST resource for managing External.
+const ExternalEndpoint = "external"
 
-// Update modifies an existing External resource identified by name using the provided item
-func (r *ExternalResource) Update(name string, item External) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// ExternalResource provides an API to manage External urations.
+type ExternalResource struct {
+	bigip.Resource[External, ExternalList]
 }
 
-// Delete removes a External resource identified by its name
-func (r *ExternalResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newExternalResource(b *bigip.BigIP) ExternalResource {
+	return ExternalResource{bigip.NewResource[External, ExternalList](b, GTMManager, MonitorEndpoint, ExternalEndpoint)}
 }
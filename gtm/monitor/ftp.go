@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // FTPList holds a list of FTP uration.
@@ -17,19 +13,32 @@ type FTPList struct {
 
 // FTP holds the uration of a single FTP.
 type FTP struct {
-	Debug              string `json:"debug,omitempty"`
-	Destination        string `json:"destination,omitempty"`
-	FullPath           string `json:"fullPath,omitempty"`
-	Generation         int    `json:"generation,omitempty"`
-	IgnoreDownResponse string `json:"ignoreDownResponse,omitempty"`
-	Interval           int    `json:"interval,omitempty"`
-	Kind               string `json:"kind,omitempty"`
-	Mode               string `json:"mode,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Partition          string `json:"partition,omitempty"`
-	ProbeTimeout       int    `json:"probeTimeout,omitempty"`
-	SelfLink           string `json:"selfLink,omitempty"`
-	Timeout            int    `json:"timeout,omitempty"`
+	Debug              string      `json:"debug,omitempty"`
+	Destination        string      `json:"destination,omitempty"`
+	FullPath           string      `json:"fullPath,omitempty"`
+	Generation         int         `json:"generation,omitempty"`
+	IgnoreDownResponse string      `json:"ignoreDownResponse,omitempty"`
+	Interval           int         `json:"interval,omitempty"`
+	Kind               string      `json:"kind,omitempty"`
+	Mode               string      `json:"mode,omitempty"`
+	Name               string      `json:"name,omitempty"`
+	Partition          string      `json:"partition,omitempty"`
+	ProbeTimeout       int         `json:"probeTimeout,omitempty"`
+	SelfLink           string      `json:"selfLink,omitempty"`
+	Timeout            int         `json:"timeout,omitempty"`
+	Extra              bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes FTP does not model in Extra.
+func (f *FTP) UnmarshalJSON(data []byte) error {
+	type plain FTP
+	return bigip.UnmarshalExtra(data, (*plain)(f), &f.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of FTP.
+func (f FTP) MarshalJSON() ([]byte, error) {
+	type plain FTP
+	return bigip.MarshalExtra(plain(f), f.Extra)
 }
 
 // FTPEndpoint represents the REST resource for managing FTP.
@@ -37,80 +46,9 @@ const FTPEndpoint = "/monitor/ftp"
 
 // FTPResource provides an API to manage FTP urations.
 type FTPResource struct {
-	b *bigip.BigIP
+	bigip.Resource[FTP, FTPList]
 }
 
-// List returns a list of all FTPList resources
-func (r *FTPResource) List() (*FTPList, error) {
-	var mdcl FTPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
-}
-
-// Get returns a specific FTP resource identified by its fullPathName
-func (r *FTPResource) Get(fullPathName string) (*FTP, error) {
-	var mdc FTP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
-}
-
-// Create adds a new FTP resource provided by the item
-func (r *FTPResource) Create(item FTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Update modifies an existing FTP resource identified by name using the provided item
-func (r *FTPResource) Update(name string, item FTP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete removes a FTP resource identified by its name
-func (r *FTPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newFTPResource(b *bigip.BigIP) FTPResource {
+	return FTPResource{bigip.NewResource[FTP, FTPList](b, GTMManager, MonitorEndpoint, FTPEndpoint)}
 }
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // HTTPList holds a list of HTTP uration.
@@ -17,20 +13,33 @@ type HTTPList struct {
 
 // HTTP holds the uration of a single HTTP.
 type HTTP struct {
-	Destination        string `json:"destination,omitempty"`
-	FullPath           string `json:"fullPath,omitempty"`
-	Generation         int    `json:"generation,omitempty"`
-	IgnoreDownResponse string `json:"ignoreDownResponse,omitempty"`
-	Interval           int    `json:"interval,omitempty"`
-	Kind               string `json:"kind,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Partition          string `json:"partition,omitempty"`
-	ProbeTimeout       int    `json:"probeTimeout,omitempty"`
-	Reverse            string `json:"reverse,omitempty"`
-	SelfLink           string `json:"selfLink,omitempty"`
-	Send               string `json:"send,omitempty"`
-	Timeout            int    `json:"timeout,omitempty"`
-	Transparent        string `json:"transparent,omitempty"`
+	Destination        string      `json:"destination,omitempty"`
+	FullPath           string      `json:"fullPath,omitempty"`
+	Generation         int         `json:"generation,omitempty"`
+	IgnoreDownResponse string      `json:"ignoreDownResponse,omitempty"`
+	Interval           int         `json:"interval,omitempty"`
+	Kind               string      `json:"kind,omitempty"`
+	Name               string      `json:"name,omitempty"`
+	Partition          string      `json:"partition,omitempty"`
+	ProbeTimeout       int         `json:"probeTimeout,omitempty"`
+	Reverse            string      `json:"reverse,omitempty"`
+	SelfLink           string      `json:"selfLink,omitempty"`
+	Send               string      `json:"send,omitempty"`
+	Timeout            int         `json:"timeout,omitempty"`
+	Transparent        string      `json:"transparent,omitempty"`
+	Extra              bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes HTTP does not model in Extra.
+func (h *HTTP) UnmarshalJSON(data []byte) error {
+	type plain HTTP
+	return bigip.UnmarshalExtra(data, (*plain)(h), &h.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of HTTP.
+func (h HTTP) MarshalJSON() ([]byte, error) {
+	type plain HTTP
+	return bigip.MarshalExtra(plain(h), h.Extra)
 }
 
 // HTTPEndpoint represents the REST resource for managing HTTP.
@@ -38,80 +47,9 @@ const HTTPEndpoint = "http"
 
 // HTTPResource provides an API to manage HTTP urations.
 type HTTPResource struct {
-	b *bigip.BigIP
+	bigip.Resource[HTTP, HTTPList]
 }
 
-// List returns a list of all HTTPList resources
-func (r *HTTPResource) List() (*HTTPList, error) {
-	var mdcl HTTPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
-}
-
-// Get returns a specific HTTP resource identified by its fullPathName
-func (r *HTTPResource) Get(fullPathName string) (*HTTP, error) {
-	var mdc HTTP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
-}
-
-// Create adds a new HTTP resource provided by the item
-func (r *HTTPResource) Create(item HTTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Update modifies an existing HTTP resource identified by name using the provided item
-func (r *HTTPResource) Update(name string, item HTTP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete removes a HTTP resource identified by its name
-func (r *HTTPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newHTTPResource(b *bigip.BigIP) HTTPResource {
+	return HTTPResource{bigip.NewResource[HTTP, HTTPList](b, GTMManager, MonitorEndpoint, HTTPEndpoint)}
 }
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // HTTPSList holds a list of HTTPS uration.
@@ -17,22 +13,35 @@ type HTTPSList struct {
 
 // HTTPS holds the uration of a single HTTPS.
 type HTTPS struct {
-	Cipherlist         string `json:"cipherlist,omitempty"`
-	Compatibility      string `json:"compatibility,omitempty"`
-	Destination        string `json:"destination,omitempty"`
-	FullPath           string `json:"fullPath,omitempty"`
-	Generation         int    `json:"generation,omitempty"`
-	IgnoreDownResponse string `json:"ignoreDownResponse,omitempty"`
-	Interval           int    `json:"interval,omitempty"`
-	Kind               string `json:"kind,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Partition          string `json:"partition,omitempty"`
-	ProbeTimeout       int    `json:"probeTimeout,omitempty"`
-	Reverse            string `json:"reverse,omitempty"`
-	SelfLink           string `json:"selfLink,omitempty"`
-	Send               string `json:"send,omitempty"`
-	Timeout            int    `json:"timeout,omitempty"`
-	Transparent        string `json:"transparent,omitempty"`
+	Cipherlist         string      `json:"cipherlist,omitempty"`
+	Compatibility      string      `json:"compatibility,omitempty"`
+	Destination        string      `json:"destination,omitempty"`
+	FullPath           string      `json:"fullPath,omitempty"`
+	Generation         int         `json:"generation,omitempty"`
+	IgnoreDownResponse string      `json:"ignoreDownResponse,omitempty"`
+	Interval           int         `json:"interval,omitempty"`
+	Kind               string      `json:"kind,omitempty"`
+	Name               string      `json:"name,omitempty"`
+	Partition          string      `json:"partition,omitempty"`
+	ProbeTimeout       int         `json:"probeTimeout,omitempty"`
+	Reverse            string      `json:"reverse,omitempty"`
+	SelfLink           string      `json:"selfLink,omitempty"`
+	Send               string      `json:"send,omitempty"`
+	Timeout            int         `json:"timeout,omitempty"`
+	Transparent        string      `json:"transparent,omitempty"`
+	Extra              bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes HTTPS does not model in Extra.
+func (h *HTTPS) UnmarshalJSON(data []byte) error {
+	type plain HTTPS
+	return bigip.UnmarshalExtra(data, (*plain)(h), &h.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of HTTPS.
+func (h HTTPS) MarshalJSON() ([]byte, error) {
+	type plain HTTPS
+	return bigip.MarshalExtra(plain(h), h.Extra)
 }
 
 // HTTPSEndpoint represents the REST resource for managing HTTPS.
@@ -40,80 +49,9 @@ const HTTPSEndpoint = "https"
 
 // HTTPSResource provides an API to manage HTTPS urations.
 type HTTPSResource struct {
-	b *bigip.BigIP
+	bigip.Resource[HTTPS, HTTPSList]
 }
 
-// List returns a list of all HTTPSList resources
-func (r *HTTPSResource) List() (*HTTPSList, error) {
-	var mdcl HTTPSList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
-}
-
-// Get returns a specific HTTPS resource identified by its fullPathName
-func (r *HTTPSResource) Get(fullPathName string) (*HTTPS, error) {
-	var mdc HTTPS
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
-}
-
-// Create adds a new HTTPS resource provided by the item
-func (r *HTTPSResource) Create(item HTTPS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Update modifies an existing HTTPS resource identified by name using the provided item
-func (r *HTTPSResource) Update(name string, item HTTPS) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete removes a HTTPS resource identified by its name
-func (r *HTTPSResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newHTTPSResource(b *bigip.BigIP) HTTPSResource {
+	return HTTPSResource{bigip.NewResource[HTTPS, HTTPSList](b, GTMManager, MonitorEndpoint, HTTPSEndpoint)}
 }
@@ -45,38 +45,38 @@ type MonitorResource struct {
 // NewMonitor constructs a new instance of MonitorResource with a given bigip.BigIP instance
 func NewMonitor(b *bigip.BigIP) MonitorResource {
 	return MonitorResource{
-		bigip:            BigIPResource{b: b},
-		bigIPLink:        BigIPLinkResource{b: b},
-		external:         ExternalResource{b: b},
-		ftp:              FTPResource{b: b},
-		firepass:         FirepassResource{b: b},
-		gtp:              GTPResource{b: b},
-		http:             HTTPResource{b: b},
-		https:            HTTPSResource{b: b},
-		icmp:             ICMPResource{b: b},
-		imap:             IMAPResource{b: b},
-		ldap:             LDAPResource{b: b},
-		mssql:            MSSQLResource{b: b},
-		mysql:            MySQLResource{b: b},
-		nntp:             NNTPResource{b: b},
-		none:             NoneResource{b: b},
-		oracle:           OracleResource{b: b},
-		pop3:             POP3Resource{b: b},
-		postgreSQL:       PostgreSQLResource{b: b},
-		radius:           RadiusResource{b: b},
-		radiusAccounting: RadiusAccountingResource{b: b},
-		realServer:       RealServerResource{b: b},
-		sip:              SIPResource{b: b},
-		smtp:             SMTPResource{b: b},
-		snmp:             SNMPResource{b: b},
-		snmpLink:         SNMPLinkResource{b: b},
-		soap:             SOAPResource{b: b},
-		tcp:              TCPResource{b: b},
-		tcpHalf:          TCPHalfResource{b: b},
-		udp:              UDPResource{b: b},
-		wap:              WAPResource{b: b},
-		wmi:              WMIResource{b: b},
-		scripted:         ScriptedResource{b: b},
+		bigip:            newBigIPResource(b),
+		bigIPLink:        newBigIPLinkResource(b),
+		external:         newExternalResource(b),
+		ftp:              newFTPResource(b),
+		firepass:         newFirepassResource(b),
+		gtp:              newGTPResource(b),
+		http:             newHTTPResource(b),
+		https:            newHTTPSResource(b),
+		icmp:             newICMPResource(b),
+		imap:             newIMAPResource(b),
+		ldap:             newLDAPResource(b),
+		mssql:            newMSSQLResource(b),
+		mysql:            newMySQLResource(b),
+		nntp:             newNNTPResource(b),
+		none:             newNoneResource(b),
+		oracle:           newOracleResource(b),
+		pop3:             newPOP3Resource(b),
+		postgreSQL:       newPostgreSQLResource(b),
+		radius:           newRadiusResource(b),
+		radiusAccounting: newRadiusAccountingResource(b),
+		realServer:       newRealServerResource(b),
+		sip:              newSIPResource(b),
+		smtp:             newSMTPResource(b),
+		snmp:             newSNMPResource(b),
+		snmpLink:         newSNMPLinkResource(b),
+		soap:             newSOAPResource(b),
+		tcp:              newTCPResource(b),
+		tcpHalf:          newTCPHalfResource(b),
+		udp:              newUDPResource(b),
+		wap:              newWAPResource(b),
+		wmi:              newWMIResource(b),
+		scripted:         newScriptedResource(b),
 	}
 }
 
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // RadiusAccountingList holds a list of RadiusAccounting configuration.
@@ -17,99 +13,41 @@ type RadiusAccountingList struct {
 
 // RadiusAccounting holds the configuration of a single RadiusAccounting.
 type RadiusAccounting struct {
-	Debug              string `json:"debug,omitempty"`
-	Destination        string `json:"destination,omitempty"`
-	FullPath           string `json:"fullPath,omitempty"`
-	Generation         int    `json:"generation,omitempty"`
-	IgnoreDownResponse string `json:"ignoreDownResponse,omitempty"`
-	Interval           int    `json:"interval,omitempty"`
-	Kind               string `json:"kind,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Partition          string `json:"partition,omitempty"`
-	ProbeTimeout       int    `json:"probeTimeout,omitempty"`
-	SelfLink           string `json:"selfLink,omitempty"`
-	Timeout            int    `json:"timeout,omitempty"`
+	Debug              string      `json:"debug,omitempty"`
+	Destination        string      `json:"destination,omitempty"`
+	FullPath           string      `json:"fullPath,omitempty"`
+	Generation         int         `json:"generation,omitempty"`
+	IgnoreDownResponse string      `json:"ignoreDownResponse,omitempty"`
+	Interval           int         `json:"interval,omitempty"`
+	Kind               string      `json:"kind,omitempty"`
+	Name               string      `json:"name,omitempty"`
+	Partition          string      `json:"partition,omitempty"`
+	ProbeTimeout       int         `json:"probeTimeout,omitempty"`
+	SelfLink           string      `json:"selfLink,omitempty"`
+	Timeout            int         `json:"timeout,omitempty"`
+	Extra              bigip.Extra `json:"-"`
 }
 
-// RadiusAccountingEndpoint represents the REST resource for managing RadiusAccounting.
-const RadiusAccountingEndpoint = "/monitor/radius-accounting"
-
-// RadiusAccountingResource provides an API to manage RadiusAccounting configurations.
-type RadiusAccountingResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes RadiusAccounting does not model in Extra.
+func (r *RadiusAccounting) UnmarshalJSON(data []byte) error {
+	type plain RadiusAccounting
+	return bigip.UnmarshalExtra(data, (*plain)(r), &r.Extra)
 }
 
-// List returns a list of all RadiusAccountingList resources
-func (r *RadiusAccountingResource) List() (*RadiusAccountingList, error) {
-	var mdcl RadiusAccountingList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// MarshalJSON adds the attributes in Extra to those of RadiusAccounting.
+func (r RadiusAccounting) MarshalJSON() ([]byte, error) {
+	type plain RadiusAccounting
+	return bigip.MarshalExtra(plain(r), r.Extra)
 }
 
-// Get returns a specific RadiusAccounting resource identified by its fullPathName
-func (r *RadiusAccountingResource) Get(fullPathName string) (*RadiusAccounting, error) {
-	var mdc RadiusAccounting
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
-}
-
-// Create adds a new RadiusAccounting resource provided by the item
-func (r *RadiusAccountingResource) Create(item RadiusAccounting) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// RadiusAccountingEndpoint represents the REST resource for managing RadiusAccounting.
+const RadiusAccountingEndpoint = "/monitor/radius-accounting"
 
-// Update modifies an existing RadiusAccounting resource identified by name using the provided item
-func (r *RadiusAccountingResource) Update(name string, item RadiusAccounting) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// RadiusAccountingResource provides an API to manage RadiusAccounting configurations.
+type RadiusAccountingResource struct {
+	bigip.Resource[RadiusAccounting, RadiusAccountingList]
 }
 
-// Delete removes a RadiusAccounting resource identified by its name
-func (r *RadiusAccountingResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newRadiusAccountingResource(b *bigip.BigIP) RadiusAccountingResource {
+	return RadiusAccountingResource{bigip.NewResource[RadiusAccounting, RadiusAccountingList](b, GTMManager, MonitorEndpoint, RadiusAccountingEndpoint)}
 }
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // SNMPLinkList holds a list of SNMPLink configuration.
@@ -17,22 +13,35 @@ type SNMPLinkList struct {
 
 // SNMPLink holds the configuration of a single SNMPLink.
 type SNMPLink struct {
-	Community          string `json:"community,omitempty"`
-	Destination        string `json:"destination,omitempty"`
-	FullPath           string `json:"fullPath,omitempty"`
-	Generation         int    `json:"generation,omitempty"`
-	IgnoreDownResponse string `json:"ignoreDownResponse,omitempty"`
-	Interval           int    `json:"interval,omitempty"`
-	Kind               string `json:"kind,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Partition          string `json:"partition,omitempty"`
-	Port               int    `json:"port,omitempty"`
-	ProbeAttempts      int    `json:"probeAttempts,omitempty"`
-	ProbeInterval      int    `json:"probeInterval,omitempty"`
-	ProbeTimeout       int    `json:"probeTimeout,omitempty"`
-	SelfLink           string `json:"selfLink,omitempty"`
-	Timeout            int    `json:"timeout,omitempty"`
-	Version            string `json:"version,omitempty"`
+	Community          string      `json:"community,omitempty"`
+	Destination        string      `json:"destination,omitempty"`
+	FullPath           string      `json:"fullPath,omitempty"`
+	Generation         int         `json:"generation,omitempty"`
+	IgnoreDownResponse string      `json:"ignoreDownResponse,omitempty"`
+	Interval           int         `json:"interval,omitempty"`
+	Kind               string      `json:"kind,omitempty"`
+	Name               string      `json:"name,omitempty"`
+	Partition          string      `json:"partition,omitempty"`
+	Port               int         `json:"port,omitempty"`
+	ProbeAttempts      int         `json:"probeAttempts,omitempty"`
+	ProbeInterval      int         `json:"probeInterval,omitempty"`
+	ProbeTimeout       int         `json:"probeTimeout,omitempty"`
+	SelfLink           string      `json:"selfLink,omitempty"`
+	Timeout            int         `json:"timeout,omitempty"`
+	Version            string      `json:"version,omitempty"`
+	Extra              bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes SNMPLink does not model in Extra.
+func (s *SNMPLink) UnmarshalJSON(data []byte) error {
+	type plain SNMPLink
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of SNMPLink.
+func (s SNMPLink) MarshalJSON() ([]byte, error) {
+	type plain SNMPLink
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
 // SNMPLinkEndpoint represents the REST resource for managing SNMPLink.
@@ -40,80 +49,9 @@ const SNMPLinkEndpoint = "snmp-link"
 
 // SNMPLinkResource provides an API to manage SNMPLink configurations.
 type SNMPLinkResource struct {
-	b *bigip.BigIP
+	bigip.Resource[SNMPLink, SNMPLinkList]
 }
 
-// List returns a list of all SNMPLinkList resources
-func (r *SNMPLinkResource) List() (*SNMPLinkList, error) {
-	var mdcl SNMPLinkList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPLinkEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
-}
-
-// Get returns a specific SNMPLink resource identified by its fullPathName
-func (r *SNMPLinkResource) Get(fullPathName string) (*SNMPLink, error) {
-	var mdc SNMPLink
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPLinkEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
-}
-
-// Create adds a new SNMPLink resource provided by the item
-func (r *SNMPLinkResource) Create(item SNMPLink) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPLinkEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Update modifies an existing SNMPLink resource identified by name using the provided item
-func (r *SNMPLinkResource) Update(name string, item SNMPLink) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPLinkEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete removes a SNMPLink resource identified by its name
-func (r *SNMPLinkResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPLinkEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newSNMPLinkResource(b *bigip.BigIP) SNMPLinkResource {
+	return SNMPLinkResource{bigip.NewResource[SNMPLink, SNMPLinkList](b, GTMManager, MonitorEndpoint, SNMPLinkEndpoint)}
 }
@@ -19,8 +19,21 @@ type Persist struct {
 	APIRawValues struct {
 		APIAnonymous string `json:"apiAnonymous,omitempty"`
 	} `json:"apiRawValues,omitempty"`
-	Kind     string `json:"kind,omitempty"`
-	SelfLink string `json:"selfLink,omitempty"`
+	Kind     string      `json:"kind,omitempty"`
+	SelfLink string      `json:"selfLink,omitempty"`
+	Extra    bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Persist does not model in Extra.
+func (p *Persist) UnmarshalJSON(data []byte) error {
+	type plain Persist
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Persist.
+func (p Persist) MarshalJSON() ([]byte, error) {
+	type plain Persist
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
 // PersistEndpoint represents the REST resource for managing Persist.
@@ -32,10 +45,16 @@ type PersistResource struct {
 }
 
 // List retrieves all Persist details.
-func (r *PersistResource) List() (*PersistList, error) {
+func (r *PersistResource) List(opts ...bigip.ListOptions) (*PersistList, error) {
+	return r.ListContext(context.Background(), opts...)
+}
+
+// ListContext is like List but uses ctx for the request.
+func (r *PersistResource) ListContext(ctx context.Context, opts ...bigip.ListOptions) (*PersistList, error) {
 	var items PersistList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PersistEndpoint).DoRaw(context.Background())
+	req := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(PersistEndpoint)
+	res, err := bigip.ApplyListOptions(req, opts...).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
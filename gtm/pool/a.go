@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // PoolList holds a list of Pool configuration.
@@ -17,43 +16,58 @@ type PoolList struct {
 
 // Pool holds the configuration of a single Pool.
 type Pool struct {
-	AlternateMode             string `json:"alternateMode,omitempty"`
-	DynamicRatio              string `json:"dynamicRatio,omitempty"`
-	Enabled                   bool   `json:"enabled,omitempty"`
-	FallbackIP                string `json:"fallbackIp,omitempty"`
-	FallbackMode              string `json:"fallbackMode,omitempty"`
-	FullPath                  string `json:"fullPath,omitempty"`
-	Generation                int    `json:"generation,omitempty"`
-	Kind                      string `json:"kind,omitempty"`
-	LimitMaxBps               int    `json:"limitMaxBps,omitempty"`
-	LimitMaxBpsStatus         string `json:"limitMaxBpsStatus,omitempty"`
-	LimitMaxConnections       int    `json:"limitMaxConnections,omitempty"`
-	LimitMaxConnectionsStatus string `json:"limitMaxConnectionsStatus,omitempty"`
-	LimitMaxPps               int    `json:"limitMaxPps,omitempty"`
-	LimitMaxPpsStatus         string `json:"limitMaxPpsStatus,omitempty"`
-	LoadBalancingMode         string `json:"loadBalancingMode,omitempty"`
-	ManualResume              string `json:"manualResume,omitempty"`
-	MaxAnswersReturned        int    `json:"maxAnswersReturned,omitempty"`
+	AlternateMode             string            `json:"alternateMode,omitempty"`
+	DynamicRatio              string            `json:"dynamicRatio,omitempty"`
+	Enabled                   bigip.Opt[bool]   `json:"enabled,omitempty"`
+	Description               bigip.Opt[string] `json:"description,omitempty"`
+	Disabled                  bigip.Opt[bool]   `json:"disabled,omitempty"`
+	FallbackIP                string            `json:"fallbackIp,omitempty"`
+	FallbackMode              string            `json:"fallbackMode,omitempty"`
+	FullPath                  string            `json:"fullPath,omitempty"`
+	Generation                int               `json:"generation,omitempty"`
+	Kind                      string            `json:"kind,omitempty"`
+	LimitMaxBps               bigip.Opt[int]    `json:"limitMaxBps,omitempty"`
+	LimitMaxBpsStatus         string            `json:"limitMaxBpsStatus,omitempty"`
+	LimitMaxConnections       bigip.Opt[int]    `json:"limitMaxConnections,omitempty"`
+	LimitMaxConnectionsStatus string            `json:"limitMaxConnectionsStatus,omitempty"`
+	LimitMaxPps               bigip.Opt[int]    `json:"limitMaxPps,omitempty"`
+	LimitMaxPpsStatus         string            `json:"limitMaxPpsStatus,omitempty"`
+	LoadBalancingMode         string            `json:"loadBalancingMode,omitempty"`
+	ManualResume              string            `json:"manualResume,omitempty"`
+	MaxAnswersReturned        bigip.Opt[int]    `json:"maxAnswersReturned,omitempty"`
 	MembersReference          struct {
 		Members         []PoolMembers `json:"items,omitempty"`
 		IsSubcollection bool          `json:"isSubcollection,omitempty"`
 		Link            string        `json:"link,omitempty"`
 	} `json:"membersReference,omitempty"`
-	Monitor                  string `json:"monitor,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Partition                string `json:"partition,omitempty"`
-	QosHitRatio              int    `json:"qosHitRatio,omitempty"`
-	QosHops                  int    `json:"qosHops,omitempty"`
-	QosKilobytesSecond       int    `json:"qosKilobytesSecond,omitempty"`
-	QosLcs                   int    `json:"qosLcs,omitempty"`
-	QosPacketRate            int    `json:"qosPacketRate,omitempty"`
-	QosRtt                   int    `json:"qosRtt,omitempty"`
-	QosTopology              int    `json:"qosTopology,omitempty"`
-	QosVsCapacity            int    `json:"qosVsCapacity,omitempty"`
-	QosVsScore               int    `json:"qosVsScore,omitempty"`
-	SelfLink                 string `json:"selfLink,omitempty"`
-	TTL                      int    `json:"ttl,omitempty"`
-	VerifyMemberAvailability string `json:"verifyMemberAvailability,omitempty"`
+	Monitor                  string         `json:"monitor,omitempty"`
+	Name                     string         `json:"name,omitempty"`
+	Partition                string         `json:"partition,omitempty"`
+	QosHitRatio              int            `json:"qosHitRatio,omitempty"`
+	QosHops                  int            `json:"qosHops,omitempty"`
+	QosKilobytesSecond       int            `json:"qosKilobytesSecond,omitempty"`
+	QosLcs                   int            `json:"qosLcs,omitempty"`
+	QosPacketRate            int            `json:"qosPacketRate,omitempty"`
+	QosRtt                   int            `json:"qosRtt,omitempty"`
+	QosTopology              int            `json:"qosTopology,omitempty"`
+	QosVsCapacity            int            `json:"qosVsCapacity,omitempty"`
+	QosVsScore               int            `json:"qosVsScore,omitempty"`
+	SelfLink                 string         `json:"selfLink,omitempty"`
+	TTL                      bigip.Opt[int] `json:"ttl,omitempty"`
+	VerifyMemberAvailability string         `json:"verifyMemberAvailability,omitempty"`
+	Extra                    bigip.Extra    `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Pool does not model in Extra.
+func (p *Pool) UnmarshalJSON(data []byte) error {
+	type plain Pool
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Pool.
+func (p Pool) MarshalJSON() ([]byte, error) {
+	type plain Pool
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
 type PoolMembersList struct {
@@ -64,22 +78,37 @@ type PoolMembersList struct {
 
 // PoolMembers holds the configuration of a single PoolMembers.
 type PoolMembers struct {
-	Enabled                   bool   `json:"enabled,omitempty"`
-	FullPath                  string `json:"fullPath,omitempty"`
-	Generation                int    `json:"generation,omitempty"`
-	Kind                      string `json:"kind,omitempty"`
-	LimitMaxBps               int    `json:"limitMaxBps,omitempty"`
-	LimitMaxBpsStatus         string `json:"limitMaxBpsStatus,omitempty"`
-	LimitMaxConnections       int    `json:"limitMaxConnections,omitempty"`
-	LimitMaxConnectionsStatus string `json:"limitMaxConnectionsStatus,omitempty"`
-	LimitMaxPps               int    `json:"limitMaxPps,omitempty"`
-	LimitMaxPpsStatus         string `json:"limitMaxPpsStatus,omitempty"`
-	MemberOrder               int    `json:"memberOrder,omitempty"`
-	Monitor                   string `json:"monitor,omitempty"`
-	Name                      string `json:"name,omitempty"`
-	Partition                 string `json:"partition,omitempty"`
-	Ratio                     int    `json:"ratio,omitempty"`
-	SelfLink                  string `json:"selfLink,omitempty"`
+	Enabled                   bigip.Opt[bool]   `json:"enabled,omitempty"`
+	Description               bigip.Opt[string] `json:"description,omitempty"`
+	Disabled                  bigip.Opt[bool]   `json:"disabled,omitempty"`
+	FullPath                  string            `json:"fullPath,omitempty"`
+	Generation                int               `json:"generation,omitempty"`
+	Kind                      string            `json:"kind,omitempty"`
+	LimitMaxBps               bigip.Opt[int]    `json:"limitMaxBps,omitempty"`
+	LimitMaxBpsStatus         string            `json:"limitMaxBpsStatus,omitempty"`
+	LimitMaxConnections       bigip.Opt[int]    `json:"limitMaxConnections,omitempty"`
+	LimitMaxConnectionsStatus string            `json:"limitMaxConnectionsStatus,omitempty"`
+	LimitMaxPps               bigip.Opt[int]    `json:"limitMaxPps,omitempty"`
+	LimitMaxPpsStatus         string            `json:"limitMaxPpsStatus,omitempty"`
+	MemberOrder               bigip.Opt[int]    `json:"memberOrder,omitempty"`
+	Monitor                   string            `json:"monitor,omitempty"`
+	Name                      string            `json:"name,omitempty"`
+	Partition                 string            `json:"partition,omitempty"`
+	Ratio                     bigip.Opt[int]    `json:"ratio,omitempty"`
+	SelfLink                  string            `json:"selfLink,omitempty"`
+	Extra                     bigip.Extra       `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes PoolMembers does not model in Extra.
+func (p *PoolMembers) UnmarshalJSON(data []byte) error {
+	type plain PoolMembers
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of PoolMembers.
+func (p PoolMembers) MarshalJSON() ([]byte, error) {
+	type plain PoolMembers
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
 type PoolStatsList struct {
@@ -138,84 +167,23 @@ const StatsEndpoint = "stats"
 
 // AResource provides an API to manage A configurations.
 type AResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all A details.
-func (r *AResource) List() (*PoolList, error) {
-	var items PoolList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
-}
-
-// Get retrieves the details of a single A by node name.
-func (r *AResource) Get(name string) (*Pool, error) {
-	var item Pool
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+	bigip.Resource[Pool, PoolList]
 }
 
-// Create creates a new A item.
-func (r *AResource) Create(item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newAResource(b *bigip.BigIP) AResource {
+	return AResource{bigip.NewResource[Pool, PoolList](b, GTMManager, PoolEndpoint, AEndpoint)}
 }
 
-// Update modifies the A item identified by the A name.
-func (r *AResource) Update(name string, item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single A identified by the A name. If it does not exist, return an error.
-func (r *AResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func (r *AResource) ShowAStats(name string) (*PoolStatsList, error) {
+	return r.ShowAStatsContext(context.Background(), name)
 }
 
-func (r *AResource) ShowAStats(name string) (*PoolStatsList, error) {
+// ShowAStatsContext is like ShowAStats but uses ctx for the request.
+func (r *AResource) ShowAStatsContext(ctx context.Context, name string) (*PoolStatsList, error) {
 	var item PoolStatsList
 
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+	res, err := r.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(PoolEndpoint).SubResource(AEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -226,9 +194,14 @@ func (r *AResource) ShowAStats(name string) (*PoolStatsList, error) {
 }
 
 func (r *AResource) ShowAllAStats() (*PoolStatsList, error) {
+	return r.ShowAllAStatsContext(context.Background())
+}
+
+// ShowAllAStatsContext is like ShowAllAStats but uses ctx for the request.
+func (r *AResource) ShowAllAStatsContext(ctx context.Context) (*PoolStatsList, error) {
 	var item PoolStatsList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+	res, err := r.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(PoolEndpoint).SubResource(AEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // CNAMEEndpoint represents the REST resource for managing CNAME.
@@ -13,84 +12,23 @@ const CNAMEEndpoint = "cname"
 
 // CNAMEResource provides an API to manage CNAME configurations.
 type CNAMEResource struct {
-	b *bigip.BigIP
+	bigip.Resource[Pool, PoolList]
 }
 
-// List retrieves all CNAME details.
-func (r *CNAMEResource) List() (*PoolList, error) {
-	var items PoolList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
-}
-
-// Get retrieves the details of a single CNAME by node name.
-func (r *CNAMEResource) Get(name string) (*Pool, error) {
-	var item Pool
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create creates a new CNAME item.
-func (r *CNAMEResource) Create(item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newCNAMEResource(b *bigip.BigIP) CNAMEResource {
+	return CNAMEResource{bigip.NewResource[Pool, PoolList](b, GTMManager, PoolEndpoint, CNAMEEndpoint)}
 }
 
-// Update modifies the CNAME item identified by the CNAME name.
-func (r *CNAMEResource) Update(name string, item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single CNAME identified by the CNAME name. If it does not exist, return an error.
-func (r *CNAMEResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func (r *CNAMEResource) ShowCNAMEStats(name string) (*PoolStatsList, error) {
+	return r.ShowCNAMEStatsContext(context.Background(), name)
 }
 
-func (r *CNAMEResource) ShowCNAMEStats(name string) (*PoolStatsList, error) {
+// ShowCNAMEStatsContext is like ShowCNAMEStats but uses ctx for the request.
+func (r *CNAMEResource) ShowCNAMEStatsContext(ctx context.Context, name string) (*PoolStatsList, error) {
 	var item PoolStatsList
 
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+	res, err := r.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -101,9 +39,14 @@ func (r *CNAMEResource) ShowCNAMEStats(name string) (*PoolStatsList, error) {
 }
 
 func (r *CNAMEResource) ShowAllCNAMEStats() (*PoolStatsList, error) {
+	return r.ShowAllCNAMEStatsContext(context.Background())
+}
+
+// ShowAllCNAMEStatsContext is like ShowAllCNAMEStats but uses ctx for the request.
+func (r *CNAMEResource) ShowAllCNAMEStatsContext(ctx context.Context) (*PoolStatsList, error) {
 	var item PoolStatsList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+	res, err := r.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // MXEndpoint represents the REST resource for managing MX.
@@ -14,84 +13,23 @@ const MXEndpoint = "mx"
 
 // MXResource provides an API to manage MX configurations.
 type MXResource struct {
-	b *bigip.BigIP
+	bigip.Resource[Pool, PoolList]
 }
 
-// List retrieves all MX details.
-func (r *MXResource) List() (*PoolList, error) {
-	var items PoolList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(MXEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
-}
-
-// Get retrieves the details of a single MX by node name.
-func (r *MXResource) Get(name string) (*Pool, error) {
-	var item Pool
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(MXEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create creates a new MX item.
-func (r *MXResource) Create(item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(MXEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newMXResource(b *bigip.BigIP) MXResource {
+	return MXResource{bigip.NewResource[Pool, PoolList](b, GTMManager, PoolEndpoint, MXEndpoint)}
 }
 
-// Update modifies the MX item identified by the MX name.
-func (r *MXResource) Update(name string, item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(MXEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single MX identified by the MX name. If it does not exist, return an error.
-func (r *MXResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(MXEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func (r *MXResource) ShowMXStats(name string) (*PoolStatsList, error) {
+	return r.ShowMXStatsContext(context.Background(), name)
 }
 
-func (r *MXResource) ShowMXStats(name string) (*PoolStatsList, error) {
+// ShowMXStatsContext is like ShowMXStats but uses ctx for the request.
+func (r *MXResource) ShowMXStatsContext(ctx context.Context, name string) (*PoolStatsList, error) {
 	var item PoolStatsList
 
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(MXEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+	res, err := r.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(PoolEndpoint).SubResource(MXEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -102,9 +40,14 @@ func (r *MXResource) ShowMXStats(name string) (*PoolStatsList, error) {
 }
 
 func (r *MXResource) ShowAllMXStats() (*PoolStatsList, error) {
+	return r.ShowAllMXStatsContext(context.Background())
+}
+
+// ShowAllMXStatsContext is like ShowAllMXStats but uses ctx for the request.
+func (r *MXResource) ShowAllMXStatsContext(ctx context.Context) (*PoolStatsList, error) {
 	var item PoolStatsList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(MXEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+	res, err := r.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(PoolEndpoint).SubResource(MXEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -19,12 +19,12 @@ type PoolResource struct {
 // NewPoolResource constructs a new instance of PoolResource with a given bigip.BigIP instance
 func NewPoolResource(b *bigip.BigIP) PoolResource {
 	return PoolResource{
-		a:     AResource{b: b},
-		aaaa:  AAAAResource{b: b},
-		cname: CNAMEResource{b: b},
-		mx:    MXResource{b: b},
-		naptr: NAPTRResource{b: b},
-		srv:   SRVResource{b: b},
+		a:     newAResource(b),
+		aaaa:  newAAAAResource(b),
+		cname: newCNAMEResource(b),
+		mx:    newMXResource(b),
+		naptr: newNAPTRResource(b),
+		srv:   newSRVResource(b),
 	}
 }
 
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ProberPoolList holds a list of ProberPool configuration.
@@ -27,9 +26,22 @@ type ProberPool struct {
 		IsSubcollection bool                `json:"isSubcollection,omitempty"`
 		Link            string              `json:"link,omitempty"`
 	} `json:"membersReference,omitempty"`
-	Name      string `json:"name,omitempty"`
-	Partition string `json:"partition,omitempty"`
-	SelfLink  string `json:"selfLink,omitempty"`
+	Name      string      `json:"name,omitempty"`
+	Partition string      `json:"partition,omitempty"`
+	SelfLink  string      `json:"selfLink,omitempty"`
+	Extra     bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes ProberPool does not model in Extra.
+func (p *ProberPool) UnmarshalJSON(data []byte) error {
+	type plain ProberPool
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of ProberPool.
+func (p ProberPool) MarshalJSON() ([]byte, error) {
+	type plain ProberPool
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
 // ProberPoolMembersList holds a list of ProberPoolMembers configuration.
@@ -41,13 +53,26 @@ type ProberPoolMembersList struct {
 
 // ProberPoolMembers holds the configuration of a single ProberPoolMembers.
 type ProberPoolMembers struct {
-	Enabled    bool   `json:"enabled"`
-	FullPath   string `json:"fullPath"`
-	Generation int    `json:"generation"`
-	Kind       string `json:"kind"`
-	Name       string `json:"name"`
-	Order      int    `json:"order"`
-	SelfLink   string `json:"selfLink"`
+	Enabled    bool        `json:"enabled"`
+	FullPath   string      `json:"fullPath"`
+	Generation int         `json:"generation"`
+	Kind       string      `json:"kind"`
+	Name       string      `json:"name"`
+	Order      int         `json:"order"`
+	SelfLink   string      `json:"selfLink"`
+	Extra      bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes ProberPoolMembers does not model in Extra.
+func (p *ProberPoolMembers) UnmarshalJSON(data []byte) error {
+	type plain ProberPoolMembers
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of ProberPoolMembers.
+func (p ProberPoolMembers) MarshalJSON() ([]byte, error) {
+	type plain ProberPoolMembers
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
 // ProberPoolMembersEndpoint represents the REST resource for managing ProberPoolMembers.
@@ -58,84 +83,23 @@ const ProberPoolEndpoint = "prober-pool"
 
 // ProberPoolResource provides an API to manage ProberPool configurations.
 type ProberPoolResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all ProberPool details.
-func (r *ProberPoolResource) List() (*ProberPoolList, error) {
-	var items ProberPoolList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
-}
-
-// Get retrieves the details of a single ProberPool by node name.
-func (r *ProberPoolResource) Get(name string) (*ProberPool, error) {
-	var item ProberPool
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+	bigip.Resource[ProberPool, ProberPoolList]
 }
 
-// Create creates a new ProberPool item.
-func (r *ProberPoolResource) Create(item ProberPool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Update modifies the ProberPool item identified by the ProberPool name.
-func (r *ProberPoolResource) Update(name string, item ProberPool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single ProberPool identified by the ProberPool name. If it does not exist, return an error.
-func (r *ProberPoolResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newProberPoolResource(b *bigip.BigIP) ProberPoolResource {
+	return ProberPoolResource{bigip.NewResource[ProberPool, ProberPoolList](b, GTMManager, ProberPoolEndpoint)}
 }
 
 // GetMembers  lists all the ProberPoolMembers configurations.
 func (r *ProberPoolResource) GetMembers(name string) (*ProberPoolMembersList, error) {
+	return r.GetMembersContext(context.Background(), name)
+}
+
+// GetMembersContext is like GetMembers but uses ctx for the request.
+func (r *ProberPoolResource) GetMembersContext(ctx context.Context, name string) (*ProberPoolMembersList, error) {
 	var items ProberPoolMembersList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).ResourceInstance(name).SubResourceInstance(ProberPoolMembersEndpoint).DoRaw(context.Background())
+	res, err := r.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(ProberPoolEndpoint).ResourceInstance(name).SubResourceInstance(ProberPoolMembersEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,7 @@
 package gtm
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // RegionList holds a list of Region configuration.
@@ -25,83 +21,30 @@ type Region struct {
 	RegionMembers []struct {
 		Name string `json:"name,omitempty"`
 	} `json:"regionMembers,omitempty"`
-	SelfLink string `json:"selfLink,omitempty"`
+	SelfLink string      `json:"selfLink,omitempty"`
+	Extra    bigip.Extra `json:"-"`
 }
 
-// RegionEndpoint represents the REST resource for managing Region.
-const RegionEndpoint = "region"
-
-// RegionResource provides an API to manage Region configurations.
-type RegionResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all Region details.
-func (r *RegionResource) List() (*RegionList, error) {
-	var items RegionList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RegionEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Region does not model in Extra.
+func (r *Region) UnmarshalJSON(data []byte) error {
+	type plain Region
+	return bigip.UnmarshalExtra(data, (*plain)(r), &r.Extra)
 }
 
-// Get retrieves the details of a single Region by node name.
-func (r *RegionResource) Get(name string) (*Region, error) {
-	var item Region
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RegionEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of Region.
+func (r Region) MarshalJSON() ([]byte, error) {
+	type plain Region
+	return bigip.MarshalExtra(plain(r), r.Extra)
 }
 
-// Create creates a new Region item.
-func (r *RegionResource) Create(item Region) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RegionEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// RegionEndpoint represents the REST resource for managing Region.
+const RegionEndpoint = "region"
 
-// Update modifies the Region item identified by the Region name.
-func (r *RegionResource) Update(name string, item Region) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RegionEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// RegionResource provides an API to manage Region configurations.
+type RegionResource struct {
+	bigip.Resource[Region, RegionList]
 }
 
-// Delete a single Region identified by the Region name. If it does not exist, return an error.
-func (r *RegionResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RegionEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newRegionResource(b *bigip.BigIP) RegionResource {
+	return RegionResource{bigip.NewResource[Region, RegionList](b, GTMManager, RegionEndpoint)}
 }
@@ -1,11 +1,7 @@
 package gtm
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // RuleList holds a list of Rule configuration.
@@ -17,89 +13,36 @@ type RuleList struct {
 
 // Rule holds the configuration of a single Rule.
 type Rule struct {
-	APIAnonymous string `json:"apiAnonymous,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	Kind         string `json:"kind,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
+	APIAnonymous string      `json:"apiAnonymous,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	Extra        bigip.Extra `json:"-"`
 }
 
-// RuleEndpoint represents the REST resource for managing Rule.
-const RuleEndpoint = "rule"
-
-// RuleResource provides an API to manage Rule configurations.
-type RuleResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all Rule details.
-func (r *RuleResource) List() (*RuleList, error) {
-	var items RuleList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RuleEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Rule does not model in Extra.
+func (r *Rule) UnmarshalJSON(data []byte) error {
+	type plain Rule
+	return bigip.UnmarshalExtra(data, (*plain)(r), &r.Extra)
 }
 
-// Get retrieves the details of a single Rule by node name.
-func (r *RuleResource) Get(name string) (*Rule, error) {
-	var item Rule
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RuleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of Rule.
+func (r Rule) MarshalJSON() ([]byte, error) {
+	type plain Rule
+	return bigip.MarshalExtra(plain(r), r.Extra)
 }
 
-// Create creates a new Rule item.
-func (r *RuleResource) Create(item Rule) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RuleEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// RuleEndpoint represents the REST resource for managing Rule.
+const RuleEndpoint = "rule"
 
-// Update modifies the Rule item identified by the Rule name.
-func (r *RuleResource) Update(name string, item Rule) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RuleEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// RuleResource provides an API to manage Rule configurations.
+type RuleResource struct {
+	bigip.Resource[Rule, RuleList]
 }
 
-// Delete a single Rule identified by the Rule name. If it does not exist, return an error.
-func (r *RuleResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RuleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newRuleResource(b *bigip.BigIP) RuleResource {
+	return RuleResource{bigip.NewResource[Rule, RuleList](b, GTMManager, RuleEndpoint)}
 }
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ServerList holds a list of Server configuration.
@@ -62,6 +61,19 @@ type Server struct {
 		IsSubcollection bool                   `json:"isSubcollection,omitempty"`
 		Link            string                 `json:"link,omitempty"`
 	} `json:"virtualServersReference,omitempty"`
+	Extra bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Server does not model in Extra.
+func (s *Server) UnmarshalJSON(data []byte) error {
+	type plain Server
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Server.
+func (s Server) MarshalJSON() ([]byte, error) {
+	type plain Server
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
 // ServerVirtualServersList holds a list of ServerVirtualServers configuration.
@@ -73,21 +85,34 @@ type ServerVirtualServersList struct {
 
 // ServerVirtualServers holds the configuration of a single ServerVirtualServers.
 type ServerVirtualServers struct {
-	LimitMaxPpsStatus        string `json:"limitMaxPpsStatus,omitempty"`
-	Kind                     string `json:"kind,omitempty"`
-	LimitMaxBps              int    `json:"limitMaxBps,omitempty"`
-	Destination              string `json:"destination,omitempty"`
-	LimitMaxConnections      string `json:"limitMaxConnections,omitempty"`
-	Enabled                  bool   `json:"enabled,omitempty"`
-	SelfLink                 string `json:"selfLink,omitempty"`
-	LimitMaxConnectionStatus string `json:"limitMaxConnectionStatus,omitempty"`
-	TranslationPort          int    `json:"translationPort,omitempty"`
-	FullPath                 string `json:"fullPath,omitempty"`
-	LimitMaxPps              int    `json:"limitMaxPps,omitempty"`
-	Generation               int    `json:"generation,omitempty"`
-	LimitMaxBpsStatus        string `json:"limitMaxBpsStatus,omitempty"`
-	TranslationAddress       string `json:"translationAddress,omitempty"`
-	Name                     string `json:"name,omitempty"`
+	LimitMaxPpsStatus        string      `json:"limitMaxPpsStatus,omitempty"`
+	Kind                     string      `json:"kind,omitempty"`
+	LimitMaxBps              int         `json:"limitMaxBps,omitempty"`
+	Destination              string      `json:"destination,omitempty"`
+	LimitMaxConnections      string      `json:"limitMaxConnections,omitempty"`
+	Enabled                  bool        `json:"enabled,omitempty"`
+	SelfLink                 string      `json:"selfLink,omitempty"`
+	LimitMaxConnectionStatus string      `json:"limitMaxConnectionStatus,omitempty"`
+	TranslationPort          int         `json:"translationPort,omitempty"`
+	FullPath                 string      `json:"fullPath,omitempty"`
+	LimitMaxPps              int         `json:"limitMaxPps,omitempty"`
+	Generation               int         `json:"generation,omitempty"`
+	LimitMaxBpsStatus        string      `json:"limitMaxBpsStatus,omitempty"`
+	TranslationAddress       string      `json:"translationAddress,omitempty"`
+	Name                     string      `json:"name,omitempty"`
+	Extra                    bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes ServerVirtualServers does not model in Extra.
+func (s *ServerVirtualServers) UnmarshalJSON(data []byte) error {
+	type plain ServerVirtualServers
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of ServerVirtualServers.
+func (s ServerVirtualServers) MarshalJSON() ([]byte, error) {
+	type plain ServerVirtualServers
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
 // ServerVirtualServersEndpoint represents the REST resource for managing ServerVirtualServers.
@@ -98,44 +123,23 @@ const ServerEndpoint = "server"
 
 // ServerResource provides an API to manage Server configurations.
 type ServerResource struct {
-	b *bigip.BigIP
+	bigip.Resource[Server, ServerList]
 }
 
-// ListAll  lists all the Server configurations.
-func (r *ServerResource) List() (*ServerList, error) {
-	var items ServerList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ServerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
-}
-
-// Get a single Server configuration identified by name.
-func (r *ServerResource) Get(fullPathName string) (*Server, error) {
-	var item Server
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ServerEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func newServerResource(b *bigip.BigIP) ServerResource {
+	return ServerResource{bigip.NewResource[Server, ServerList](b, GTMManager, ServerEndpoint)}
 }
 
 // GetVirtualServers lists all the ServerVirtualServers configurations.
 func (r *ServerResource) GetVirtualServers(fullPathName string) (*ServerVirtualServersList, error) {
+	return r.GetVirtualServersContext(context.Background(), fullPathName)
+}
+
+// GetVirtualServersContext is like GetVirtualServers but uses ctx for the request.
+func (r *ServerResource) GetVirtualServersContext(ctx context.Context, fullPathName string) (*ServerVirtualServersList, error) {
 	var items ServerVirtualServersList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ServerEndpoint).ResourceInstance(fullPathName).SubStatsResource(ServerVirtualServersEndpoint).DoRaw(context.Background())
+	res, err := r.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
+		Resource(ServerEndpoint).ResourceInstance(fullPathName).SubStatsResource(ServerVirtualServersEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -145,43 +149,3 @@ func (r *ServerResource) GetVirtualServers(fullPathName string) (*ServerVirtualS
 	}
 	return &items, nil
 }
-
-// Create a new Server configuration.
-func (r *ServerResource) Create(item Server) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ServerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Edit a Server configuration identified by name.
-func (r *ServerResource) Update(fullPathName string, item Server) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DatacenterEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single Server configuration identified by name.
-func (r *ServerResource) Delete(fullPathName string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DatacenterEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
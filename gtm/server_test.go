@@ -2,12 +2,16 @@ package gtm
 
 import (
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
 
 	"testing"
 )
 
 func TestServerResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	s := bigiptest.NewServer()
+	defer s.Close()
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -30,9 +34,7 @@ func TestServerResource(t *testing.T) {
 		t.Error(err)
 	}
 
-	serverResource := ServerResource{
-		b: bigIP,
-	}
+	serverResource := newServerResource(bigIP)
 
 	// Create a new Server
 	newServer := Server{
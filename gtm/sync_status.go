@@ -25,9 +25,14 @@ type SyncStatusResource struct {
 
 // List retrieves all SyncStatus details.
 func (r *SyncStatusResource) Show() (*SyncStatus, error) {
+	return r.ShowContext(context.Background())
+}
+
+// ShowContext is like Show but uses ctx for the request.
+func (r *SyncStatusResource) ShowContext(ctx context.Context) (*SyncStatus, error) {
 	var items SyncStatus
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(SyncStatusEndpoint).DoRaw(context.Background())
+		Resource(SyncStatusEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,7 @@
 package gtm
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // TopologyList holds a list of Topology configuration.
@@ -17,89 +13,36 @@ type TopologyList struct {
 
 // Topology holds the configuration of a single Topology.
 type Topology struct {
-	FullPath   string `json:"fullPath,omitempty"`
-	Generation int    `json:"generation,omitempty"`
-	Kind       string `json:"kind,omitempty"`
-	Name       string `json:"name,omitempty"`
-	Order      int    `json:"order,omitempty"`
-	Score      int    `json:"score,omitempty"`
-	SelfLink   string `json:"selfLink,omitempty"`
+	FullPath   string      `json:"fullPath,omitempty"`
+	Generation int         `json:"generation,omitempty"`
+	Kind       string      `json:"kind,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	Order      int         `json:"order,omitempty"`
+	Score      int         `json:"score,omitempty"`
+	SelfLink   string      `json:"selfLink,omitempty"`
+	Extra      bigip.Extra `json:"-"`
 }
 
-// TopologyEndpoint represents the REST resource for managing Topology.
-const TopologyEndpoint = "topology"
-
-// TopologyResource provides an API to manage Topology configurations.
-type TopologyResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all Topology details.
-func (r *TopologyResource) List() (*TopologyList, error) {
-	var items TopologyList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(TopologyEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Topology does not model in Extra.
+func (t *Topology) UnmarshalJSON(data []byte) error {
+	type plain Topology
+	return bigip.UnmarshalExtra(data, (*plain)(t), &t.Extra)
 }
 
-// Get retrieves the details of a single Topology by node name.
-func (r *TopologyResource) Get(name string) (*Topology, error) {
-	var item Topology
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(TopologyEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of Topology.
+func (t Topology) MarshalJSON() ([]byte, error) {
+	type plain Topology
+	return bigip.MarshalExtra(plain(t), t.Extra)
 }
 
-// Create creates a new Topology item.
-func (r *TopologyResource) Create(item Topology) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(TopologyEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// TopologyEndpoint represents the REST resource for managing Topology.
+const TopologyEndpoint = "topology"
 
-// Update modifies the Topology item identified by the Topology name.
-func (r *TopologyResource) Update(name string, item Topology) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(TopologyEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// TopologyResource provides an API to manage Topology configurations.
+type TopologyResource struct {
+	bigip.Resource[Topology, TopologyList]
 }
 
-// Delete a single Topology identified by the Topology name. If it does not exist, return an error.
-func (r *TopologyResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(TopologyEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newTopologyResource(b *bigip.BigIP) TopologyResource {
+	return TopologyResource{bigip.NewResource[Topology, TopologyList](b, GTMManager, TopologyEndpoint)}
 }
@@ -16,12 +16,12 @@ type WideipResource struct {
 
 func NewWideip(b *bigip.BigIP) WideipResource {
 	return WideipResource{
-		a:     AResource{b: b},
-		aaaa:  AAAAResource{b: b},
-		cname: CNAMEResource{b: b},
-		mx:    MXResource{b: b},
-		naptr: NAPTRResource{b: b},
-		srv:   SRVResource{b: b},
+		a:     newAResource(b),
+		aaaa:  newAAAAResource(b),
+		cname: newCNAMEResource(b),
+		mx:    newMXResource(b),
+		naptr: newNAPTRResource(b),
+		srv:   newSRVResource(b),
 	}
 }
 
@@ -0,0 +1,217 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is the number of items an Iterator requests per page unless
+// ListOptions.Top says otherwise.
+const DefaultPageSize = 500
+
+// Iterator walks through a collection page by page, decoding one item at a time
+// from the response, so that collections of tens of thousands of items can be
+// processed without holding them in memory:
+//
+//	it := ltm.New(b).Virtual().Iterate(ctx)
+//	defer it.Close()
+//	for it.Next() {
+//		vs := it.Item()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// An Iterator is not safe for concurrent use.
+type Iterator[T any] struct {
+	ctx        context.Context
+	newRequest func() *rest.Request
+	opts       ListOptions
+
+	body    io.ReadCloser
+	dec     *json.Decoder
+	inItems bool
+	// count is the number of items read from the current page, nextLink the link
+	// to the next page, if any.
+	count    int
+	nextLink string
+
+	item T
+	err  error
+	done bool
+}
+
+// NewIterator returns an Iterator over the collection requested by newRequest,
+// which is called for every page and must return a GET request for the collection.
+// opts.Top sets the page size and opts.Skip the number of items skipped before the
+// first one.
+func NewIterator[T any](ctx context.Context, newRequest func() *rest.Request, opts ...ListOptions) *Iterator[T] {
+	var o ListOptions
+	if len(opts) != 0 {
+		o = opts[0]
+	}
+	if o.Top <= 0 {
+		o.Top = DefaultPageSize
+	}
+	return &Iterator[T]{ctx: ctx, newRequest: newRequest, opts: o}
+}
+
+// Iterate returns an Iterator over the items of the collection. See NewIterator
+// for the meaning of opts.
+func (r *Resource[T, L]) Iterate(ctx context.Context, opts ...ListOptions) *Iterator[T] {
+	return NewIterator[T](ctx, func() *rest.Request {
+		return r.Collection(http.MethodGet)
+	}, opts...)
+}
+
+// Next advances the iterator to the next item, which is then returned by Item. It
+// returns false when there are no more items or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	for !it.done && it.err == nil {
+		if it.dec == nil {
+			it.err = it.openPage()
+			continue
+		}
+		if it.inItems && it.dec.More() {
+			var item T
+			if err := it.dec.Decode(&item); err != nil {
+				it.err = fmt.Errorf("failed to unmarshal JSON data: %w", err)
+				break
+			}
+			it.item = item
+			it.count++
+			return true
+		}
+		if err := it.finishPage(); err != nil {
+			it.err = err
+			break
+		}
+		// Endpoints that do not support paging return everything at once and no
+		// link to a next page.
+		if it.nextLink == "" || it.count == 0 {
+			it.done = true
+			break
+		}
+		skip := it.opts.Skip + it.count
+		if next := nextSkip(it.nextLink, skip); next > it.opts.Skip {
+			skip = next
+		}
+		it.opts.Skip = skip
+	}
+	it.Close()
+	return false
+}
+
+// Item returns the item Next advanced to.
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Close releases the response being read. It must be called when the iteration
+// is stopped before Next returned false, and may be called more than once.
+func (it *Iterator[T]) Close() error {
+	if it.body == nil {
+		return nil
+	}
+	err := it.body.Close()
+	it.body = nil
+	it.dec = nil
+	return err
+}
+
+// openPage requests the page starting at opts.Skip and reads up to its items.
+func (it *Iterator[T]) openPage() error {
+	body, err := it.opts.Apply(it.newRequest()).Stream(it.ctx)
+	if err != nil {
+		return err
+	}
+	it.body = body
+	it.dec = json.NewDecoder(body)
+	it.count = 0
+	it.nextLink = ""
+	it.inItems = false
+
+	if err := expectDelim(it.dec, '{'); err != nil {
+		return err
+	}
+	return it.readFields()
+}
+
+// finishPage reads what follows the items of the current page.
+func (it *Iterator[T]) finishPage() error {
+	if it.inItems {
+		if err := expectDelim(it.dec, ']'); err != nil {
+			return err
+		}
+		it.inItems = false
+		if err := it.readFields(); err != nil {
+			return err
+		}
+	}
+	return it.Close()
+}
+
+// readFields reads the fields of the page up to the start of the items, or up to
+// the end of the page if the items are read already or missing.
+func (it *Iterator[T]) readFields() error {
+	for it.dec.More() {
+		token, err := it.dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal JSON data: %w", err)
+		}
+		key, _ := token.(string)
+		switch key {
+		case "items":
+			if err := expectDelim(it.dec, '['); err != nil {
+				return err
+			}
+			it.inItems = true
+			return nil
+		case "nextLink":
+			err = it.dec.Decode(&it.nextLink)
+		default:
+			var skip json.RawMessage
+			err = it.dec.Decode(&skip)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal JSON data: %w", err)
+		}
+	}
+	return expectDelim(it.dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	if token != delim {
+		return fmt.Errorf("failed to unmarshal JSON data: expected %v, got %v", delim, token)
+	}
+	return nil
+}
+
+// nextSkip returns the $skip of the nextLink of a page, or fallback if it has none.
+func nextSkip(nextLink string, fallback int) int {
+	u, err := url.Parse(nextLink)
+	if err != nil {
+		return fallback
+	}
+	skip, err := strconv.Atoi(u.Query().Get("$skip"))
+	if err != nil {
+		return fallback
+	}
+	return skip
+}
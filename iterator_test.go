@@ -0,0 +1,127 @@
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newPagingServer returns a server with a collection of total items that pages
+// like iControl REST, and a collection that ignores $top and $skip.
+func newPagingServer(total int) (*httptest.Server, *[]string) {
+	var queries []string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/mgmt/tm/ltm/node" {
+			w.Write([]byte(`{"kind":"tm:ltm:node:nodecollectionstate","items":[{"name":"n1"},{"name":"n2"}]}`))
+			return
+		}
+		if r.URL.Path != "/mgmt/tm/ltm/virtual" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"not found"}`))
+			return
+		}
+		queries = append(queries, r.URL.RawQuery)
+		top, _ := strconv.Atoi(r.URL.Query().Get("$top"))
+		skip, _ := strconv.Atoi(r.URL.Query().Get("$skip"))
+		var items []string
+		for i := skip; i < skip+top && i < total; i++ {
+			items = append(items, fmt.Sprintf(`{"name":"vs%d","fullPath":"/Common/vs%d"}`, i, i))
+		}
+		fmt.Fprintf(w, `{"kind":"tm:ltm:virtual:virtualcollectionstate","currentItemCount":%d,"items":[%s]`, len(items), strings.Join(items, ","))
+		if skip+top < total {
+			fmt.Fprintf(w, `,"nextLink":"https://localhost/mgmt/tm/ltm/virtual?$top=%d&$skip=%d&ver=16.1.0"`, top, skip+top)
+		}
+		fmt.Fprintf(w, `,"pageIndex":%d,"totalItems":%d}`, skip/top+1, total)
+	}))
+	return ts, &queries
+}
+
+func TestIterator(t *testing.T) {
+	ts, queries := newPagingServer(10)
+	defer ts.Close()
+
+	b, err := NewSession(ts.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	r := NewResource[testItem, testItemList](b, "ltm", "virtual")
+
+	it := r.Iterate(context.Background(), ListOptions{Top: 4, Select: []string{"name", "fullPath"}})
+	var names []string
+	for it.Next() {
+		names = append(names, it.Item().Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err: %v", err)
+	}
+	if len(names) != 10 || names[0] != "vs0" || names[9] != "vs9" {
+		t.Errorf("unexpected items %v", names)
+	}
+	expected := []string{
+		"$select=name%2CfullPath&$top=4",
+		"$select=name%2CfullPath&$skip=4&$top=4",
+		"$select=name%2CfullPath&$skip=8&$top=4",
+	}
+	if strings.Join(*queries, " ") != strings.Join(expected, " ") {
+		t.Errorf("unexpected queries %v", *queries)
+	}
+}
+
+func TestIteratorWithoutPaging(t *testing.T) {
+	ts, _ := newPagingServer(0)
+	defer ts.Close()
+
+	b, err := NewSession(ts.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+
+	for _, endpoint := range []string{"node", "virtual"} {
+		r := NewResource[testItem, testItemList](b, "ltm", endpoint)
+		it := r.Iterate(context.Background())
+		count := 0
+		for it.Next() {
+			count++
+		}
+		if it.Err() != nil {
+			t.Fatalf("%s: Err: %v", endpoint, it.Err())
+		}
+		if expected := map[string]int{"node": 2, "virtual": 0}[endpoint]; count != expected {
+			t.Errorf("%s: expected %d items, got %d", endpoint, expected, count)
+		}
+	}
+}
+
+func TestIteratorError(t *testing.T) {
+	ts, _ := newPagingServer(10)
+	defer ts.Close()
+
+	b, err := NewSession(ts.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	r := NewResource[testItem, testItemList](b, "ltm", "pool")
+	it := r.Iterate(context.Background())
+	if it.Next() {
+		t.Fatal("expected no items")
+	}
+	if !rest.IsNotFound(it.Err()) {
+		t.Errorf("expected a not found error, got %v", it.Err())
+	}
+
+	// Stopping early releases the response.
+	r = NewResource[testItem, testItemList](b, "ltm", "virtual")
+	it = r.Iterate(context.Background(), ListOptions{Top: 4})
+	if !it.Next() {
+		t.Fatalf("expected an item, got %v", it.Err())
+	}
+	if err := it.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package bigip
+
+import (
+	"github.com/lefeck/go-bigip/rest"
+	"strconv"
+	"strings"
+)
+
+// ListOptions narrows down and shapes the items returned by a List call. The zero
+// value lists every item with all its attributes.
+type ListOptions struct {
+	// Select limits every item to the given attributes, for example "name" and "fullPath".
+	Select []string
+	// Filter restricts the items to those matching an expression such as
+	// "partition eq Common". See PartitionFilter.
+	Filter string
+	// Top is the maximum number of items returned, Skip the number of items skipped
+	// before the first one returned. Zero means no limit and no skipping.
+	Top  int
+	Skip int
+	// ExpandSubcollections includes the items of subcollections, such as the
+	// members of a pool or the profiles of a virtual server.
+	ExpandSubcollections bool
+	// Options are passed on to tmsh, for example "recursive" to list the items of
+	// all folders below a partition.
+	Options []string
+}
+
+// PartitionFilter returns a Filter selecting the items of partition.
+func PartitionFilter(partition string) string {
+	return "partition eq " + partition
+}
+
+// Apply sets the query parameters described by o on r.
+func (o ListOptions) Apply(r *rest.Request) *rest.Request {
+	if len(o.Select) != 0 {
+		r = r.SetParams("$select", strings.Join(o.Select, ","))
+	}
+	if len(o.Filter) != 0 {
+		r = r.SetParams("$filter", o.Filter)
+	}
+	if o.Top > 0 {
+		r = r.SetParams("$top", strconv.Itoa(o.Top))
+	}
+	if o.Skip > 0 {
+		r = r.SetParams("$skip", strconv.Itoa(o.Skip))
+	}
+	if o.ExpandSubcollections {
+		r = r.SetParams("expandSubcollections", "true")
+	}
+	if len(o.Options) != 0 {
+		r = r.SetParams("options", strings.Join(o.Options, ","))
+	}
+	return r
+}
+
+// ApplyListOptions applies every element of opts to r. It is meant for List calls
+// accepting a variadic ListOptions.
+func ApplyListOptions(r *rest.Request, opts ...ListOptions) *rest.Request {
+	for _, o := range opts {
+		r = o.Apply(r)
+	}
+	return r
+}
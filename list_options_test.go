@@ -0,0 +1,54 @@
+package bigip
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestListOptions(t *testing.T) {
+	var query url.Values
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer ts.Close()
+
+	b, err := NewSession(ts.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	r := NewResource[testItem, testItemList](b, "ltm", "virtual")
+
+	if _, err := r.List(); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(query) != 0 {
+		t.Errorf("expected no query parameters, got %v", query)
+	}
+
+	opts := ListOptions{
+		Select:               []string{"name", "fullPath"},
+		Filter:               PartitionFilter("Common"),
+		Top:                  100,
+		Skip:                 200,
+		ExpandSubcollections: true,
+		Options:              []string{"recursive"},
+	}
+	if _, err := r.List(opts); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	expected := url.Values{
+		"$select":              {"name,fullPath"},
+		"$filter":              {"partition eq Common"},
+		"$top":                 {"100"},
+		"$skip":                {"200"},
+		"expandSubcollections": {"true"},
+		"options":              {"recursive"},
+	}
+	if query.Encode() != expected.Encode() {
+		t.Errorf("expected query %v, got %v", expected, query)
+	}
+}
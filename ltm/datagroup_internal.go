@@ -1,11 +1,7 @@
 package ltm
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type DataGroupInternalList struct {
@@ -24,79 +20,30 @@ type DataGroupInternal struct {
 		Data string `json:"data,omitempty"`
 		Name string `json:"name,omitempty"`
 	} `json:"records,omitempty"`
-	SelfLink  string `json:"selfLink,omitempty"`
-	Type      string `json:"type,omitempty"`
-	Partition string `json:"partition,omitempty"`
+	SelfLink  string      `json:"selfLink,omitempty"`
+	Type      string      `json:"type,omitempty"`
+	Partition string      `json:"partition,omitempty"`
+	Extra     bigip.Extra `json:"-"`
 }
 
-const DataGroupInternalEndpoint = "/data-group/internal"
-
-type DataGroupInternalResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes DataGroupInternal does not model in Extra.
+func (d *DataGroupInternal) UnmarshalJSON(data []byte) error {
+	type plain DataGroupInternal
+	return bigip.UnmarshalExtra(data, (*plain)(d), &d.Extra)
 }
 
-func (dgir *DataGroupInternalResource) List() (*DataGroupInternalList, error) {
-	var dgil DataGroupInternalList
-
-	res, err := dgir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(DataGroupInternalEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &dgil); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &dgil, nil
+// MarshalJSON adds the attributes in Extra to those of DataGroupInternal.
+func (d DataGroupInternal) MarshalJSON() ([]byte, error) {
+	type plain DataGroupInternal
+	return bigip.MarshalExtra(plain(d), d.Extra)
 }
 
-func (dgir *DataGroupInternalResource) Get(fullPathName string) (*DataGroupInternal, error) {
-	var item DataGroupInternal
-	res, err := dgir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(DataGroupInternalEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-func (dgir *DataGroupInternalResource) Create(item DataGroupInternal) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = dgir.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(DataGroupInternalEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+const DataGroupInternalEndpoint = "/data-group/internal"
 
-func (dgir *DataGroupInternalResource) Update(fullPathName string, item DataGroupInternal) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = dgir.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(DataGroupInternalEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type DataGroupInternalResource struct {
+	bigip.Resource[DataGroupInternal, DataGroupInternalList]
 }
 
-func (dgir *DataGroupInternalResource) Delete(fullPathName string) error {
-	_, err := dgir.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(DataGroupInternalEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newDataGroupInternalResource(b *bigip.BigIP) DataGroupInternalResource {
+	return DataGroupInternalResource{bigip.NewResource[DataGroupInternal, DataGroupInternalList](b, LtmManager, DataGroupInternalEndpoint)}
 }
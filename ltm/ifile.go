@@ -15,51 +15,43 @@ type IFileList struct {
 }
 
 type IFile struct {
-	AppService  string `json:"appService,omitempty"`
-	Description string `json:"description,omitempty"`
-	Name        string `json:"name,omitempty"`
-	FileName    string `json:"fileName,omitempty"`
-	TMPartition string `json:"tmPartition,omitempty"`
-	Partition   string `json:"partition,omitempty"`
+	AppService  string      `json:"appService,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	FileName    string      `json:"fileName,omitempty"`
+	TMPartition string      `json:"tmPartition,omitempty"`
+	Partition   string      `json:"partition,omitempty"`
+	Extra       bigip.Extra `json:"-"`
 }
 
-const IFileEndpoint = "ifile"
-
-type IFileResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes IFile does not model in Extra.
+func (i *IFile) UnmarshalJSON(data []byte) error {
+	type plain IFile
+	return bigip.UnmarshalExtra(data, (*plain)(i), &i.Extra)
 }
 
-func (ifr *IFileResource) List() (*IFileList, error) {
-	var ifl IFileList
-
-	res, err := ifr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(IFileEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &ifl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &ifl, nil
+// MarshalJSON adds the attributes in Extra to those of IFile.
+func (i IFile) MarshalJSON() ([]byte, error) {
+	type plain IFile
+	return bigip.MarshalExtra(plain(i), i.Extra)
 }
 
-func (ifr *IFileResource) Get(fullPathName string) (*IFile, error) {
-	var item IFile
+const IFileEndpoint = "ifile"
 
-	res, err := ifr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(IFileEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+type IFileResource struct {
+	bigip.Resource[IFile, IFileList]
+}
 
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func newIFileResource(b *bigip.BigIP) IFileResource {
+	return IFileResource{bigip.NewResource[IFile, IFileList](b, LtmManager, IFileEndpoint)}
 }
 
 func (ifr *IFileResource) Create(name, fileObject string) error {
+	return ifr.CreateContext(context.Background(), name, fileObject)
+}
+
+// CreateContext is like Create but uses ctx for the request.
+func (ifr *IFileResource) CreateContext(ctx context.Context, name, fileObject string) error {
 	item := map[string]string{
 		"name":      name,
 		"file-name": fileObject,
@@ -69,8 +61,8 @@ func (ifr *IFileResource) Create(name, fileObject string) error {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = ifr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(IFileEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+	_, err = ifr.BigIP().RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(IFileEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -78,6 +70,11 @@ func (ifr *IFileResource) Create(name, fileObject string) error {
 }
 
 func (ifr *IFileResource) Edit(name, fileObject string) error {
+	return ifr.EditContext(context.Background(), name, fileObject)
+}
+
+// EditContext is like Edit but uses ctx for the request.
+func (ifr *IFileResource) EditContext(ctx context.Context, name, fileObject string) error {
 	item := map[string]string{
 		"name":      name,
 		"file-name": fileObject,
@@ -87,17 +84,8 @@ func (ifr *IFileResource) Edit(name, fileObject string) error {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = ifr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(IFileEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (ifr *IFileResource) Delete(name string) error {
-	_, err := ifr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(IFileEndpoint).ResourceInstance(name).DoRaw(context.Background())
+	_, err = ifr.BigIP().RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(IFileEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -2,18 +2,20 @@ package ltm
 
 import (
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
 
 	"testing"
 )
 
 func TestIFileResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	s := bigiptest.NewServer()
+	defer s.Close()
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
-	iFileResource := IFileResource{
-		b: bigIP,
-	}
+	iFileResource := newIFileResource(bigIP)
 
 	// Create an iFile
 	fileObject := "./file.txt"
@@ -32,6 +34,9 @@ func TestIFileResource(t *testing.T) {
 	if iFileCheck.Name != "test-ifile" {
 		t.Error("Name of iFile is not correct")
 	}
+	if _, ok := iFileCheck.Extra["fullPath"]; !ok {
+		t.Errorf("expected the attributes IFile does not model in Extra, got %v", iFileCheck.Extra)
+	}
 
 	// Update properties of the iFile
 	fileObjectUpdated := "./updated-file.txt"
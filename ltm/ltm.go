@@ -39,22 +39,22 @@ type LTM struct {
 // New creates a new LTM client.
 func New(b *bigip.BigIP) LTM {
 	return LTM{
-		virtual:             VirtualResource{b: b},
-		virtualAddress:      VirtualAddressResource{b: b},
+		virtual:             newVirtualResource(b),
+		virtualAddress:      newVirtualAddressResource(b),
 		virtualAddressStats: VirtualAddressStatsResource{b: b},
 		virtualStats:        VirtualStatsResource{b: b},
-		pool:                PoolResource{b: b},
-		snatPool:            SnatPoolResource{b: b},
+		pool:                newPoolResource(b),
+		snatPool:            newSnatPoolResource(b),
 		poolStats:           PoolStatsResource{b: b},
 		poolMembers:         PoolMembersResource{b: b},
-		rule:                RuleResource{b: b},
-		node:                NodeResource{b: b},
+		rule:                newRuleResource(b),
+		node:                newNodeResource(b),
 		nodeStats:           NodeStatsResource{b: b},
 
-		trafficMatchingCriteriaResource: TrafficMatchingCriteriaResource{b: b},
+		trafficMatchingCriteriaResource: newTrafficMatchingCriteriaResource(b),
 
-		iFile:             IFileResource{b: b},
-		dataGroupInternal: DataGroupInternalResource{b: b},
+		iFile:             newIFileResource(b),
+		dataGroupInternal: newDataGroupInternalResource(b),
 
 		// monitor
 		monitor: monitor.NewMonitor(b),
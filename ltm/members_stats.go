@@ -99,10 +99,15 @@ type MemberStats struct {
 
 // Specify pool and member, get the specified member stats.
 func (psr *PoolStatsResource) GetMemberStats(poolFullPathName, memberFullPathName string) (*MemberStatsList, error) {
+	return psr.GetMemberStatsContext(context.Background(), poolFullPathName, memberFullPathName)
+}
+
+// GetMemberStatsContext is like GetMemberStats but uses ctx for the request.
+func (psr *PoolStatsResource) GetMemberStatsContext(ctx context.Context, poolFullPathName, memberFullPathName string) (*MemberStatsList, error) {
 	var msl MemberStatsList
 	res, err := psr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
 		Resource(PoolEndpoint).ResourceInstance(poolFullPathName).SubResource(poolMembersEndpoint).
-		SubResourceInstance(memberFullPathName).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		SubResourceInstance(memberFullPathName).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -125,10 +130,15 @@ type PoolAllMemberStatsEntries struct {
 
 // Get the stats of all members in a pool.
 func (psr *PoolStatsResource) GetPoolAllMemberStats(poolFullPathName string) (*PoolAllMemberStatsList, error) {
+	return psr.GetPoolAllMemberStatsContext(context.Background(), poolFullPathName)
+}
+
+// GetPoolAllMemberStatsContext is like GetPoolAllMemberStats but uses ctx for the request.
+func (psr *PoolStatsResource) GetPoolAllMemberStatsContext(ctx context.Context, poolFullPathName string) (*PoolAllMemberStatsList, error) {
 	var pams PoolAllMemberStatsList
 	res, err := psr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
 		Resource(PoolEndpoint).ResourceInstance(poolFullPathName).SubResource(poolMembersEndpoint).
-		SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
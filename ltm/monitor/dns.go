@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type DNSList struct {
@@ -14,101 +10,54 @@ type DNSList struct {
 	SelfLink string `json:"selflink,omitempty"`
 }
 type DNS struct {
-	AcceptRcode              string `json:"acceptRcode,omitempty"`
-	Adaptive                 string `json:"adaptive,omitempty"`
-	AdaptiveDivergenceType   string `json:"adaptiveDivergenceType,omitempty"`
-	AdaptiveDivergenceValue  int    `json:"adaptiveDivergenceValue,omitempty"`
-	AdaptiveLimit            int    `json:"adaptiveLimit,omitempty"`
-	AdaptiveSamplingTimespan int    `json:"adaptiveSamplingTimespan,omitempty"`
-	AnswerContains           string `json:"answerContains,omitempty"`
-	AppService               string `json:"appService,omitempty"`
-	DefaultsFrom             string `json:"defaultsFrom,omitempty"`
-	Description              string `json:"description,omitempty"`
-	Destination              string `json:"destination,omitempty"`
-	FullPath                 string `json:"fullPath,omitempty"`
-	Generation               int    `json:"generation,omitempty"`
-	Interval                 int    `json:"interval,omitempty"`
-	Kind                     string `json:"kind,omitempty"`
-	ManualResume             string `json:"manualResume,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Partition                string `json:"partition,omitempty"`
-	Qname                    string `json:"qname,omitempty"`
-	Qtype                    string `json:"qtype,omitempty"`
-	Recv                     string `json:"recv,omitempty"`
-	Reverse                  string `json:"reverse,omitempty"`
-	SelfLink                 string `json:"selfLink,omitempty"`
-	TimeUntilUp              int    `json:"timeUntilUp,omitempty"`
-	Timeout                  int    `json:"timeout,omitempty"`
-	Transparent              string `json:"transparent,omitempty"`
-	UpInterval               int    `json:"upInterval,omitempty"`
+	AcceptRcode              string      `json:"acceptRcode,omitempty"`
+	Adaptive                 string      `json:"adaptive,omitempty"`
+	AdaptiveDivergenceType   string      `json:"adaptiveDivergenceType,omitempty"`
+	AdaptiveDivergenceValue  int         `json:"adaptiveDivergenceValue,omitempty"`
+	AdaptiveLimit            int         `json:"adaptiveLimit,omitempty"`
+	AdaptiveSamplingTimespan int         `json:"adaptiveSamplingTimespan,omitempty"`
+	AnswerContains           string      `json:"answerContains,omitempty"`
+	AppService               string      `json:"appService,omitempty"`
+	DefaultsFrom             string      `json:"defaultsFrom,omitempty"`
+	Description              string      `json:"description,omitempty"`
+	Destination              string      `json:"destination,omitempty"`
+	FullPath                 string      `json:"fullPath,omitempty"`
+	Generation               int         `json:"generation,omitempty"`
+	Interval                 int         `json:"interval,omitempty"`
+	Kind                     string      `json:"kind,omitempty"`
+	ManualResume             string      `json:"manualResume,omitempty"`
+	Name                     string      `json:"name,omitempty"`
+	Partition                string      `json:"partition,omitempty"`
+	Qname                    string      `json:"qname,omitempty"`
+	Qtype                    string      `json:"qtype,omitempty"`
+	Recv                     string      `json:"recv,omitempty"`
+	Reverse                  string      `json:"reverse,omitempty"`
+	SelfLink                 string      `json:"selfLink,omitempty"`
+	TimeUntilUp              int         `json:"timeUntilUp,omitempty"`
+	Timeout                  int         `json:"timeout,omitempty"`
+	Transparent              string      `json:"transparent,omitempty"`
+	UpInterval               int         `json:"upInterval,omitempty"`
+	Extra                    bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes DNS does not model in Extra.
+func (d *DNS) UnmarshalJSON(data []byte) error {
+	type plain DNS
+	return bigip.UnmarshalExtra(data, (*plain)(d), &d.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of DNS.
+func (d DNS) MarshalJSON() ([]byte, error) {
+	type plain DNS
+	return bigip.MarshalExtra(plain(d), d.Extra)
 }
 
 const DNSEndpoint = "dns"
 
 type DNSResource struct {
-	b *bigip.BigIP
+	bigip.Resource[DNS, DNSList]
 }
 
-func (mdr *DNSResource) List() (*DNSList, error) {
-	var mdcl DNSList
-	res, err := mdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DNSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
-}
-
-func (mdr *DNSResource) Get(fullPathName string) (*DNS, error) {
-	var mdc DNS
-	res, err := mdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DNSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
-}
-
-func (mdr *DNSResource) Create(item DNS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mdr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DNSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mdr *DNSResource) Update(name string, item DNS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mdr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DNSEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mdr *DNSResource) Delete(name string) error {
-	_, err := mdr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DNSEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newDNSResource(b *bigip.BigIP) DNSResource {
+	return DNSResource{bigip.NewResource[DNS, DNSList](b, LtmManager, MonitorEndpoint, DNSEndpoint)}
 }
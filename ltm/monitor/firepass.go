@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type FirepassList struct {
@@ -15,92 +11,45 @@ type FirepassList struct {
 }
 
 type Firepass struct {
-	AppService       string `json:"appService,omitempty"`
-	Cipherlist       string `json:"cipherlist,omitempty"`
-	ConcurrencyLimit int    `json:"concurrencyLimit,omitempty"`
-	DefaultsFrom     string `json:"defaultsFrom,omitempty"`
-	Description      string `json:"description,omitempty"`
-	Destination      string `json:"destination,omitempty"`
-	FullPath         string `json:"fullPath,omitempty"`
-	Generation       int    `json:"generation,omitempty"`
-	Interval         int    `json:"interval,omitempty"`
-	Kind             string `json:"kind,omitempty"`
-	MaxLoadAverage   int    `json:"maxLoadAverage,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Partition        string `json:"partition,omitempty"`
-	SelfLink         string `json:"selfLink,omitempty"`
-	TimeUntilUp      int    `json:"timeUntilUp,omitempty"`
-	Timeout          int    `json:"timeout,omitempty"`
-	UpInterval       int    `json:"upInterval,omitempty"`
-	Username         string `json:"username,omitempty"`
+	AppService       string      `json:"appService,omitempty"`
+	Cipherlist       string      `json:"cipherlist,omitempty"`
+	ConcurrencyLimit int         `json:"concurrencyLimit,omitempty"`
+	DefaultsFrom     string      `json:"defaultsFrom,omitempty"`
+	Description      string      `json:"description,omitempty"`
+	Destination      string      `json:"destination,omitempty"`
+	FullPath         string      `json:"fullPath,omitempty"`
+	Generation       int         `json:"generation,omitempty"`
+	Interval         int         `json:"interval,omitempty"`
+	Kind             string      `json:"kind,omitempty"`
+	MaxLoadAverage   int         `json:"maxLoadAverage,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	Partition        string      `json:"partition,omitempty"`
+	SelfLink         string      `json:"selfLink,omitempty"`
+	TimeUntilUp      int         `json:"timeUntilUp,omitempty"`
+	Timeout          int         `json:"timeout,omitempty"`
+	UpInterval       int         `json:"upInterval,omitempty"`
+	Username         string      `json:"username,omitempty"`
+	Extra            bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Firepass does not model in Extra.
+func (f *Firepass) UnmarshalJSON(data []byte) error {
+	type plain Firepass
+	return bigip.UnmarshalExtra(data, (*plain)(f), &f.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Firepass.
+func (f Firepass) MarshalJSON() ([]byte, error) {
+	type plain Firepass
+	return bigip.MarshalExtra(plain(f), f.Extra)
 }
 
 const FirepassEndpoint = "firepass"
 
 type FirepassResource struct {
-	b *bigip.BigIP
+	bigip.Resource[Firepass, FirepassList]
 }
 
-func (mfr *FirepassResource) List() (*FirepassList, error) {
-	var mfcl FirepassList
-	res, err := mfr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mfcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mfcl, nil
-}
-
-func (mfr *FirepassResource) Get(fullPathName string) (*Firepass, error) {
-	var mfc Firepass
-	res, err := mfr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mfc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mfc, nil
-}
-
-func (mfr *FirepassResource) Create(item Firepass) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mfr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mfr *FirepassResource) Update(name string, item Firepass) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mfr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mfr *FirepassResource) Delete(name string) error {
-	_, err := mfr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newFirepassResource(b *bigip.BigIP) FirepassResource {
+	return FirepassResource{bigip.NewResource[Firepass, FirepassList](b, LtmManager, MonitorEndpoint, FirepassEndpoint)}
 }
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type GatewayICMPList struct {
@@ -14,95 +10,48 @@ type GatewayICMPList struct {
 	SelfLink string        `json:"selflink,omitempty"`
 }
 type GatewayICMP struct {
-	Adaptive                 string `json:"adaptive,omitempty"`
-	AdaptiveDivergenceType   string `json:"adaptiveDivergenceType,omitempty"`
-	AdaptiveDivergenceValue  int    `json:"adaptiveDivergenceValue,omitempty"`
-	AdaptiveLimit            int    `json:"adaptiveLimit,omitempty"`
-	AdaptiveSamplingTimespan int    `json:"adaptiveSamplingTimespan,omitempty"`
-	AppService               string `json:"appService,omitempty"`
-	DefaultsFrom             string `json:"defaultsFrom,omitempty"`
-	Description              string `json:"description,omitempty"`
-	Destination              string `json:"destination,omitempty"`
-	FullPath                 string `json:"fullPath,omitempty"`
-	Generation               int    `json:"generation,omitempty"`
-	Interval                 int    `json:"interval,omitempty"`
-	Kind                     string `json:"kind,omitempty"`
-	ManualResume             string `json:"manualResume,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Partition                string `json:"partition,omitempty"`
-	SelfLink                 string `json:"selfLink,omitempty"`
-	TimeUntilUp              int    `json:"timeUntilUp,omitempty"`
-	Timeout                  int    `json:"timeout,omitempty"`
-	Transparent              string `json:"transparent,omitempty"`
-	UpInterval               int    `json:"upInterval,omitempty"`
+	Adaptive                 string      `json:"adaptive,omitempty"`
+	AdaptiveDivergenceType   string      `json:"adaptiveDivergenceType,omitempty"`
+	AdaptiveDivergenceValue  int         `json:"adaptiveDivergenceValue,omitempty"`
+	AdaptiveLimit            int         `json:"adaptiveLimit,omitempty"`
+	AdaptiveSamplingTimespan int         `json:"adaptiveSamplingTimespan,omitempty"`
+	AppService               string      `json:"appService,omitempty"`
+	DefaultsFrom             string      `json:"defaultsFrom,omitempty"`
+	Description              string      `json:"description,omitempty"`
+	Destination              string      `json:"destination,omitempty"`
+	FullPath                 string      `json:"fullPath,omitempty"`
+	Generation               int         `json:"generation,omitempty"`
+	Interval                 int         `json:"interval,omitempty"`
+	Kind                     string      `json:"kind,omitempty"`
+	ManualResume             string      `json:"manualResume,omitempty"`
+	Name                     string      `json:"name,omitempty"`
+	Partition                string      `json:"partition,omitempty"`
+	SelfLink                 string      `json:"selfLink,omitempty"`
+	TimeUntilUp              int         `json:"timeUntilUp,omitempty"`
+	Timeout                  int         `json:"timeout,omitempty"`
+	Transparent              string      `json:"transparent,omitempty"`
+	UpInterval               int         `json:"upInterval,omitempty"`
+	Extra                    bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes GatewayICMP does not model in Extra.
+func (g *GatewayICMP) UnmarshalJSON(data []byte) error {
+	type plain GatewayICMP
+	return bigip.UnmarshalExtra(data, (*plain)(g), &g.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of GatewayICMP.
+func (g GatewayICMP) MarshalJSON() ([]byte, error) {
+	type plain GatewayICMP
+	return bigip.MarshalExtra(plain(g), g.Extra)
 }
 
 const GatewayICMPEndpoint = "gateway-icmp"
 
 type GatewayICMPResource struct {
-	b *bigip.BigIP
+	bigip.Resource[GatewayICMP, GatewayICMPList]
 }
 
-func (mgir *GatewayICMPResource) List() (*GatewayICMPList, error) {
-	var mgicl GatewayICMPList
-	res, err := mgir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(GatewayICMPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mgicl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mgicl, nil
-}
-
-func (mgir *GatewayICMPResource) Get(fullPathName string) (*GatewayICMP, error) {
-	var mgic GatewayICMP
-	res, err := mgir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(GatewayICMPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mgic); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mgic, nil
-}
-
-func (mgir *GatewayICMPResource) Create(item GatewayICMP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mgir.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(GatewayICMPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mgir *GatewayICMPResource) Update(name string, item GatewayICMP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mgir.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(GatewayICMPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mgir *GatewayICMPResource) Delete(name string) error {
-	_, err := mgir.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(GatewayICMPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newGatewayICMPResource(b *bigip.BigIP) GatewayICMPResource {
+	return GatewayICMPResource{bigip.NewResource[GatewayICMP, GatewayICMPList](b, LtmManager, MonitorEndpoint, GatewayICMPEndpoint)}
 }
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type HTTPSList struct {
@@ -14,102 +10,55 @@ type HTTPSList struct {
 	SelfLink string  `json:"selflink"`
 }
 type HTTPS struct {
-	Adaptive                 string `json:"adaptive,omitempty"`
-	AdaptiveDivergenceType   string `json:"adaptiveDivergenceType,omitempty"`
-	AdaptiveDivergenceValue  int    `json:"adaptiveDivergenceValue,omitempty"`
-	AdaptiveLimit            int    `json:"adaptiveLimit,omitempty"`
-	AdaptiveSamplingTimespan int    `json:"adaptiveSamplingTimespan,omitempty"`
-	Cipherlist               string `json:"cipherlist,omitempty"`
-	Compatibility            string `json:"compatibility,omitempty"`
-	AppService               string `json:"appService,omitempty"`
-	DefaultsFrom             string `json:"defaultsFrom,omitempty"`
-	Description              string `json:"description,omitempty"`
-	Destination              string `json:"destination,omitempty"`
-	FullPath                 string `json:"fullPath,omitempty"`
-	Generation               int    `json:"generation,omitempty"`
-	Interval                 int    `json:"interval,omitempty"`
-	IPDscp                   int    `json:"ipDscp,omitempty"`
-	Kind                     string `json:"kind,omitempty"`
-	ManualResume             string `json:"manualResume,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Partition                string `json:"partition,omitempty"`
-	Recv                     string `json:"recv,omitempty"`
-	RecvDisable              string `json:"recvDisable,omitempty"`
-	Reverse                  string `json:"reverse,omitempty"`
-	SelfLink                 string `json:"selfLink,omitempty"`
-	Send                     string `json:"send,omitempty"`
-	TimeUntilUp              int    `json:"timeUntilUp,omitempty"`
-	Timeout                  int    `json:"timeout,omitempty"`
-	Transparent              string `json:"transparent,omitempty"`
-	UpInterval               int    `json:"upInterval,omitempty"`
+	Adaptive                 string      `json:"adaptive,omitempty"`
+	AdaptiveDivergenceType   string      `json:"adaptiveDivergenceType,omitempty"`
+	AdaptiveDivergenceValue  int         `json:"adaptiveDivergenceValue,omitempty"`
+	AdaptiveLimit            int         `json:"adaptiveLimit,omitempty"`
+	AdaptiveSamplingTimespan int         `json:"adaptiveSamplingTimespan,omitempty"`
+	Cipherlist               string      `json:"cipherlist,omitempty"`
+	Compatibility            string      `json:"compatibility,omitempty"`
+	AppService               string      `json:"appService,omitempty"`
+	DefaultsFrom             string      `json:"defaultsFrom,omitempty"`
+	Description              string      `json:"description,omitempty"`
+	Destination              string      `json:"destination,omitempty"`
+	FullPath                 string      `json:"fullPath,omitempty"`
+	Generation               int         `json:"generation,omitempty"`
+	Interval                 int         `json:"interval,omitempty"`
+	IPDscp                   int         `json:"ipDscp,omitempty"`
+	Kind                     string      `json:"kind,omitempty"`
+	ManualResume             string      `json:"manualResume,omitempty"`
+	Name                     string      `json:"name,omitempty"`
+	Partition                string      `json:"partition,omitempty"`
+	Recv                     string      `json:"recv,omitempty"`
+	RecvDisable              string      `json:"recvDisable,omitempty"`
+	Reverse                  string      `json:"reverse,omitempty"`
+	SelfLink                 string      `json:"selfLink,omitempty"`
+	Send                     string      `json:"send,omitempty"`
+	TimeUntilUp              int         `json:"timeUntilUp,omitempty"`
+	Timeout                  int         `json:"timeout,omitempty"`
+	Transparent              string      `json:"transparent,omitempty"`
+	UpInterval               int         `json:"upInterval,omitempty"`
+	Extra                    bigip.Extra `json:"-"`
 }
 
-const HTTPSEndpoint = "https"
-
-type HTTPSResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes HTTPS does not model in Extra.
+func (h *HTTPS) UnmarshalJSON(data []byte) error {
+	type plain HTTPS
+	return bigip.UnmarshalExtra(data, (*plain)(h), &h.Extra)
 }
 
-func (mhr *HTTPSResource) List() (*HTTPSList, error) {
-	var mhcl HTTPSList
-	res, err := mhr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mhcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mhcl, nil
+// MarshalJSON adds the attributes in Extra to those of HTTPS.
+func (h HTTPS) MarshalJSON() ([]byte, error) {
+	type plain HTTPS
+	return bigip.MarshalExtra(plain(h), h.Extra)
 }
 
-func (mhr *HTTPSResource) Get(fullPathName string) (*HTTPS, error) {
-	var mhc HTTPS
-	res, err := mhr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mhc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mhc, nil
-}
-
-func (mhr *HTTPSResource) Create(item HTTPS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mhr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+const HTTPSEndpoint = "https"
 
-func (mhr *HTTPSResource) Update(name string, item HTTPS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mhr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type HTTPSResource struct {
+	bigip.Resource[HTTPS, HTTPSList]
 }
 
-func (mhr *HTTPSResource) Delete(name string) error {
-	_, err := mhr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newHTTPSResource(b *bigip.BigIP) HTTPSResource {
+	return HTTPSResource{bigip.NewResource[HTTPS, HTTPSList](b, LtmManager, MonitorEndpoint, HTTPSEndpoint)}
 }
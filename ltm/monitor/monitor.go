@@ -50,44 +50,44 @@ type MonitorResource struct {
 
 func NewMonitor(b *bigip.BigIP) MonitorResource {
 	return MonitorResource{
-		diameter:         DiameterResource{b: b},
-		dns:              DNSResource{b: b},
-		external:         ExternalResource{b: b},
-		firepass:         FirepassResource{b: b},
-		ftp:              FTPResource{b: b},
-		gatewayicmp:      GatewayICMPResource{b: b},
-		http:             HTTPResource{b: b},
-		https:            HTTPSResource{b: b},
-		icmp:             ICMPResource{b: b},
-		imap:             IMAPResource{b: b},
-		inband:           InbandResource{b: b},
-		ldap:             LDAPResource{b: b},
-		modulescore:      ModuleScoreResource{b: b},
-		mssql:            MSSQLResource{b: b},
-		mysql:            MySQLResource{b: b},
-		nntp:             NNTPResource{b: b},
-		oracle:           OracleResource{b: b},
-		pop3:             POP3Resource{b: b},
-		postgresql:       PostgreSQLResource{b: b},
-		radiusaccounting: RadiusAccountingResource{b: b},
-		radius:           RadiusResource{b: b},
-		realserver:       RealServerResource{b: b},
-		rpc:              RPCResource{b: b},
-		sasp:             SASPResource{b: b},
-		scripted:         ScriptedResource{b: b},
-		sip:              SIPResource{b: b},
-		smb:              SMBResource{b: b},
-		smtp:             SMTPResource{b: b},
-		snmpdcabase:      SNMPDCABaseResource{b: b},
-		snmpdca:          SNMPDCAResource{b: b},
-		soap:             SOAPResource{b: b},
-		tcpecho:          TCPEchoResource{b: b},
-		tcp:              TCPResource{b: b},
-		tcphalfopen:      TCPHalfOpenResource{b: b},
-		udp:              UDPResource{b: b},
-		virtuallocation:  VirtualLocationResource{b: b},
-		wap:              WAPResource{b: b},
-		wmi:              WMIResource{b: b},
+		diameter:         newDiameterResource(b),
+		dns:              newDNSResource(b),
+		external:         newExternalResource(b),
+		firepass:         newFirepassResource(b),
+		ftp:              newFTPResource(b),
+		gatewayicmp:      newGatewayICMPResource(b),
+		http:             newHTTPResource(b),
+		https:            newHTTPSResource(b),
+		icmp:             newICMPResource(b),
+		imap:             newIMAPResource(b),
+		inband:           newInbandResource(b),
+		ldap:             newLDAPResource(b),
+		modulescore:      newModuleScoreResource(b),
+		mssql:            newMSSQLResource(b),
+		mysql:            newMySQLResource(b),
+		nntp:             newNNTPResource(b),
+		oracle:           newOracleResource(b),
+		pop3:             newPOP3Resource(b),
+		postgresql:       newPostgreSQLResource(b),
+		radiusaccounting: newRadiusAccountingResource(b),
+		radius:           newRadiusResource(b),
+		realserver:       newRealServerResource(b),
+		rpc:              newRPCResource(b),
+		sasp:             newSASPResource(b),
+		scripted:         newScriptedResource(b),
+		sip:              newSIPResource(b),
+		smb:              newSMBResource(b),
+		smtp:             newSMTPResource(b),
+		snmpdcabase:      newSNMPDCABaseResource(b),
+		snmpdca:          newSNMPDCAResource(b),
+		soap:             newSOAPResource(b),
+		tcpecho:          newTCPEchoResource(b),
+		tcp:              newTCPResource(b),
+		tcphalfopen:      newTCPHalfOpenResource(b),
+		udp:              newUDPResource(b),
+		virtuallocation:  newVirtualLocationResource(b),
+		wap:              newWAPResource(b),
+		wmi:              newWMIResource(b),
 	}
 }
 
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type POP3List struct {
@@ -15,90 +11,43 @@ type POP3List struct {
 }
 
 type POP3 struct {
-	AppService   string `json:"appService,omitempty"`
-	Debug        string `json:"debug,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Destination  string `json:"destination,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	Interval     int    `json:"interval,omitempty"`
-	Kind         string `json:"kind,omitempty"`
-	ManualResume string `json:"manualResume,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	TimeUntilUp  int    `json:"timeUntilUp,omitempty"`
-	Timeout      int    `json:"timeout,omitempty"`
-	UpInterval   int    `json:"upInterval,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	Debug        string      `json:"debug,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Destination  string      `json:"destination,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	Interval     int         `json:"interval,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	ManualResume string      `json:"manualResume,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	TimeUntilUp  int         `json:"timeUntilUp,omitempty"`
+	Timeout      int         `json:"timeout,omitempty"`
+	UpInterval   int         `json:"upInterval,omitempty"`
+	Extra        bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes POP3 does not model in Extra.
+func (p *POP3) UnmarshalJSON(data []byte) error {
+	type plain POP3
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of POP3.
+func (p POP3) MarshalJSON() ([]byte, error) {
+	type plain POP3
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
 const POP3Endpoint = "pop3"
 
 type POP3Resource struct {
-	b *bigip.BigIP
+	bigip.Resource[POP3, POP3List]
 }
 
-func (mpr *POP3Resource) List() (*POP3List, error) {
-	var mpcl POP3List
-	res, err := mpr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mpcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mpcl, nil
-}
-
-func (mpr *POP3Resource) Get(fullPathName string) (*POP3, error) {
-	var mpc POP3
-	res, err := mpr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mpc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mpc, nil
-}
-
-func (mpr *POP3Resource) Create(item POP3) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mpr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mpr *POP3Resource) Update(name string, item POP3) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mpr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mpr *POP3Resource) Delete(name string) error {
-	_, err := mpr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newPOP3Resource(b *bigip.BigIP) POP3Resource {
+	return POP3Resource{bigip.NewResource[POP3, POP3List](b, LtmManager, MonitorEndpoint, POP3Endpoint)}
 }
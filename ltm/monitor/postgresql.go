@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type PostgreSQLList struct {
@@ -14,96 +10,49 @@ type PostgreSQLList struct {
 	SelfLink string       `json:"selflink,omitempty"`
 }
 type PostgreSQL struct {
-	AppService   string `json:"appService,omitempty"`
-	Count        string `json:"count,omitempty"`
-	Database     string `json:"database,omitempty"`
-	Debug        string `json:"debug,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Destination  string `json:"destination,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	Interval     int    `json:"interval,omitempty"`
-	Kind         string `json:"kind,omitempty"`
-	ManualResume string `json:"manualResume,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	Recv         string `json:"recv,omitempty"`
-	RecvColumn   string `json:"recvColumn,omitempty"`
-	RecvRow      string `json:"recvRow,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	Send         string `json:"send,omitempty"`
-	TimeUntilUp  int    `json:"timeUntilUp,omitempty"`
-	Timeout      int    `json:"timeout,omitempty"`
-	UpInterval   int    `json:"upInterval,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	Count        string      `json:"count,omitempty"`
+	Database     string      `json:"database,omitempty"`
+	Debug        string      `json:"debug,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Destination  string      `json:"destination,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	Interval     int         `json:"interval,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	ManualResume string      `json:"manualResume,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	Recv         string      `json:"recv,omitempty"`
+	RecvColumn   string      `json:"recvColumn,omitempty"`
+	RecvRow      string      `json:"recvRow,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	Send         string      `json:"send,omitempty"`
+	TimeUntilUp  int         `json:"timeUntilUp,omitempty"`
+	Timeout      int         `json:"timeout,omitempty"`
+	UpInterval   int         `json:"upInterval,omitempty"`
+	Extra        bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes PostgreSQL does not model in Extra.
+func (p *PostgreSQL) UnmarshalJSON(data []byte) error {
+	type plain PostgreSQL
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of PostgreSQL.
+func (p PostgreSQL) MarshalJSON() ([]byte, error) {
+	type plain PostgreSQL
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
 const PostgreSQLEndpoint = "postgresql"
 
 type PostgreSQLResource struct {
-	b *bigip.BigIP
+	bigip.Resource[PostgreSQL, PostgreSQLList]
 }
 
-func (mpr *PostgreSQLResource) List() (*PostgreSQLList, error) {
-	var mpcl PostgreSQLList
-	res, err := mpr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mpcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mpcl, nil
-}
-
-func (mpr *PostgreSQLResource) Get(fullPathName string) (*PostgreSQL, error) {
-	var mpc PostgreSQL
-	res, err := mpr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mpc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mpc, nil
-}
-
-func (mpr *PostgreSQLResource) Create(item PostgreSQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mpr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mpr *PostgreSQLResource) Update(name string, item PostgreSQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mpr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mpr *PostgreSQLResource) Delete(name string) error {
-	_, err := mpr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newPostgreSQLResource(b *bigip.BigIP) PostgreSQLResource {
+	return PostgreSQLResource{bigip.NewResource[PostgreSQL, PostgreSQLList](b, LtmManager, MonitorEndpoint, PostgreSQLEndpoint)}
 }
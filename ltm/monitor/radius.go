@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type RadiusList struct {
@@ -14,91 +10,44 @@ type RadiusList struct {
 	SelfLink string   `json:"selflink,omitempty"`
 }
 type Radius struct {
-	AppService   string `json:"appService,omitempty"`
-	Debug        string `json:"debug,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Destination  string `json:"destination,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	Interval     int    `json:"interval,omitempty"`
-	Kind         string `json:"kind,omitempty"`
-	ManualResume string `json:"manualResume,omitempty"`
-	Name         string `json:"name,omitempty"`
-	NasIpAddress string `json:"nasIpAddress,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	TimeUntilUp  int    `json:"timeUntilUp,omitempty"`
-	Timeout      int    `json:"timeout,omitempty"`
-	UpInterval   int    `json:"upInterval,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	Debug        string      `json:"debug,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Destination  string      `json:"destination,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	Interval     int         `json:"interval,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	ManualResume string      `json:"manualResume,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	NasIpAddress string      `json:"nasIpAddress,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	TimeUntilUp  int         `json:"timeUntilUp,omitempty"`
+	Timeout      int         `json:"timeout,omitempty"`
+	UpInterval   int         `json:"upInterval,omitempty"`
+	Extra        bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Radius does not model in Extra.
+func (r *Radius) UnmarshalJSON(data []byte) error {
+	type plain Radius
+	return bigip.UnmarshalExtra(data, (*plain)(r), &r.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Radius.
+func (r Radius) MarshalJSON() ([]byte, error) {
+	type plain Radius
+	return bigip.MarshalExtra(plain(r), r.Extra)
 }
 
 const RadiusEndpoint = "radius"
 
 type RadiusResource struct {
-	b *bigip.BigIP
+	bigip.Resource[Radius, RadiusList]
 }
 
-func (mrr *RadiusResource) List() (*RadiusList, error) {
-	var mrcl RadiusList
-	res, err := mrr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mrcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mrcl, nil
-}
-
-func (mrr *RadiusResource) Get(fullPathName string) (*Radius, error) {
-	var mrc Radius
-	res, err := mrr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mrc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mrc, nil
-}
-
-func (mrr *RadiusResource) Create(item Radius) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mrr *RadiusResource) Update(name string, item Radius) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).SubResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mrr *RadiusResource) Delete(name string) error {
-	_, err := mrr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newRadiusResource(b *bigip.BigIP) RadiusResource {
+	return RadiusResource{bigip.NewResource[Radius, RadiusList](b, LtmManager, MonitorEndpoint, RadiusEndpoint)}
 }
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type RadiusAccountingList struct {
@@ -14,91 +10,44 @@ type RadiusAccountingList struct {
 	SelfLink string             `json:"selflink,omitempty"`
 }
 type RadiusAccounting struct {
-	AppService   string `json:"appService,omitempty"`
-	Debug        string `json:"debug,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Destination  string `json:"destination,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	Interval     int    `json:"interval,omitempty"`
-	Kind         string `json:"kind,omitempty"`
-	ManualResume string `json:"manualResume,omitempty"`
-	Name         string `json:"name,omitempty"`
-	NasIpAddress string `json:"nasIpAddress,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	TimeUntilUp  int    `json:"timeUntilUp,omitempty"`
-	Timeout      int    `json:"timeout,omitempty"`
-	UpInterval   int    `json:"upInterval,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	Debug        string      `json:"debug,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Destination  string      `json:"destination,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	Interval     int         `json:"interval,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	ManualResume string      `json:"manualResume,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	NasIpAddress string      `json:"nasIpAddress,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	TimeUntilUp  int         `json:"timeUntilUp,omitempty"`
+	Timeout      int         `json:"timeout,omitempty"`
+	UpInterval   int         `json:"upInterval,omitempty"`
+	Extra        bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes RadiusAccounting does not model in Extra.
+func (r *RadiusAccounting) UnmarshalJSON(data []byte) error {
+	type plain RadiusAccounting
+	return bigip.UnmarshalExtra(data, (*plain)(r), &r.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of RadiusAccounting.
+func (r RadiusAccounting) MarshalJSON() ([]byte, error) {
+	type plain RadiusAccounting
+	return bigip.MarshalExtra(plain(r), r.Extra)
 }
 
 const RadiusAccountingEndpoint = "rarius-accounting"
 
 type RadiusAccountingResource struct {
-	b *bigip.BigIP
+	bigip.Resource[RadiusAccounting, RadiusAccountingList]
 }
 
-func (mrar *RadiusAccountingResource) List() (*RadiusAccountingList, error) {
-	var mracl RadiusAccountingList
-	res, err := mrar.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mracl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mracl, nil
-}
-
-func (mrar *RadiusAccountingResource) Get(fullPathName string) (*RadiusAccounting, error) {
-	var mrac RadiusAccounting
-	res, err := mrar.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mrac); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mrac, nil
-}
-
-func (mrar *RadiusAccountingResource) Create(item RadiusAccounting) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrar.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mrar *RadiusAccountingResource) Update(name string, item RadiusAccounting) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrar.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mrar *RadiusAccountingResource) Delete(name string) error {
-	_, err := mrar.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubStatsResource(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newRadiusAccountingResource(b *bigip.BigIP) RadiusAccountingResource {
+	return RadiusAccountingResource{bigip.NewResource[RadiusAccounting, RadiusAccountingList](b, LtmManager, MonitorEndpoint, RadiusAccountingEndpoint)}
 }
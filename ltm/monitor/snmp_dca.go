@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type SNMPDCAList struct {
@@ -15,97 +11,50 @@ type SNMPDCAList struct {
 }
 
 type SNMPDCA struct {
-	AgentType         string `json:"agentType,omitempty"`
-	AppService        string `json:"appService,omitempty"`
-	Community         string `json:"community,omitempty"`
-	CPUCoefficient    string `json:"cpuCoefficient,omitempty"`
-	CPUThreshold      string `json:"cpuThreshold,omitempty"`
-	DefaultsFrom      string `json:"defaultsFrom,omitempty"`
-	Description       string `json:"description,omitempty"`
-	Destination       string `json:"destination,omitempty"`
-	DiskCoefficient   string `json:"diskCoefficient,omitempty"`
-	DiskThreshold     string `json:"diskThreshold,omitempty"`
-	FullPath          string `json:"fullPath,omitempty"`
-	Generation        int    `json:"generation,omitempty"`
-	Interval          int    `json:"interval,omitempty"`
-	Kind              string `json:"kind,omitempty"`
-	MemoryCoefficient string `json:"memoryCoefficient,omitempty"`
-	MemoryThreshold   string `json:"memoryThreshold,omitempty"`
-	Name              string `json:"name,omitempty"`
-	Partition         string `json:"partition,omitempty"`
-	SelfLink          string `json:"selfLink,omitempty"`
-	TimeUntilUp       int    `json:"timeUntilUp,omitempty"`
-	Timeout           int    `json:"timeout,omitempty"`
-	UserDefined       string `json:"userDefined,omitempty"`
-	Version           string `json:"version,omitempty"`
+	AgentType         string      `json:"agentType,omitempty"`
+	AppService        string      `json:"appService,omitempty"`
+	Community         string      `json:"community,omitempty"`
+	CPUCoefficient    string      `json:"cpuCoefficient,omitempty"`
+	CPUThreshold      string      `json:"cpuThreshold,omitempty"`
+	DefaultsFrom      string      `json:"defaultsFrom,omitempty"`
+	Description       string      `json:"description,omitempty"`
+	Destination       string      `json:"destination,omitempty"`
+	DiskCoefficient   string      `json:"diskCoefficient,omitempty"`
+	DiskThreshold     string      `json:"diskThreshold,omitempty"`
+	FullPath          string      `json:"fullPath,omitempty"`
+	Generation        int         `json:"generation,omitempty"`
+	Interval          int         `json:"interval,omitempty"`
+	Kind              string      `json:"kind,omitempty"`
+	MemoryCoefficient string      `json:"memoryCoefficient,omitempty"`
+	MemoryThreshold   string      `json:"memoryThreshold,omitempty"`
+	Name              string      `json:"name,omitempty"`
+	Partition         string      `json:"partition,omitempty"`
+	SelfLink          string      `json:"selfLink,omitempty"`
+	TimeUntilUp       int         `json:"timeUntilUp,omitempty"`
+	Timeout           int         `json:"timeout,omitempty"`
+	UserDefined       string      `json:"userDefined,omitempty"`
+	Version           string      `json:"version,omitempty"`
+	Extra             bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes SNMPDCA does not model in Extra.
+func (s *SNMPDCA) UnmarshalJSON(data []byte) error {
+	type plain SNMPDCA
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of SNMPDCA.
+func (s SNMPDCA) MarshalJSON() ([]byte, error) {
+	type plain SNMPDCA
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
 const SNMPDCAEndpoint = "snmp-dca"
 
 type SNMPDCAResource struct {
-	b *bigip.BigIP
+	bigip.Resource[SNMPDCA, SNMPDCAList]
 }
 
-func (msdr *SNMPDCAResource) List() (*SNMPDCAList, error) {
-	var msdcl SNMPDCAList
-	res, err := msdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCAEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &msdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msdcl, nil
-}
-
-func (msdr *SNMPDCAResource) Get(fullPathName string) (*SNMPDCA, error) {
-	var msdc SNMPDCA
-	res, err := msdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCAEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &msdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msdc, nil
-}
-
-func (msdr *SNMPDCAResource) Create(item SNMPDCA) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msdr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCAEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (msdr *SNMPDCAResource) Update(name string, item SNMPDCA) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msdr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCAEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (msdr *SNMPDCAResource) Delete(name string) error {
-	_, err := msdr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCAEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newSNMPDCAResource(b *bigip.BigIP) SNMPDCAResource {
+	return SNMPDCAResource{bigip.NewResource[SNMPDCA, SNMPDCAList](b, LtmManager, MonitorEndpoint, SNMPDCAEndpoint)}
 }
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type SNMPDCABaseList struct {
@@ -15,89 +11,43 @@ type SNMPDCABaseList struct {
 }
 
 type SNMPDCABase struct {
-	AppService   string `json:"appService,omitempty"`
-	Community    string `json:"community,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Destination  string `json:"destination,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	Interval     int    `json:"interval,omitempty"`
-	Kind         string `json:"kind,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	TimeUntilUp  int    `json:"timeUntilUp,omitempty"`
-	Timeout      int    `json:"timeout,omitempty"`
-	UserDefined  string `json:"userDefined,omitempty"`
-	Version      string `json:"version,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	Community    string      `json:"community,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Destination  string      `json:"destination,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	Interval     int         `json:"interval,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	TimeUntilUp  int         `json:"timeUntilUp,omitempty"`
+	Timeout      int         `json:"timeout,omitempty"`
+	UserDefined  string      `json:"userDefined,omitempty"`
+	Version      string      `json:"version,omitempty"`
+	Extra        bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes SNMPDCABase does not model in Extra.
+func (s *SNMPDCABase) UnmarshalJSON(data []byte) error {
+	type plain SNMPDCABase
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of SNMPDCABase.
+func (s SNMPDCABase) MarshalJSON() ([]byte, error) {
+	type plain SNMPDCABase
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
 const SNMPDCABaseEndpoint = "snmp-dca-base"
 
 type SNMPDCABaseResource struct {
-	b *bigip.BigIP
+	bigip.Resource[SNMPDCABase, SNMPDCABaseList]
 }
 
-func (msdbr *SNMPDCABaseResource) List() (*SNMPDCABaseList, error) {
-	var msdbcl SNMPDCABaseList
-	res, err := msdbr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCABaseEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &msdbcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msdbcl, nil
-}
-
-func (msdbr *SNMPDCABaseResource) Get(fullPathName string) (*SNMPDCABase, error) {
-	var msdbc SNMPDCABase
-	res, err := msdbr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCABaseEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &msdbc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msdbc, nil
-}
-func (msdbr *SNMPDCABaseResource) Create(item SNMPDCABase) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msdbr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCABaseEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (msdbr *SNMPDCABaseResource) Update(name string, item SNMPDCABase) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msdbr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCABaseEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (msdbr *SNMPDCABaseResource) Delete(name string) error {
-	_, err := msdbr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCABaseEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newSNMPDCABaseResource(b *bigip.BigIP) SNMPDCABaseResource {
+	return SNMPDCABaseResource{bigip.NewResource[SNMPDCABase, SNMPDCABaseList](b, LtmManager, MonitorEndpoint, SNMPDCABaseEndpoint)}
 }
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type TCPHalfOpenList struct {
@@ -14,90 +10,43 @@ type TCPHalfOpenList struct {
 	SelfLink string        `json:"selflink,omitempty"`
 }
 type TCPHalfOpen struct {
-	AppService   string `json:"appService,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Destination  string `json:"destination,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	Interval     int    `json:"interval,omitempty"`
-	Kind         string `json:"kind,omitempty"`
-	ManualResume string `json:"manualResume,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	TimeUntilUp  int    `json:"timeUntilUp,omitempty"`
-	Timeout      int    `json:"timeout,omitempty"`
-	Transparent  string `json:"transparent,omitempty"`
-	UpInterval   int    `json:"upInterval,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Destination  string      `json:"destination,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	Interval     int         `json:"interval,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	ManualResume string      `json:"manualResume,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	TimeUntilUp  int         `json:"timeUntilUp,omitempty"`
+	Timeout      int         `json:"timeout,omitempty"`
+	Transparent  string      `json:"transparent,omitempty"`
+	UpInterval   int         `json:"upInterval,omitempty"`
+	Extra        bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes TCPHalfOpen does not model in Extra.
+func (t *TCPHalfOpen) UnmarshalJSON(data []byte) error {
+	type plain TCPHalfOpen
+	return bigip.UnmarshalExtra(data, (*plain)(t), &t.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of TCPHalfOpen.
+func (t TCPHalfOpen) MarshalJSON() ([]byte, error) {
+	type plain TCPHalfOpen
+	return bigip.MarshalExtra(plain(t), t.Extra)
 }
 
 const TCPHalfOpenEndpoint = "tcp-half-open"
 
 type TCPHalfOpenResource struct {
-	b *bigip.BigIP
+	bigip.Resource[TCPHalfOpen, TCPHalfOpenList]
 }
 
-func (mthor *TCPHalfOpenResource) List() (*TCPHalfOpenList, error) {
-	var mthocl TCPHalfOpenList
-	res, err := mthor.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfOpenEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mthocl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mthocl, nil
-}
-
-func (mthor *TCPHalfOpenResource) Get(fullPathName string) (*TCPHalfOpen, error) {
-	var mthoc TCPHalfOpen
-	res, err := mthor.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfOpenEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mthoc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mthoc, nil
-}
-
-func (mthor *TCPHalfOpenResource) Create(item TCPHalfOpen) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mthor.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfOpenEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mthor *TCPHalfOpenResource) Update(name string, item TCPHalfOpen) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mthor.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfOpenEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mthor *TCPHalfOpenResource) Delete(name string) error {
-	_, err := mthor.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfOpenEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newTCPHalfOpenResource(b *bigip.BigIP) TCPHalfOpenResource {
+	return TCPHalfOpenResource{bigip.NewResource[TCPHalfOpen, TCPHalfOpenList](b, LtmManager, MonitorEndpoint, TCPHalfOpenEndpoint)}
 }
@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type UDPList struct {
@@ -15,100 +11,53 @@ type UDPList struct {
 }
 
 type UDP struct {
-	Adaptive                 string `json:"adaptive,omitempty"`
-	AdaptiveDivergenceType   string `json:"adaptiveDivergenceType,omitempty"`
-	AdaptiveDivergenceValue  int    `json:"adaptiveDivergenceValue,omitempty"`
-	AdaptiveLimit            int    `json:"adaptiveLimit,omitempty"`
-	AdaptiveSamplingTimespan int    `json:"adaptiveSamplingTimespan,omitempty"`
-	AppService               string `json:"appService,omitempty"`
-	Debug                    string `json:"debug,omitempty"`
-	DefaultsFrom             string `json:"defaultsFrom,omitempty"`
-	Description              string `json:"description,omitempty"`
-	Destination              string `json:"destination,omitempty"`
-	FullPath                 string `json:"fullPath,omitempty"`
-	Generation               int    `json:"generation,omitempty"`
-	Interval                 int    `json:"interval,omitempty"`
-	Kind                     string `json:"kind,omitempty"`
-	ManualResume             string `json:"manualResume,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Partition                string `json:"partition,omitempty"`
-	Recv                     string `json:"recv,omitempty"`
-	RecvDisable              string `json:"recvDisable,omitempty"`
-	Reverse                  string `json:"reverse,omitempty"`
-	SelfLink                 string `json:"selfLink,omitempty"`
-	Send                     string `json:"send,omitempty"`
-	TimeUntilUp              int    `json:"timeUntilUp,omitempty"`
-	Timeout                  int    `json:"timeout,omitempty"`
-	Transparent              string `json:"transparent,omitempty"`
-	UpInterval               int    `json:"upInterval,omitempty"`
+	Adaptive                 string      `json:"adaptive,omitempty"`
+	AdaptiveDivergenceType   string      `json:"adaptiveDivergenceType,omitempty"`
+	AdaptiveDivergenceValue  int         `json:"adaptiveDivergenceValue,omitempty"`
+	AdaptiveLimit            int         `json:"adaptiveLimit,omitempty"`
+	AdaptiveSamplingTimespan int         `json:"adaptiveSamplingTimespan,omitempty"`
+	AppService               string      `json:"appService,omitempty"`
+	Debug                    string      `json:"debug,omitempty"`
+	DefaultsFrom             string      `json:"defaultsFrom,omitempty"`
+	Description              string      `json:"description,omitempty"`
+	Destination              string      `json:"destination,omitempty"`
+	FullPath                 string      `json:"fullPath,omitempty"`
+	Generation               int         `json:"generation,omitempty"`
+	Interval                 int         `json:"interval,omitempty"`
+	Kind                     string      `json:"kind,omitempty"`
+	ManualResume             string      `json:"manualResume,omitempty"`
+	Name                     string      `json:"name,omitempty"`
+	Partition                string      `json:"partition,omitempty"`
+	Recv                     string      `json:"recv,omitempty"`
+	RecvDisable              string      `json:"recvDisable,omitempty"`
+	Reverse                  string      `json:"reverse,omitempty"`
+	SelfLink                 string      `json:"selfLink,omitempty"`
+	Send                     string      `json:"send,omitempty"`
+	TimeUntilUp              int         `json:"timeUntilUp,omitempty"`
+	Timeout                  int         `json:"timeout,omitempty"`
+	Transparent              string      `json:"transparent,omitempty"`
+	UpInterval               int         `json:"upInterval,omitempty"`
+	Extra                    bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes UDP does not model in Extra.
+func (u *UDP) UnmarshalJSON(data []byte) error {
+	type plain UDP
+	return bigip.UnmarshalExtra(data, (*plain)(u), &u.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of UDP.
+func (u UDP) MarshalJSON() ([]byte, error) {
+	type plain UDP
+	return bigip.MarshalExtra(plain(u), u.Extra)
 }
 
 const UDPEndpoint = "udp"
 
 type UDPResource struct {
-	b *bigip.BigIP
+	bigip.Resource[UDP, UDPList]
 }
 
-func (mur *UDPResource) List() (*UDPList, error) {
-	var mucl UDPList
-	res, err := mur.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mucl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mucl, nil
-}
-
-func (mur *UDPResource) Get(fullPathName string) (*UDP, error) {
-	var muc UDP
-	res, err := mur.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &muc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &muc, nil
-}
-
-func (mur *UDPResource) Create(item UDP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mur.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mur *UDPResource) Update(name string, item UDP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mur.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mur *UDPResource) Delete(name string) error {
-	_, err := mur.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newUDPResource(b *bigip.BigIP) UDPResource {
+	return UDPResource{bigip.NewResource[UDP, UDPList](b, LtmManager, MonitorEndpoint, UDPEndpoint)}
 }
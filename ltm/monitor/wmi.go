@@ -1,11 +1,7 @@
 package monitor
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type WMIList struct {
@@ -14,93 +10,46 @@ type WMIList struct {
 	SelfLink string `json:"selflink,omitempty"`
 }
 type WMI struct {
-	Agent        string `json:"agent,omitempty"`
-	AppService   string `json:"appService,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Destination  string `json:"destination,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	Interval     int    `json:"interval,omitempty"`
-	Kind         string `json:"kind,omitempty"`
-	Method       string `json:"method,omitempty"`
-	Metrics      string `json:"metrics,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	Post         string `json:"post,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	TimeUntilUp  int    `json:"timeUntilUp,omitempty"`
-	Timeout      int    `json:"timeout,omitempty"`
-	TmCommand    string `json:"tmCommand,omitempty"`
-	URL          string `json:"url,omitempty"`
+	Agent        string      `json:"agent,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Destination  string      `json:"destination,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	Interval     int         `json:"interval,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Method       string      `json:"method,omitempty"`
+	Metrics      string      `json:"metrics,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	Post         string      `json:"post,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	TimeUntilUp  int         `json:"timeUntilUp,omitempty"`
+	Timeout      int         `json:"timeout,omitempty"`
+	TmCommand    string      `json:"tmCommand,omitempty"`
+	URL          string      `json:"url,omitempty"`
+	Extra        bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes WMI does not model in Extra.
+func (w *WMI) UnmarshalJSON(data []byte) error {
+	type plain WMI
+	return bigip.UnmarshalExtra(data, (*plain)(w), &w.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of WMI.
+func (w WMI) MarshalJSON() ([]byte, error) {
+	type plain WMI
+	return bigip.MarshalExtra(plain(w), w.Extra)
 }
 
 const WMIEndpoint = "wmi"
 
 type WMIResource struct {
-	b *bigip.BigIP
+	bigip.Resource[WMI, WMIList]
 }
 
-func (mwr *WMIResource) List() (*WMIList, error) {
-	var mwcl WMIList
-	res, err := mwr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &mwcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mwcl, nil
-}
-
-func (mwr *WMIResource) Get(fullPathName string) (*WMI, error) {
-	var mwc WMI
-	res, err := mwr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mwc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mwc, nil
-}
-
-func (mwr *WMIResource) Create(item WMI) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mwr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mwr *WMIResource) Update(name string, item WMI) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mwr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (mwr *WMIResource) Delete(name string) error {
-	_, err := mwr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newWMIResource(b *bigip.BigIP) WMIResource {
+	return WMIResource{bigip.NewResource[WMI, WMIList](b, LtmManager, MonitorEndpoint, WMIEndpoint)}
 }
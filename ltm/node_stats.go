@@ -49,9 +49,14 @@ type NodeStatsResource struct {
 }
 
 func (nsr *NodeStatsResource) List() (*NodeStatsList, error) {
+	return nsr.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (nsr *NodeStatsResource) ListContext(ctx context.Context) (*NodeStatsList, error) {
 	var nsl NodeStatsList
 	res, err := nsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(NodeEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(NodeEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
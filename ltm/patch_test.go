@@ -0,0 +1,41 @@
+package ltm
+
+import (
+	"encoding/json"
+	"github.com/lefeck/go-bigip"
+	"testing"
+	"time"
+)
+
+func TestPatchBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   any
+		mask     []string
+		expected string
+	}{
+		{name: "pool", fields: Pool{Monitor: "http"}, expected: `{"monitor":"http"}`},
+		{name: "pool with opts and mask", fields: Pool{MinActiveMembers: bigip.Some[int64](0), Description: bigip.Some("")}, mask: []string{"monitor"},
+			expected: `{"description":"","minActiveMembers":0,"monitor":""}`},
+		{name: "pool extra", fields: Pool{Extra: bigip.Extra{"newAttribute": json.RawMessage(`"value"`)}},
+			expected: `{"newAttribute":"value"}`},
+		{name: "virtual server", fields: VirtualServer{Destination: "/Common/10.0.0.1:80", Rules: bigip.Some([]string{})},
+			expected: `{"destination":"/Common/10.0.0.1:80","rules":[]}`},
+		{name: "virtual server nested struct", fields: &VirtualServer{SourceAddressTranslation: SourceAddressTranslation{Type: "automap"}},
+			expected: `{"sourceAddressTranslation":{"type":"automap"}}`},
+		{name: "virtual server time", fields: VirtualServer{CreationTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			expected: `{"creationTime":"2024-01-01T00:00:00Z"}`},
+		{name: "empty", fields: VirtualServer{}, expected: `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := bigip.PatchBody(tt.fields, tt.mask...)
+			if err != nil {
+				t.Fatalf("PatchBody: %v", err)
+			}
+			if string(body) != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, body)
+			}
+		})
+	}
+}
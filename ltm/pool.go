@@ -2,10 +2,7 @@ package ltm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // PoolList is a list contains multiple Pool objects.
@@ -17,108 +14,59 @@ type PoolList struct {
 
 // Pool represents an F5 BIG-IP LTM Pool configuration.
 type Pool struct {
-	AllowNat              string   `json:"allowNat,omitempty" pretty:",expanded"`
-	AllowSnat             string   `json:"allowSnat,omitempty" pretty:",expanded"`
-	Description           string   `json:"description,omitempty"`
-	FullPath              string   `json:"fullPath,omitempty" pretty:",expanded"`
-	Generation            int64    `json:"generation,omitempty" pretty:",expanded"`
-	IgnorePersistedWeight string   `json:"ignorePersistedWeight,omitempty" pretty:",expanded"`
-	IPTosToClient         string   `json:"ipTosToClient,omitempty" pretty:",expanded"`
-	IPTosToServer         string   `json:"ipTosToServer,omitempty" pretty:",expanded"`
-	Kind                  string   `json:"kind,omitempty" pretty:",expanded"`
-	LinkQosToClient       string   `json:"linkQosToClient,omitempty" pretty:",expanded"`
-	LinkQosToServer       string   `json:"linkQosToServer,omitempty" pretty:",expanded"`
-	LoadBalancingMode     string   `json:"loadBalancingMode,omitempty"`
-	Members               []string `json:"members"`
+	AllowNat              string            `json:"allowNat,omitempty" pretty:",expanded"`
+	AllowSnat             string            `json:"allowSnat,omitempty" pretty:",expanded"`
+	Description           bigip.Opt[string] `json:"description,omitempty"`
+	FullPath              string            `json:"fullPath,omitempty" pretty:",expanded"`
+	Generation            int64             `json:"generation,omitempty" pretty:",expanded"`
+	IgnorePersistedWeight string            `json:"ignorePersistedWeight,omitempty" pretty:",expanded"`
+	IPTosToClient         string            `json:"ipTosToClient,omitempty" pretty:",expanded"`
+	IPTosToServer         string            `json:"ipTosToServer,omitempty" pretty:",expanded"`
+	Kind                  string            `json:"kind,omitempty" pretty:",expanded"`
+	LinkQosToClient       string            `json:"linkQosToClient,omitempty" pretty:",expanded"`
+	LinkQosToServer       string            `json:"linkQosToServer,omitempty" pretty:",expanded"`
+	LoadBalancingMode     string            `json:"loadBalancingMode,omitempty"`
+	Members               []string          `json:"members"`
 	MembersReference      struct {
 		IsSubcollection bool          `json:"isSubcollection,omitempty"`
 		Link            string        `json:"link,omitempty"`
 		Members         []PoolMembers `json:"items,omitempty"`
 	} `json:"membersReference,omitempty"`
-	MinActiveMembers       int64  `json:"minActiveMembers,omitempty"`
-	MinUpMembers           int64  `json:"minUpMembers,omitempty"`
-	MinUpMembersAction     string `json:"minUpMembersAction,omitempty"`
-	MinUpMembersChecking   string `json:"minUpMembersChecking,omitempty"`
-	Monitor                string `json:"monitor,omitempty"`
-	Name                   string `json:"name,omitempty"`
-	QueueDepthLimit        int64  `json:"queueDepthLimit,omitempty" pretty:",expanded"`
-	QueueOnConnectionLimit string `json:"queueOnConnectionLimit,omitempty" pretty:",expanded"`
-	QueueTimeLimit         int64  `json:"queueTimeLimit,omitempty" pretty:",expanded"`
-	ReselectTries          int64  `json:"reselectTries,omitempty"`
-	SelfLink               string `json:"selfLink,omitempty" pretty:",expanded"`
-	ServiceDownAction      string `json:"serviceDownAction,omitempty"`
-	SlowRampTime           int64  `json:"slowRampTime,omitempty" pretty:",expanded"`
-	Partition              string `json:"partition,omitempty"`
+	MinActiveMembers       bigip.Opt[int64] `json:"minActiveMembers,omitempty"`
+	MinUpMembers           bigip.Opt[int64] `json:"minUpMembers,omitempty"`
+	MinUpMembersAction     string           `json:"minUpMembersAction,omitempty"`
+	MinUpMembersChecking   string           `json:"minUpMembersChecking,omitempty"`
+	Monitor                string           `json:"monitor,omitempty"`
+	Name                   string           `json:"name,omitempty"`
+	QueueDepthLimit        bigip.Opt[int64] `json:"queueDepthLimit,omitempty" pretty:",expanded"`
+	QueueOnConnectionLimit string           `json:"queueOnConnectionLimit,omitempty" pretty:",expanded"`
+	QueueTimeLimit         bigip.Opt[int64] `json:"queueTimeLimit,omitempty" pretty:",expanded"`
+	ReselectTries          bigip.Opt[int64] `json:"reselectTries,omitempty"`
+	SelfLink               string           `json:"selfLink,omitempty" pretty:",expanded"`
+	ServiceDownAction      string           `json:"serviceDownAction,omitempty"`
+	SlowRampTime           bigip.Opt[int64] `json:"slowRampTime,omitempty" pretty:",expanded"`
+	Partition              string           `json:"partition,omitempty"`
+	Extra                  bigip.Extra      `json:"-"`
 }
 
-// PoolEndpoint represents the REST resource for managing a pool.
-const PoolEndpoint = "pool"
-
-// A PoolResource provides API to manage pool uration.
-type PoolResource struct {
-	b *bigip.BigIP
-}
-
-// lists all the pool instances.
-func (pr *PoolResource) List() (*PoolList, error) {
-	var pl PoolList
-	res, err := pr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &pl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &pl, nil
-}
-
-// List all the details of the pool, including: profile, policy, etc.
-func (vr *PoolResource) ListDetail() (*PoolList, error) {
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).SetParams("expandSubcollections", "true").DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var pl PoolList
-	if err := json.Unmarshal(res, &pl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &pl, nil
+// UnmarshalJSON keeps the attributes Pool does not model in Extra.
+func (p *Pool) UnmarshalJSON(data []byte) error {
+	type plain Pool
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
 }
 
-// ListVirtualServerName get all virtual server names
-func (vr *PoolResource) ListPoolName() ([]string, error) {
-	pl, err := vr.List()
-	if err != nil {
-		return nil, err
-	}
-	var items []string
-	for _, pool := range pl.Items {
-		fullPathName := pool.FullPath
-		items = append(items, fullPathName)
-	}
-	return items, nil
+// MarshalJSON adds the attributes in Extra to those of Pool.
+func (p Pool) MarshalJSON() ([]byte, error) {
+	type plain Pool
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
-// Get a single pool identified by name.
-func (pr *PoolResource) Get(fullPathName string) (*Pool, error) {
-	var pool Pool
-	res, err := pr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &pool); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &pool, nil
-}
+// PoolEndpoint represents the REST resource for managing a pool.
+const PoolEndpoint = "pool"
 
-// Create a new pool instance. If you just create a new pool, for example:
+// A PoolResource provides API to manage pool uration.
+//
+// Create creates a new pool instance. If you just create a new pool, for example:
 /*
 	item := Pool{
 		Name:              "pool-demo",
@@ -137,43 +85,41 @@ func (pr *PoolResource) Get(fullPathName string) (*Pool, error) {
         ......
 	}
 */
-func (pr *PoolResource) Create(item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = pr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type PoolResource struct {
+	bigip.Resource[Pool, PoolList]
 }
 
-// update a pool instance identified by name.
-func (pr *PoolResource) Update(fullPathName string, item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = pr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newPoolResource(b *bigip.BigIP) PoolResource {
+	return PoolResource{bigip.NewResource[Pool, PoolList](b, LtmManager, PoolEndpoint)}
 }
 
-// Delete a single pool instance identified by name.
-func (pr *PoolResource) Delete(name string) error {
-	_, err := pr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(name).DoRaw(context.Background())
+// List all the details of the pool, including: profile, policy, etc.
+func (vr *PoolResource) ListDetail() (*PoolList, error) {
+	return vr.ListDetailContext(context.Background())
+}
+
+// ListDetailContext is like ListDetail but uses ctx for the request.
+func (vr *PoolResource) ListDetailContext(ctx context.Context) (*PoolList, error) {
+	return vr.ListContext(ctx, bigip.ListOptions{ExpandSubcollections: true})
+}
+
+// ListVirtualServerName get all virtual server names
+func (vr *PoolResource) ListPoolName() ([]string, error) {
+	return vr.ListPoolNameContext(context.Background())
+}
+
+// ListPoolNameContext is like ListPoolName but uses ctx for the request.
+func (vr *PoolResource) ListPoolNameContext(ctx context.Context) ([]string, error) {
+	pl, err := vr.ListContext(ctx, bigip.ListOptions{Select: []string{"fullPath"}})
 	if err != nil {
-		return err
+		return nil, err
+	}
+	var items []string
+	for _, pool := range pl.Items {
+		fullPathName := pool.FullPath
+		items = append(items, fullPathName)
 	}
-	return nil
+	return items, nil
 }
 
 // // GetMembers gets all the members associated to the pool identified by id.
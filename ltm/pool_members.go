@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 	"strings"
 )
 
@@ -17,27 +18,41 @@ type PoolMembersList struct {
 
 // A PoolMembers represents the members of a pool.
 type PoolMembers struct {
-	Kind            string `json:"kind,omitempty"`
-	Name            string `json:"name,omitempty"`
-	Partition       string `json:"partition,omitempty"`
-	FullPath        string `json:"fullPath,omitempty"`
-	Generation      int64  `json:"generation,omitempty"`
-	SelfLink        string `json:"selfLink,omitempty"`
-	Address         string `json:"address,omitempty"`
-	ConnectionLimit int64  `json:"connectionLimit,omitempty"`
-	DynamicRatio    int64  `json:"dynamicRatio,omitempty"`
-	Ephemeral       string `json:"ephemeral,omitempty"`
+	Kind            string            `json:"kind,omitempty"`
+	Name            string            `json:"name,omitempty"`
+	Partition       string            `json:"partition,omitempty"`
+	FullPath        string            `json:"fullPath,omitempty"`
+	Generation      int64             `json:"generation,omitempty"`
+	SelfLink        string            `json:"selfLink,omitempty"`
+	Address         string            `json:"address,omitempty"`
+	ConnectionLimit bigip.Opt[int64]  `json:"connectionLimit,omitempty"`
+	Description     bigip.Opt[string] `json:"description,omitempty"`
+	DynamicRatio    bigip.Opt[int64]  `json:"dynamicRatio,omitempty"`
+	Ephemeral       string            `json:"ephemeral,omitempty"`
 	Fqdn            struct {
 		Autopopulate string `json:"autopopulate,omitempty"`
 	} `json:"fqdn,omitempty"`
-	InheritProfile string `json:"inheritProfile,omitempty"`
-	Logging        string `json:"logging,omitempty"`
-	Monitor        string `json:"monitor,omitempty"`
-	PriorityGroup  int64  `json:"priorityGroup,omitempty"`
-	RateLimit      string `json:"rateLimit,omitempty"`
-	Ratio          int64  `json:"ratio,omitempty"`
-	Session        string `json:"session,omitempty"`
-	State          string `json:"state,omitempty"`
+	InheritProfile string           `json:"inheritProfile,omitempty"`
+	Logging        string           `json:"logging,omitempty"`
+	Monitor        string           `json:"monitor,omitempty"`
+	PriorityGroup  bigip.Opt[int64] `json:"priorityGroup,omitempty"`
+	RateLimit      string           `json:"rateLimit,omitempty"`
+	Ratio          bigip.Opt[int64] `json:"ratio,omitempty"`
+	Session        string           `json:"session,omitempty"`
+	State          string           `json:"state,omitempty"`
+	Extra          bigip.Extra      `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes PoolMembers does not model in Extra.
+func (p *PoolMembers) UnmarshalJSON(data []byte) error {
+	type plain PoolMembers
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of PoolMembers.
+func (p PoolMembers) MarshalJSON() ([]byte, error) {
+	type plain PoolMembers
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
 // PoolMembersEndpoint represents the REST resource for managing pool members.
@@ -49,10 +64,16 @@ type PoolMembersResource struct {
 }
 
 // lists all the pool members.
-func (pmr *PoolMembersResource) List(pool string) (*PoolMembersList, error) {
+func (pmr *PoolMembersResource) List(pool string, opts ...bigip.ListOptions) (*PoolMembersList, error) {
+	return pmr.ListContext(context.Background(), pool, opts...)
+}
+
+// ListContext is like List but uses ctx for the request.
+func (pmr *PoolMembersResource) ListContext(ctx context.Context, pool string, opts ...bigip.ListOptions) (*PoolMembersList, error) {
 	var pml PoolMembersList
-	res, err := pmr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(pool).SubResource(poolMembersEndpoint).DoRaw(context.Background())
+	req := pmr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(PoolEndpoint).ResourceInstance(pool).SubResource(poolMembersEndpoint)
+	res, err := bigip.ApplyListOptions(req, opts...).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -63,11 +84,25 @@ func (pmr *PoolMembersResource) List(pool string) (*PoolMembersList, error) {
 	return &pml, nil
 }
 
+// Iterate returns an Iterator over the members of pool, which reads them page by
+// page. See bigip.NewIterator for the meaning of opts.
+func (pmr *PoolMembersResource) Iterate(ctx context.Context, pool string, opts ...bigip.ListOptions) *bigip.Iterator[PoolMembers] {
+	return bigip.NewIterator[PoolMembers](ctx, func() *rest.Request {
+		return pmr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+			Resource(PoolEndpoint).ResourceInstance(pool).SubResource(poolMembersEndpoint)
+	}, opts...)
+}
+
 // Get a single pool members identified by pool name and member name.
 func (pmr *PoolMembersResource) Get(poolName string, memberName string) (*PoolMembers, error) {
+	return pmr.GetContext(context.Background(), poolName, memberName)
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (pmr *PoolMembersResource) GetContext(ctx context.Context, poolName string, memberName string) (*PoolMembers, error) {
 	var pm PoolMembers
 	res, err := pmr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).DoRaw(context.Background())
+		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -80,13 +115,18 @@ func (pmr *PoolMembersResource) Get(poolName string, memberName string) (*PoolMe
 
 // Create a new pool members.
 func (pmr *PoolMembersResource) Create(pool string, item PoolMembers) error {
+	return pmr.CreateContext(context.Background(), pool, item)
+}
+
+// CreateContext is like Create but uses ctx for the request.
+func (pmr *PoolMembersResource) CreateContext(ctx context.Context, pool string, item PoolMembers) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = pmr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(pool).SubResource(poolMembersEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(PoolEndpoint).ResourceInstance(pool).SubResource(poolMembersEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -95,13 +135,38 @@ func (pmr *PoolMembersResource) Create(pool string, item PoolMembers) error {
 
 // Update a pool members indentified by pool name and member name.
 func (pmr *PoolMembersResource) Update(poolName string, memberName string, item PoolMembers) error {
+	return pmr.UpdateContext(context.Background(), poolName, memberName, item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (pmr *PoolMembersResource) UpdateContext(ctx context.Context, poolName string, memberName string, item PoolMembers) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = pmr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (pmr *PoolMembersResource) Patch(poolName string, memberName string, fields any, mask ...string) error {
+	return pmr.PatchContext(context.Background(), poolName, memberName, fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (pmr *PoolMembersResource) PatchContext(ctx context.Context, poolName string, memberName string, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = pmr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -110,8 +175,13 @@ func (pmr *PoolMembersResource) Update(poolName string, memberName string, item
 
 // Delete a single pool members identified by pool name and member name.
 func (pmr *PoolMembersResource) Delete(poolName string, memberName string) error {
+	return pmr.DeleteContext(context.Background(), poolName, memberName)
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (pmr *PoolMembersResource) DeleteContext(ctx context.Context, poolName string, memberName string) error {
 	_, err := pmr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).DoRaw(context.Background())
+		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
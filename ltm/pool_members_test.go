@@ -2,18 +2,20 @@ package ltm
 
 import (
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
 
 	"testing"
 )
 
 func TestPoolMembersResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	s := bigiptest.NewServer()
+	defer s.Close()
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
-	poolResource := PoolResource{
-		b: bigIP,
-	}
+	poolResource := newPoolResource(bigIP)
 
 	pool := Pool{
 		Name:              "test-pool-members",
@@ -53,7 +55,7 @@ func TestPoolMembersResource(t *testing.T) {
 	}
 
 	// Update properties of the Pool Member
-	poolMemberCheck.ConnectionLimit = 100
+	poolMemberCheck.ConnectionLimit = bigip.Some[int64](100)
 	err = poolMemberResource.Update("test-pool-members", poolMemberCheck.Name, *poolMemberCheck)
 	if err != nil {
 		t.Fatalf("Error updating Pool Member: %v", err)
@@ -64,7 +66,7 @@ func TestPoolMembersResource(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Error getting updated Pool Member: %v", err)
 	}
-	if updatedPoolMember.ConnectionLimit != 100 {
+	if updatedPoolMember.ConnectionLimit.Value() != 100 {
 		t.Error("Failed to update ConnectionLimit of Pool Member")
 	}
 
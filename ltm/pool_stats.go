@@ -124,9 +124,14 @@ type PoolStatsResource struct {
 // https://192.168.13.91/mgmt/tm/ltm/pool/stats?expandSubcollections=true
 
 func (psr *PoolStatsResource) List() (*PoolStatsList, error) {
+	return psr.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (psr *PoolStatsResource) ListContext(ctx context.Context) (*PoolStatsList, error) {
 	var psl PoolStatsList
 	res, err := psr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -139,9 +144,14 @@ func (psr *PoolStatsResource) List() (*PoolStatsList, error) {
 
 // Gets only the stats for the specified pool itself, not include members of the pool.
 func (psr *PoolStatsResource) GetPoolStats(pool string) (*PoolStatsList, error) {
+	return psr.GetPoolStatsContext(context.Background(), pool)
+}
+
+// GetPoolStatsContext is like GetPoolStats but uses ctx for the request.
+func (psr *PoolStatsResource) GetPoolStatsContext(ctx context.Context, pool string) (*PoolStatsList, error) {
 	var psl PoolStatsList
 	res, err := psr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(pool).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).ResourceInstance(pool).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2,17 +2,19 @@ package ltm
 
 import (
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
 	"testing"
 )
 
 func TestPoolResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	s := bigiptest.NewServer()
+	defer s.Close()
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
-	poolResource := PoolResource{
-		b: bigIP,
-	}
+	poolResource := newPoolResource(bigIP)
 
 	// Create a new Pool
 	pool := Pool{
@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type ClientLDAPList struct {
@@ -15,92 +11,37 @@ type ClientLDAPList struct {
 }
 
 type ClientLDAP struct {
-	Kind           string `json:"kind,omitempty"`
-	Name           string `json:"name,omitempty"`
-	Partition      string `json:"partition,omitempty"`
-	FullPath       string `json:"fullPath,omitempty"`
-	Generation     int    `json:"generation,omitempty"`
-	SelfLink       string `json:"selfLink,omitempty"`
-	ActivationMode string `json:"activationMode,omitempty"`
-	AppService     string `json:"appService,omitempty"`
-	DefaultsFrom   string `json:"defaultsFrom,omitempty"`
-	Description    string `json:"description,omitempty"`
+	Kind           string      `json:"kind,omitempty"`
+	Name           string      `json:"name,omitempty"`
+	Partition      string      `json:"partition,omitempty"`
+	FullPath       string      `json:"fullPath,omitempty"`
+	Generation     int         `json:"generation,omitempty"`
+	SelfLink       string      `json:"selfLink,omitempty"`
+	ActivationMode string      `json:"activationMode,omitempty"`
+	AppService     string      `json:"appService,omitempty"`
+	DefaultsFrom   string      `json:"defaultsFrom,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	Extra          bigip.Extra `json:"-"`
 }
 
-const ClientLDAPEndpoint = "client-ldap"
-
-type ClientLDAPResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of ClientLDAP resources.
-func (cr *ClientLDAPResource) List() (*ClientLDAPList, error) {
-	var items ClientLDAPList
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientLDAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes ClientLDAP does not model in Extra.
+func (c *ClientLDAP) UnmarshalJSON(data []byte) error {
+	type plain ClientLDAP
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// Get retrieves a ClientLDAP resource by its full path name.
-func (cr *ClientLDAPResource) Get(fullPathName string) (*ClientLDAP, error) {
-	var item ClientLDAP
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientLDAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new ClientLDAP resource using the provided ClientLDAP item.
-func (cr *ClientLDAPResource) Create(item ClientLDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientLDAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of ClientLDAP.
+func (c ClientLDAP) MarshalJSON() ([]byte, error) {
+	type plain ClientLDAP
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Update modifies a ClientLDAP resource identified by its full path name using the provided ClientLDAP item.
-func (cr *ClientLDAPResource) Update(fullPathName string, item ClientLDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const ClientLDAPEndpoint = "client-ldap"
 
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientLDAPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type ClientLDAPResource struct {
+	bigip.Resource[ClientLDAP, ClientLDAPList]
 }
 
-// Delete removes a ClientLDAP resource by its full path name.
-func (cr *ClientLDAPResource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientLDAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newClientLDAPResource(b *bigip.BigIP) ClientLDAPResource {
+	return ClientLDAPResource{bigip.NewResource[ClientLDAP, ClientLDAPList](b, LtmManager, ProfileEndpoint, ClientLDAPEndpoint)}
 }
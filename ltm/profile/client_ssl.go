@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type ClientSSLList struct {
@@ -123,74 +119,27 @@ type ClientSSL struct {
 		} `json:"keyReference,omitempty"`
 		Usage string `json:"usage,omitempty"`
 	} `json:"certKeyChain,omitempty"`
+	Extra bigip.Extra `json:"-"`
 }
 
-const ClientSSLEndpoint = "client-ssl"
-
-type ClientSSLResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes ClientSSL does not model in Extra.
+func (c *ClientSSL) UnmarshalJSON(data []byte) error {
+	type plain ClientSSL
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-func (mir *ClientSSLResource) List() (*ClientSSLList, error) {
-	var items ClientSSLList
-	res, err := mir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientSSLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of ClientSSL.
+func (c ClientSSL) MarshalJSON() ([]byte, error) {
+	type plain ClientSSL
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-func (mir *ClientSSLResource) Get(fullPathName string) (*ClientSSL, error) {
-	var item ClientSSL
-	res, err := mir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientSSLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-func (mir *ClientSSLResource) Create(item ClientSSL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mir.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientSSLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+const ClientSSLEndpoint = "client-ssl"
 
-func (mir *ClientSSLResource) Update(fullPathName string, item ClientSSL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mir.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientSSLEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type ClientSSLResource struct {
+	bigip.Resource[ClientSSL, ClientSSLList]
 }
 
-func (mir *ClientSSLResource) Delete(fullPathName string) error {
-	_, err := mir.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientSSLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newClientSSLResource(b *bigip.BigIP) ClientSSLResource {
+	return ClientSSLResource{bigip.NewResource[ClientSSL, ClientSSLList](b, LtmManager, ProfileEndpoint, ClientSSLEndpoint)}
 }
@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type ConnectorList struct {
@@ -15,85 +11,38 @@ type ConnectorList struct {
 }
 
 type Connector struct {
-	Kind               string `json:"kind,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Partition          string `json:"partition,omitempty"`
-	FullPath           string `json:"fullPath,omitempty"`
-	Generation         int    `json:"generation,omitempty"`
-	SelfLink           string `json:"selfLink,omitempty"`
-	AppService         string `json:"appService,omitempty"`
-	ConnectOnData      string `json:"connectOnData,omitempty"`
-	ConnectionTimeout  int    `json:"connectionTimeout,omitempty"`
-	EntryVirtualServer string `json:"entryVirtualServer,omitempty"`
-	ServiceDownAction  string `json:"serviceDownAction,omitempty"`
+	Kind               string      `json:"kind,omitempty"`
+	Name               string      `json:"name,omitempty"`
+	Partition          string      `json:"partition,omitempty"`
+	FullPath           string      `json:"fullPath,omitempty"`
+	Generation         int         `json:"generation,omitempty"`
+	SelfLink           string      `json:"selfLink,omitempty"`
+	AppService         string      `json:"appService,omitempty"`
+	ConnectOnData      string      `json:"connectOnData,omitempty"`
+	ConnectionTimeout  int         `json:"connectionTimeout,omitempty"`
+	EntryVirtualServer string      `json:"entryVirtualServer,omitempty"`
+	ServiceDownAction  string      `json:"serviceDownAction,omitempty"`
+	Extra              bigip.Extra `json:"-"`
 }
 
-const ConnectorEndpoint = "connector"
-
-type ConnectorResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes Connector does not model in Extra.
+func (c *Connector) UnmarshalJSON(data []byte) error {
+	type plain Connector
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-func (cr *ConnectorResource) List() (*ConnectorList, error) {
-	var items ConnectorList
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ConnectorEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of Connector.
+func (c Connector) MarshalJSON() ([]byte, error) {
+	type plain Connector
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-func (cr *ConnectorResource) Get(fullPathName string) (*Connector, error) {
-	var item Connector
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ConnectorEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-func (cr *ConnectorResource) Create(item Connector) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ConnectorEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+const ConnectorEndpoint = "connector"
 
-func (cr *ConnectorResource) Update(fullPathName string, item Connector) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ConnectorEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type ConnectorResource struct {
+	bigip.Resource[Connector, ConnectorList]
 }
 
-func (cr *ConnectorResource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ConnectorEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newConnectorResource(b *bigip.BigIP) ConnectorResource {
+	return ConnectorResource{bigip.NewResource[Connector, ConnectorList](b, LtmManager, ProfileEndpoint, ConnectorEndpoint)}
 }
@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type DNSList struct {
@@ -47,79 +43,32 @@ type DNS struct {
 	LogProfileReference           struct {
 		Link string `json:"link,omitempty"`
 	} `json:"logProfileReference,omitempty"`
-	ProcessRd               string `json:"processRd,omitempty"`
-	ProcessXfr              string `json:"processXfr,omitempty"`
-	RapidResponseLastAction string `json:"rapidResponseLastAction,omitempty"`
-	UnhandledQueryAction    string `json:"unhandledQueryAction,omitempty"`
-	UseLocalBind            string `json:"useLocalBind,omitempty"`
+	ProcessRd               string      `json:"processRd,omitempty"`
+	ProcessXfr              string      `json:"processXfr,omitempty"`
+	RapidResponseLastAction string      `json:"rapidResponseLastAction,omitempty"`
+	UnhandledQueryAction    string      `json:"unhandledQueryAction,omitempty"`
+	UseLocalBind            string      `json:"useLocalBind,omitempty"`
+	Extra                   bigip.Extra `json:"-"`
 }
 
-const DNSEndpoint = "dns"
-
-type DNSResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes DNS does not model in Extra.
+func (d *DNS) UnmarshalJSON(data []byte) error {
+	type plain DNS
+	return bigip.UnmarshalExtra(data, (*plain)(d), &d.Extra)
 }
 
-func (cr *DNSResource) List() (*DNSList, error) {
-	var items DNSList
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DNSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of DNS.
+func (d DNS) MarshalJSON() ([]byte, error) {
+	type plain DNS
+	return bigip.MarshalExtra(plain(d), d.Extra)
 }
 
-func (cr *DNSResource) Get(fullPathName string) (*DNS, error) {
-	var item DNS
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DNSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-func (cr *DNSResource) Create(item DNS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DNSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+const DNSEndpoint = "dns"
 
-func (cr *DNSResource) Update(fullPathName string, item DNS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DNSEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type DNSResource struct {
+	bigip.Resource[DNS, DNSList]
 }
 
-func (cr *DNSResource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DNSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newDNSResource(b *bigip.BigIP) DNSResource {
+	return DNSResource{bigip.NewResource[DNS, DNSList](b, LtmManager, ProfileEndpoint, DNSEndpoint)}
 }
@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type FastL4List struct {
@@ -27,128 +23,81 @@ type FastL4 struct {
 	DefaultsFromReference struct {
 		Link string `json:"link,omitempty"`
 	} `json:"defaultsFromReference,omitempty"`
-	Description                 string `json:"description,omitempty"`
-	ExplicitFlowMigration       string `json:"explicitFlowMigration,omitempty"`
-	HardwareSynCookie           string `json:"hardwareSynCookie,omitempty"`
-	IdleTimeout                 string `json:"idleTimeout,omitempty"`
-	IPDfMode                    string `json:"ipDfMode,omitempty"`
-	IPTosToClient               string `json:"ipTosToClient,omitempty"`
-	IPTosToServer               string `json:"ipTosToServer,omitempty"`
-	IPTTLMode                   string `json:"ipTtlMode,omitempty"`
-	IPTTLV4                     int    `json:"ipTtlV4,omitempty"`
-	IPTTLV6                     int    `json:"ipTtlV6,omitempty"`
-	KeepAliveInterval           string `json:"keepAliveInterval,omitempty"`
-	LateBinding                 string `json:"lateBinding,omitempty"`
-	LinkQosToClient             string `json:"linkQosToClient,omitempty"`
-	LinkQosToServer             string `json:"linkQosToServer,omitempty"`
-	LooseClose                  string `json:"looseClose,omitempty"`
-	LooseInitialization         string `json:"looseInitialization,omitempty"`
-	MssOverride                 int    `json:"mssOverride,omitempty"`
-	OtherPvaClientpktsThreshold int    `json:"otherPvaClientpktsThreshold,omitempty"`
-	OtherPvaOffloadDirection    string `json:"otherPvaOffloadDirection,omitempty"`
-	OtherPvaServerpktsThreshold int    `json:"otherPvaServerpktsThreshold,omitempty"`
-	OtherPvaWhentoOffload       string `json:"otherPvaWhentoOffload,omitempty"`
-	PriorityToClient            string `json:"priorityToClient,omitempty"`
-	PriorityToServer            string `json:"priorityToServer,omitempty"`
-	PvaAcceleration             string `json:"pvaAcceleration,omitempty"`
-	PvaDynamicClientPackets     int    `json:"pvaDynamicClientPackets,omitempty"`
-	PvaDynamicServerPackets     int    `json:"pvaDynamicServerPackets,omitempty"`
-	PvaFlowAging                string `json:"pvaFlowAging,omitempty"`
-	PvaFlowEvict                string `json:"pvaFlowEvict,omitempty"`
-	PvaOffloadDynamic           string `json:"pvaOffloadDynamic,omitempty"`
-	PvaOffloadDynamicPriority   string `json:"pvaOffloadDynamicPriority,omitempty"`
-	PvaOffloadInitialPriority   string `json:"pvaOffloadInitialPriority,omitempty"`
-	PvaOffloadState             string `json:"pvaOffloadState,omitempty"`
-	ReassembleFragments         string `json:"reassembleFragments,omitempty"`
-	ReceiveWindowSize           int    `json:"receiveWindowSize,omitempty"`
-	ResetOnTimeout              string `json:"resetOnTimeout,omitempty"`
-	RttFromClient               string `json:"rttFromClient,omitempty"`
-	RttFromServer               string `json:"rttFromServer,omitempty"`
-	ServerSack                  string `json:"serverSack,omitempty"`
-	ServerTimestamp             string `json:"serverTimestamp,omitempty"`
-	SoftwareSynCookie           string `json:"softwareSynCookie,omitempty"`
-	SynCookieDsrFlowResetBy     string `json:"synCookieDsrFlowResetBy,omitempty"`
-	SynCookieEnable             string `json:"synCookieEnable,omitempty"`
-	SynCookieMss                int    `json:"synCookieMss,omitempty"`
-	SynCookieWhitelist          string `json:"synCookieWhitelist,omitempty"`
-	TCPCloseTimeout             string `json:"tcpCloseTimeout,omitempty"`
-	TCPGenerateIsn              string `json:"tcpGenerateIsn,omitempty"`
-	TCPHandshakeTimeout         string `json:"tcpHandshakeTimeout,omitempty"`
-	TCPPvaOffloadDirection      string `json:"tcpPvaOffloadDirection,omitempty"`
-	TCPPvaWhentoOffload         string `json:"tcpPvaWhentoOffload,omitempty"`
-	TCPStripSack                string `json:"tcpStripSack,omitempty"`
-	TCPTimeWaitTimeout          int    `json:"tcpTimeWaitTimeout,omitempty"`
-	TCPTimestampMode            string `json:"tcpTimestampMode,omitempty"`
-	TCPWscaleMode               string `json:"tcpWscaleMode,omitempty"`
-	TimeoutRecovery             string `json:"timeoutRecovery,omitempty"`
+	Description                 string      `json:"description,omitempty"`
+	ExplicitFlowMigration       string      `json:"explicitFlowMigration,omitempty"`
+	HardwareSynCookie           string      `json:"hardwareSynCookie,omitempty"`
+	IdleTimeout                 string      `json:"idleTimeout,omitempty"`
+	IPDfMode                    string      `json:"ipDfMode,omitempty"`
+	IPTosToClient               string      `json:"ipTosToClient,omitempty"`
+	IPTosToServer               string      `json:"ipTosToServer,omitempty"`
+	IPTTLMode                   string      `json:"ipTtlMode,omitempty"`
+	IPTTLV4                     int         `json:"ipTtlV4,omitempty"`
+	IPTTLV6                     int         `json:"ipTtlV6,omitempty"`
+	KeepAliveInterval           string      `json:"keepAliveInterval,omitempty"`
+	LateBinding                 string      `json:"lateBinding,omitempty"`
+	LinkQosToClient             string      `json:"linkQosToClient,omitempty"`
+	LinkQosToServer             string      `json:"linkQosToServer,omitempty"`
+	LooseClose                  string      `json:"looseClose,omitempty"`
+	LooseInitialization         string      `json:"looseInitialization,omitempty"`
+	MssOverride                 int         `json:"mssOverride,omitempty"`
+	OtherPvaClientpktsThreshold int         `json:"otherPvaClientpktsThreshold,omitempty"`
+	OtherPvaOffloadDirection    string      `json:"otherPvaOffloadDirection,omitempty"`
+	OtherPvaServerpktsThreshold int         `json:"otherPvaServerpktsThreshold,omitempty"`
+	OtherPvaWhentoOffload       string      `json:"otherPvaWhentoOffload,omitempty"`
+	PriorityToClient            string      `json:"priorityToClient,omitempty"`
+	PriorityToServer            string      `json:"priorityToServer,omitempty"`
+	PvaAcceleration             string      `json:"pvaAcceleration,omitempty"`
+	PvaDynamicClientPackets     int         `json:"pvaDynamicClientPackets,omitempty"`
+	PvaDynamicServerPackets     int         `json:"pvaDynamicServerPackets,omitempty"`
+	PvaFlowAging                string      `json:"pvaFlowAging,omitempty"`
+	PvaFlowEvict                string      `json:"pvaFlowEvict,omitempty"`
+	PvaOffloadDynamic           string      `json:"pvaOffloadDynamic,omitempty"`
+	PvaOffloadDynamicPriority   string      `json:"pvaOffloadDynamicPriority,omitempty"`
+	PvaOffloadInitialPriority   string      `json:"pvaOffloadInitialPriority,omitempty"`
+	PvaOffloadState             string      `json:"pvaOffloadState,omitempty"`
+	ReassembleFragments         string      `json:"reassembleFragments,omitempty"`
+	ReceiveWindowSize           int         `json:"receiveWindowSize,omitempty"`
+	ResetOnTimeout              string      `json:"resetOnTimeout,omitempty"`
+	RttFromClient               string      `json:"rttFromClient,omitempty"`
+	RttFromServer               string      `json:"rttFromServer,omitempty"`
+	ServerSack                  string      `json:"serverSack,omitempty"`
+	ServerTimestamp             string      `json:"serverTimestamp,omitempty"`
+	SoftwareSynCookie           string      `json:"softwareSynCookie,omitempty"`
+	SynCookieDsrFlowResetBy     string      `json:"synCookieDsrFlowResetBy,omitempty"`
+	SynCookieEnable             string      `json:"synCookieEnable,omitempty"`
+	SynCookieMss                int         `json:"synCookieMss,omitempty"`
+	SynCookieWhitelist          string      `json:"synCookieWhitelist,omitempty"`
+	TCPCloseTimeout             string      `json:"tcpCloseTimeout,omitempty"`
+	TCPGenerateIsn              string      `json:"tcpGenerateIsn,omitempty"`
+	TCPHandshakeTimeout         string      `json:"tcpHandshakeTimeout,omitempty"`
+	TCPPvaOffloadDirection      string      `json:"tcpPvaOffloadDirection,omitempty"`
+	TCPPvaWhentoOffload         string      `json:"tcpPvaWhentoOffload,omitempty"`
+	TCPStripSack                string      `json:"tcpStripSack,omitempty"`
+	TCPTimeWaitTimeout          int         `json:"tcpTimeWaitTimeout,omitempty"`
+	TCPTimestampMode            string      `json:"tcpTimestampMode,omitempty"`
+	TCPWscaleMode               string      `json:"tcpWscaleMode,omitempty"`
+	TimeoutRecovery             string      `json:"timeoutRecovery,omitempty"`
+	Extra                       bigip.Extra `json:"-"`
 }
 
-const FastL4Endpoint = "fastl4"
-
-type FastL4Resource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes FastL4 does not model in Extra.
+func (f *FastL4) UnmarshalJSON(data []byte) error {
+	type plain FastL4
+	return bigip.UnmarshalExtra(data, (*plain)(f), &f.Extra)
 }
 
-func (cr *FastL4Resource) List() (*FastL4List, error) {
-	var items FastL4List
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastL4Endpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of FastL4.
+func (f FastL4) MarshalJSON() ([]byte, error) {
+	type plain FastL4
+	return bigip.MarshalExtra(plain(f), f.Extra)
 }
 
-func (cr *FastL4Resource) Get(fullPathName string) (*FastL4, error) {
-	var item FastL4
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastL4Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-func (cr *FastL4Resource) Create(item FastL4) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastL4Endpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+const FastL4Endpoint = "fastl4"
 
-func (cr *FastL4Resource) Update(fullPathName string, item FastL4) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastL4Endpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type FastL4Resource struct {
+	bigip.Resource[FastL4, FastL4List]
 }
 
-func (cr *FastL4Resource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastL4Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newFastL4Resource(b *bigip.BigIP) FastL4Resource {
+	return FastL4Resource{bigip.NewResource[FastL4, FastL4List](b, LtmManager, ProfileEndpoint, FastL4Endpoint)}
 }
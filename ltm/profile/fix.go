@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type FIXList struct {
@@ -32,74 +28,27 @@ type FIX struct {
 	ResponseParsing          string        `json:"responseParsing,omitempty"`
 	SenderTagClass           []interface{} `json:"senderTagClass,omitempty"`
 	StatisticsSampleInterval int           `json:"statisticsSampleInterval,omitempty"`
+	Extra                    bigip.Extra   `json:"-"`
 }
 
-const FIXEndpoint = "fix"
-
-type FIXResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes FIX does not model in Extra.
+func (f *FIX) UnmarshalJSON(data []byte) error {
+	type plain FIX
+	return bigip.UnmarshalExtra(data, (*plain)(f), &f.Extra)
 }
 
-func (cr *FIXResource) List() (*FIXList, error) {
-	var items FIXList
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FIXEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of FIX.
+func (f FIX) MarshalJSON() ([]byte, error) {
+	type plain FIX
+	return bigip.MarshalExtra(plain(f), f.Extra)
 }
 
-func (cr *FIXResource) Get(fullPathName string) (*FIX, error) {
-	var item FIX
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FIXEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-func (cr *FIXResource) Create(item FIX) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FIXEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+const FIXEndpoint = "fix"
 
-func (cr *FIXResource) Update(fullPathName string, item FIX) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FIXEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type FIXResource struct {
+	bigip.Resource[FIX, FIXList]
 }
 
-func (cr *FIXResource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FIXEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newFIXResource(b *bigip.BigIP) FIXResource {
+	return FIXResource{bigip.NewResource[FIX, FIXList](b, LtmManager, ProfileEndpoint, FIXEndpoint)}
 }
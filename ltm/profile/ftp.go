@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type FTPList struct {
@@ -15,94 +11,47 @@ type FTPList struct {
 }
 
 type FTP struct {
-	Kind                   string `json:"kind,omitempty"`
-	Name                   string `json:"name,omitempty"`
-	Partition              string `json:"partition,omitempty"`
-	FullPath               string `json:"fullPath,omitempty"`
-	Generation             int    `json:"generation,omitempty"`
-	SelfLink               string `json:"selfLink,omitempty"`
-	AllowActiveMode        string `json:"allowActiveMode,omitempty"`
-	AllowFtps              string `json:"allowFtps,omitempty"`
-	AppService             string `json:"appService,omitempty"`
-	DefaultsFrom           string `json:"defaultsFrom,omitempty"`
-	Description            string `json:"description,omitempty"`
-	EnforceTLSSessionReuse string `json:"enforceTlsSessionReuse,omitempty"`
-	FtpsMode               string `json:"ftpsMode,omitempty"`
-	InheritParentProfile   string `json:"inheritParentProfile,omitempty"`
-	InheritVlanList        string `json:"inheritVlanList,omitempty"`
-	LogProfile             string `json:"logProfile,omitempty"`
-	LogPublisher           string `json:"logPublisher,omitempty"`
-	Port                   int    `json:"port,omitempty"`
-	Security               string `json:"security,omitempty"`
-	TranslateExtended      string `json:"translateExtended,omitempty"`
+	Kind                   string      `json:"kind,omitempty"`
+	Name                   string      `json:"name,omitempty"`
+	Partition              string      `json:"partition,omitempty"`
+	FullPath               string      `json:"fullPath,omitempty"`
+	Generation             int         `json:"generation,omitempty"`
+	SelfLink               string      `json:"selfLink,omitempty"`
+	AllowActiveMode        string      `json:"allowActiveMode,omitempty"`
+	AllowFtps              string      `json:"allowFtps,omitempty"`
+	AppService             string      `json:"appService,omitempty"`
+	DefaultsFrom           string      `json:"defaultsFrom,omitempty"`
+	Description            string      `json:"description,omitempty"`
+	EnforceTLSSessionReuse string      `json:"enforceTlsSessionReuse,omitempty"`
+	FtpsMode               string      `json:"ftpsMode,omitempty"`
+	InheritParentProfile   string      `json:"inheritParentProfile,omitempty"`
+	InheritVlanList        string      `json:"inheritVlanList,omitempty"`
+	LogProfile             string      `json:"logProfile,omitempty"`
+	LogPublisher           string      `json:"logPublisher,omitempty"`
+	Port                   int         `json:"port,omitempty"`
+	Security               string      `json:"security,omitempty"`
+	TranslateExtended      string      `json:"translateExtended,omitempty"`
+	Extra                  bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes FTP does not model in Extra.
+func (f *FTP) UnmarshalJSON(data []byte) error {
+	type plain FTP
+	return bigip.UnmarshalExtra(data, (*plain)(f), &f.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of FTP.
+func (f FTP) MarshalJSON() ([]byte, error) {
+	type plain FTP
+	return bigip.MarshalExtra(plain(f), f.Extra)
 }
 
 const FTPEndpoint = "ftp"
 
 type FTPResource struct {
-	b *bigip.BigIP
+	bigip.Resource[FTP, FTPList]
 }
 
-func (cr *FTPResource) List() (*FTPList, error) {
-	var items FTPList
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
-}
-
-func (cr *FTPResource) Get(fullPathName string) (*FTP, error) {
-	var item FTP
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-func (cr *FTPResource) Create(item FTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (cr *FTPResource) Update(fullPathName string, item FTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FTPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (cr *FTPResource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newFTPResource(b *bigip.BigIP) FTPResource {
+	return FTPResource{bigip.NewResource[FTP, FTPList](b, LtmManager, ProfileEndpoint, FTPEndpoint)}
 }
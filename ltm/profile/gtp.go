@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type GTPList struct {
@@ -15,101 +11,37 @@ type GTPList struct {
 }
 
 type GTP struct {
-	Kind         string `json:"kind,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	AppService   string `json:"appService,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	IngressMax   int    `json:"ingressMax,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	IngressMax   int         `json:"ingressMax,omitempty"`
+	Extra        bigip.Extra `json:"-"`
 }
 
-const GTPEndpoint = "gtp"
-
-type GTPResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of GTP resources.
-func (cr *GTPResource) List() (*GTPList, error) {
-	var items GTPList
-	// Perform a GET request to retrieve a list of GTP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(GTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into GTPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes GTP does not model in Extra.
+func (g *GTP) UnmarshalJSON(data []byte) error {
+	type plain GTP
+	return bigip.UnmarshalExtra(data, (*plain)(g), &g.Extra)
 }
 
-// Get retrieves a GTP resource by its full path name.
-func (cr *GTPResource) Get(fullPathName string) (*GTP, error) {
-	var item GTP
-	// Perform a GET request to retrieve a specific GTP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(GTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into GTP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new GTP resource using the provided GTP item.
-func (cr *GTPResource) Create(item GTP) error {
-	// Marshal the GTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new GTP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(GTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of GTP.
+func (g GTP) MarshalJSON() ([]byte, error) {
+	type plain GTP
+	return bigip.MarshalExtra(plain(g), g.Extra)
 }
 
-// Update modifies a GTP resource identified by its full path name using the provided GTP item.
-func (cr *GTPResource) Update(fullPathName string, item GTP) error {
-	// Marshal the GTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const GTPEndpoint = "gtp"
 
-	// Perform a PUT request to update the specified GTP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(GTPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type GTPResource struct {
+	bigip.Resource[GTP, GTPList]
 }
 
-// Delete removes a GTP resource by its full path name.
-func (cr *GTPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified GTP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(GTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newGTPResource(b *bigip.BigIP) GTPResource {
+	return GTPResource{bigip.NewResource[GTP, GTPList](b, LtmManager, ProfileEndpoint, GTPEndpoint)}
 }
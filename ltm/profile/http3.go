@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type HTTP3List struct {
@@ -15,101 +11,37 @@ type HTTP3List struct {
 }
 
 type HTTP3 struct {
-	Kind            string `json:"kind,omitempty"`
-	Name            string `json:"name,omitempty"`
-	Partition       string `json:"partition,omitempty"`
-	FullPath        string `json:"fullPath,omitempty"`
-	Generation      int    `json:"generation,omitempty"`
-	SelfLink        string `json:"selfLink,omitempty"`
-	AppService      string `json:"appService,omitempty"`
-	DefaultsFrom    string `json:"defaultsFrom,omitempty"`
-	Description     string `json:"description,omitempty"`
-	HeaderTableSize int    `json:"headerTableSize,omitempty"`
+	Kind            string      `json:"kind,omitempty"`
+	Name            string      `json:"name,omitempty"`
+	Partition       string      `json:"partition,omitempty"`
+	FullPath        string      `json:"fullPath,omitempty"`
+	Generation      int         `json:"generation,omitempty"`
+	SelfLink        string      `json:"selfLink,omitempty"`
+	AppService      string      `json:"appService,omitempty"`
+	DefaultsFrom    string      `json:"defaultsFrom,omitempty"`
+	Description     string      `json:"description,omitempty"`
+	HeaderTableSize int         `json:"headerTableSize,omitempty"`
+	Extra           bigip.Extra `json:"-"`
 }
 
-const HTTP3Endpoint = "http3"
-
-type HTTP3Resource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of HTTP3 resources.
-func (cr *HTTP3Resource) List() (*HTTP3List, error) {
-	var items HTTP3List
-	// Perform a GET request to retrieve a list of HTTP3 resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP3Endpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTP3List struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes HTTP3 does not model in Extra.
+func (h *HTTP3) UnmarshalJSON(data []byte) error {
+	type plain HTTP3
+	return bigip.UnmarshalExtra(data, (*plain)(h), &h.Extra)
 }
 
-// Get retrieves an HTTP3 resource by its full path name.
-func (cr *HTTP3Resource) Get(fullPathName string) (*HTTP3, error) {
-	var item HTTP3
-	// Perform a GET request to retrieve a specific HTTP3 resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTP3 struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new HTTP3 resource using the provided HTTP3 item.
-func (cr *HTTP3Resource) Create(item HTTP3) error {
-	// Marshal the HTTP3 struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new HTTP3 resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP3Endpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of HTTP3.
+func (h HTTP3) MarshalJSON() ([]byte, error) {
+	type plain HTTP3
+	return bigip.MarshalExtra(plain(h), h.Extra)
 }
 
-// Update modifies an HTTP3 resource identified by its full path name using the provided HTTP3 item.
-func (cr *HTTP3Resource) Update(fullPathName string, item HTTP3) error {
-	// Marshal the HTTP3 struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const HTTP3Endpoint = "http3"
 
-	// Perform a PUT request to update the specified HTTP3 resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP3Endpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type HTTP3Resource struct {
+	bigip.Resource[HTTP3, HTTP3List]
 }
 
-// Delete removes an HTTP3 resource by its full path name.
-func (cr *HTTP3Resource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified HTTP3 resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newHTTP3Resource(b *bigip.BigIP) HTTP3Resource {
+	return HTTP3Resource{bigip.NewResource[HTTP3, HTTP3List](b, LtmManager, ProfileEndpoint, HTTP3Endpoint)}
 }
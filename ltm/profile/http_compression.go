@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type HTTPCompressionList struct {
@@ -45,91 +41,27 @@ type HTTPCompression struct {
 	URIExclude         []interface{} `json:"uriExclude,omitempty"`
 	URIInclude         []string      `json:"uriInclude,omitempty"`
 	VaryHeader         string        `json:"varyHeader,omitempty"`
+	Extra              bigip.Extra   `json:"-"`
 }
 
-const HTTPCompressionEndpoint = "http-compression"
-
-type HTTPCompressionResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of HTTPCompression resources.
-func (cr *HTTPCompressionResource) List() (*HTTPCompressionList, error) {
-	var items HTTPCompressionList
-	// Perform a GET request to retrieve a list of HTTPCompression resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPCompressionEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTPCompressionList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes HTTPCompression does not model in Extra.
+func (h *HTTPCompression) UnmarshalJSON(data []byte) error {
+	type plain HTTPCompression
+	return bigip.UnmarshalExtra(data, (*plain)(h), &h.Extra)
 }
 
-// Get retrieves an HTTPCompression resource by its full path name.
-func (cr *HTTPCompressionResource) Get(fullPathName string) (*HTTPCompression, error) {
-	var item HTTPCompression
-	// Perform a GET request to retrieve a specific HTTPCompression resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPCompressionEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTPCompression struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new HTTPCompression resource using the provided HTTPCompression item.
-func (cr *HTTPCompressionResource) Create(item HTTPCompression) error {
-	// Marshal the HTTPCompression struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new HTTPCompression resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPCompressionEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of HTTPCompression.
+func (h HTTPCompression) MarshalJSON() ([]byte, error) {
+	type plain HTTPCompression
+	return bigip.MarshalExtra(plain(h), h.Extra)
 }
 
-// Update modifies an HTTPCompression resource identified by its full path name using the provided HTTPCompression item.
-func (cr *HTTPCompressionResource) Update(fullPathName string, item HTTPCompression) error {
-	// Marshal the HTTPCompression struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const HTTPCompressionEndpoint = "http-compression"
 
-	// Perform a PUT request to update the specified HTTPCompression resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPCompressionEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type HTTPCompressionResource struct {
+	bigip.Resource[HTTPCompression, HTTPCompressionList]
 }
 
-// Delete removes an HTTPCompression resource by its full path name.
-func (cr *HTTPCompressionResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified HTTPCompression resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPCompressionEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newHTTPCompressionResource(b *bigip.BigIP) HTTPCompressionResource {
+	return HTTPCompressionResource{bigip.NewResource[HTTPCompression, HTTPCompressionList](b, LtmManager, ProfileEndpoint, HTTPCompressionEndpoint)}
 }
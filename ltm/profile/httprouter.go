@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type HTTPRouterList struct {
@@ -15,100 +11,36 @@ type HTTPRouterList struct {
 }
 
 type HTTPRouter struct {
-	Kind         string `json:"kind,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	AppService   string `json:"appService,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Extra        bigip.Extra `json:"-"`
 }
 
-const HTTPRouterEndpoint = "httprouter"
-
-type HTTPRouterResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of HTTPRouter resources.
-func (cr *HTTPRouterResource) List() (*HTTPRouterList, error) {
-	var items HTTPRouterList
-	// Perform a GET request to retrieve a list of HTTPRouter resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPRouterEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTPRouterList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes HTTPRouter does not model in Extra.
+func (h *HTTPRouter) UnmarshalJSON(data []byte) error {
+	type plain HTTPRouter
+	return bigip.UnmarshalExtra(data, (*plain)(h), &h.Extra)
 }
 
-// Get retrieves an HTTPRouter resource by its full path name.
-func (cr *HTTPRouterResource) Get(fullPathName string) (*HTTPRouter, error) {
-	var item HTTPRouter
-	// Perform a GET request to retrieve a specific HTTPRouter resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPRouterEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTPRouter struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new HTTPRouter resource using the provided HTTPRouter item.
-func (cr *HTTPRouterResource) Create(item HTTPRouter) error {
-	// Marshal the HTTPRouter struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new HTTPRouter resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPRouterEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of HTTPRouter.
+func (h HTTPRouter) MarshalJSON() ([]byte, error) {
+	type plain HTTPRouter
+	return bigip.MarshalExtra(plain(h), h.Extra)
 }
 
-// Update modifies an HTTPRouter resource identified by its full path name using the provided HTTPRouter item.
-func (cr *HTTPRouterResource) Update(fullPathName string, item HTTPRouter) error {
-	// Marshal the HTTPRouter struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const HTTPRouterEndpoint = "httprouter"
 
-	// Perform a PUT request to update the specified HTTPRouter resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPRouterEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type HTTPRouterResource struct {
+	bigip.Resource[HTTPRouter, HTTPRouterList]
 }
 
-// Delete removes an HTTPRouter resource by its full path name.
-func (cr *HTTPRouterResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified HTTPRouter resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPRouterEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newHTTPRouterResource(b *bigip.BigIP) HTTPRouterResource {
+	return HTTPRouterResource{bigip.NewResource[HTTPRouter, HTTPRouterList](b, LtmManager, ProfileEndpoint, HTTPRouterEndpoint)}
 }
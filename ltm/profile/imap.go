@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type IMAPList struct {
@@ -15,101 +11,37 @@ type IMAPList struct {
 }
 
 type IMAP struct {
-	Kind           string `json:"kind,omitempty"`
-	Name           string `json:"name,omitempty"`
-	Partition      string `json:"partition,omitempty"`
-	FullPath       string `json:"fullPath,omitempty"`
-	Generation     int    `json:"generation,omitempty"`
-	SelfLink       string `json:"selfLink,omitempty"`
-	ActivationMode string `json:"activationMode,omitempty"`
-	AppService     string `json:"appService,omitempty"`
-	DefaultsFrom   string `json:"defaultsFrom,omitempty"`
-	Description    string `json:"description,omitempty"`
+	Kind           string      `json:"kind,omitempty"`
+	Name           string      `json:"name,omitempty"`
+	Partition      string      `json:"partition,omitempty"`
+	FullPath       string      `json:"fullPath,omitempty"`
+	Generation     int         `json:"generation,omitempty"`
+	SelfLink       string      `json:"selfLink,omitempty"`
+	ActivationMode string      `json:"activationMode,omitempty"`
+	AppService     string      `json:"appService,omitempty"`
+	DefaultsFrom   string      `json:"defaultsFrom,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	Extra          bigip.Extra `json:"-"`
 }
 
-const IMAPEndpoint = "imap"
-
-type IMAPResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of IMAP resources.
-func (cr *IMAPResource) List() (*IMAPList, error) {
-	var items IMAPList
-	// Perform a GET request to retrieve a list of IMAP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(IMAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into IMAPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes IMAP does not model in Extra.
+func (i *IMAP) UnmarshalJSON(data []byte) error {
+	type plain IMAP
+	return bigip.UnmarshalExtra(data, (*plain)(i), &i.Extra)
 }
 
-// Get retrieves an IMAP resource by its full path name.
-func (cr *IMAPResource) Get(fullPathName string) (*IMAP, error) {
-	var item IMAP
-	// Perform a GET request to retrieve a specific IMAP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into IMAP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new IMAP resource using the provided IMAP item.
-func (cr *IMAPResource) Create(item IMAP) error {
-	// Marshal the IMAP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new IMAP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(IMAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of IMAP.
+func (i IMAP) MarshalJSON() ([]byte, error) {
+	type plain IMAP
+	return bigip.MarshalExtra(plain(i), i.Extra)
 }
 
-// Update modifies an IMAP resource identified by its full path name using the provided IMAP item.
-func (cr *IMAPResource) Update(fullPathName string, item IMAP) error {
-	// Marshal the IMAP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const IMAPEndpoint = "imap"
 
-	// Perform a PUT request to update the specified IMAP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type IMAPResource struct {
+	bigip.Resource[IMAP, IMAPList]
 }
 
-// Delete removes an IMAP resource by its full path name.
-func (cr *IMAPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified IMAP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newIMAPResource(b *bigip.BigIP) IMAPResource {
+	return IMAPResource{bigip.NewResource[IMAP, IMAPList](b, LtmManager, ProfileEndpoint, IMAPEndpoint)}
 }
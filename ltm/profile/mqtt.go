@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type MQTTList struct {
@@ -15,100 +11,36 @@ type MQTTList struct {
 }
 
 type MQTT struct {
-	Kind         string `json:"kind,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	AppService   string `json:"appService,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Extra        bigip.Extra `json:"-"`
 }
 
-const MQTTEndpoint = "mqtt"
-
-type MQTTResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of MQTT resources.
-func (cr *MQTTResource) List() (*MQTTList, error) {
-	var items MQTTList
-	// Perform a GET request to retrieve a list of MQTT resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(MQTTEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into MQTTList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes MQTT does not model in Extra.
+func (m *MQTT) UnmarshalJSON(data []byte) error {
+	type plain MQTT
+	return bigip.UnmarshalExtra(data, (*plain)(m), &m.Extra)
 }
 
-// Get retrieves an MQTT resource by its full path name.
-func (cr *MQTTResource) Get(fullPathName string) (*MQTT, error) {
-	var item MQTT
-	// Perform a GET request to retrieve a specific MQTT resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(MQTTEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into MQTT struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new MQTT resource using the provided MQTT item.
-func (cr *MQTTResource) Create(item MQTT) error {
-	// Marshal the MQTT struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new MQTT resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(MQTTEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of MQTT.
+func (m MQTT) MarshalJSON() ([]byte, error) {
+	type plain MQTT
+	return bigip.MarshalExtra(plain(m), m.Extra)
 }
 
-// Update modifies an MQTT resource identified by its full path name using the provided MQTT item.
-func (cr *MQTTResource) Update(fullPathName string, item MQTT) error {
-	// Marshal the MQTT struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const MQTTEndpoint = "mqtt"
 
-	// Perform a PUT request to update the specified MQTT resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(MQTTEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type MQTTResource struct {
+	bigip.Resource[MQTT, MQTTList]
 }
 
-// Delete removes an MQTT resource by its full path name.
-func (cr *MQTTResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified MQTT resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(MQTTEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newMQTTResource(b *bigip.BigIP) MQTTResource {
+	return MQTTResource{bigip.NewResource[MQTT, MQTTList](b, LtmManager, ProfileEndpoint, MQTTEndpoint)}
 }
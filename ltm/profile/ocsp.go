@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type OCSPList struct {
@@ -15,101 +11,37 @@ type OCSPList struct {
 }
 
 type OCSP struct {
-	Kind         string `json:"kind,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	AppService   string `json:"appService,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	MaxAge       int    `json:"maxAge,omitempty"`
-	Nonce        string `json:"nonce,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	MaxAge       int         `json:"maxAge,omitempty"`
+	Nonce        string      `json:"nonce,omitempty"`
+	Extra        bigip.Extra `json:"-"`
 }
 
-const OCSPEndpoint = "ocsp"
-
-type OCSPResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of OCSP resources.
-func (cr *OCSPResource) List() (*OCSPList, error) {
-	var items OCSPList
-	// Perform a GET request to retrieve a list of OCSP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OCSPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into OCSPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes OCSP does not model in Extra.
+func (o *OCSP) UnmarshalJSON(data []byte) error {
+	type plain OCSP
+	return bigip.UnmarshalExtra(data, (*plain)(o), &o.Extra)
 }
 
-// Get retrieves an OCSP resource by its full path name.
-func (cr *OCSPResource) Get(fullPathName string) (*OCSP, error) {
-	var item OCSP
-	// Perform a GET request to retrieve a specific OCSP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OCSPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into OCSP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new OCSP resource using the provided OCSP item.
-func (cr *OCSPResource) Create(item OCSP) error {
-	// Marshal the OCSP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new OCSP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OCSPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of OCSP.
+func (o OCSP) MarshalJSON() ([]byte, error) {
+	type plain OCSP
+	return bigip.MarshalExtra(plain(o), o.Extra)
 }
 
-// Update modifies an OCSP resource identified by its full path name using the provided OCSP item.
-func (cr *OCSPResource) Update(fullPathName string, item OCSP) error {
-	// Marshal the OCSP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const OCSPEndpoint = "ocsp"
 
-	// Perform a PUT request to update the specified OCSP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OCSPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type OCSPResource struct {
+	bigip.Resource[OCSP, OCSPList]
 }
 
-// Delete removes an OCSP resource by its full path name.
-func (cr *OCSPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified OCSP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OCSPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newOCSPResource(b *bigip.BigIP) OCSPResource {
+	return OCSPResource{bigip.NewResource[OCSP, OCSPList](b, LtmManager, ProfileEndpoint, OCSPEndpoint)}
 }
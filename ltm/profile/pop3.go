@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type POP3List struct {
@@ -15,101 +11,37 @@ type POP3List struct {
 }
 
 type POP3 struct {
-	Kind           string `json:"kind,omitempty"`
-	Name           string `json:"name,omitempty"`
-	Partition      string `json:"partition,omitempty"`
-	FullPath       string `json:"fullPath,omitempty"`
-	Generation     int    `json:"generation,omitempty"`
-	SelfLink       string `json:"selfLink,omitempty"`
-	ActivationMode string `json:"activationMode,omitempty"`
-	AppService     string `json:"appService,omitempty"`
-	DefaultsFrom   string `json:"defaultsFrom,omitempty"`
-	Description    string `json:"description,omitempty"`
+	Kind           string      `json:"kind,omitempty"`
+	Name           string      `json:"name,omitempty"`
+	Partition      string      `json:"partition,omitempty"`
+	FullPath       string      `json:"fullPath,omitempty"`
+	Generation     int         `json:"generation,omitempty"`
+	SelfLink       string      `json:"selfLink,omitempty"`
+	ActivationMode string      `json:"activationMode,omitempty"`
+	AppService     string      `json:"appService,omitempty"`
+	DefaultsFrom   string      `json:"defaultsFrom,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	Extra          bigip.Extra `json:"-"`
 }
 
-const POP3Endpoint = "pop3"
-
-type POP3Resource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of POP3 resources.
-func (cr *POP3Resource) List() (*POP3List, error) {
-	var items POP3List
-	// Perform a GET request to retrieve a list of POP3 resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(POP3Endpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into POP3List struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes POP3 does not model in Extra.
+func (p *POP3) UnmarshalJSON(data []byte) error {
+	type plain POP3
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
 }
 
-// Get retrieves a POP3 resource by its full path name.
-func (cr *POP3Resource) Get(fullPathName string) (*POP3, error) {
-	var item POP3
-	// Perform a GET request to retrieve a specific POP3 resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(POP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into POP3 struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new POP3 resource using the provided POP3 item.
-func (cr *POP3Resource) Create(item POP3) error {
-	// Marshal the POP3 struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new POP3 resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(POP3Endpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of POP3.
+func (p POP3) MarshalJSON() ([]byte, error) {
+	type plain POP3
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
-// Update modifies a POP3 resource identified by its full path name using the provided POP3 item.
-func (cr *POP3Resource) Update(fullPathName string, item POP3) error {
-	// Marshal the POP3 struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const POP3Endpoint = "pop3"
 
-	// Perform a PUT request to update the specified POP3 resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(POP3Endpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type POP3Resource struct {
+	bigip.Resource[POP3, POP3List]
 }
 
-// Delete removes a POP3 resource by its full path name.
-func (cr *POP3Resource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified POP3 resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(POP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newPOP3Resource(b *bigip.BigIP) POP3Resource {
+	return POP3Resource{bigip.NewResource[POP3, POP3List](b, LtmManager, ProfileEndpoint, POP3Endpoint)}
 }
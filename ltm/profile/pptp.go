@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type PPTPList struct {
@@ -15,103 +11,39 @@ type PPTPList struct {
 }
 
 type PPTP struct {
-	Kind                 string `json:"kind,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Partition            string `json:"partition,omitempty"`
-	FullPath             string `json:"fullPath,omitempty"`
-	Generation           int    `json:"generation,omitempty"`
-	SelfLink             string `json:"selfLink,omitempty"`
-	AppService           string `json:"appService,omitempty"`
-	CsvFormat            string `json:"csvFormat,omitempty"`
-	DefaultsFrom         string `json:"defaultsFrom,omitempty"`
-	Description          string `json:"description,omitempty"`
-	IncludeDestinationIP string `json:"includeDestinationIp,omitempty"`
-	PublisherName        string `json:"publisherName,omitempty"`
+	Kind                 string      `json:"kind,omitempty"`
+	Name                 string      `json:"name,omitempty"`
+	Partition            string      `json:"partition,omitempty"`
+	FullPath             string      `json:"fullPath,omitempty"`
+	Generation           int         `json:"generation,omitempty"`
+	SelfLink             string      `json:"selfLink,omitempty"`
+	AppService           string      `json:"appService,omitempty"`
+	CsvFormat            string      `json:"csvFormat,omitempty"`
+	DefaultsFrom         string      `json:"defaultsFrom,omitempty"`
+	Description          string      `json:"description,omitempty"`
+	IncludeDestinationIP string      `json:"includeDestinationIp,omitempty"`
+	PublisherName        string      `json:"publisherName,omitempty"`
+	Extra                bigip.Extra `json:"-"`
 }
 
-const PPTPEndpoint = "pptp"
-
-type PPTPResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of PPTP resources.
-func (cr *PPTPResource) List() (*PPTPList, error) {
-	var items PPTPList
-	// Perform a GET request to retrieve a list of PPTP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(PPTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into PPTPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes PPTP does not model in Extra.
+func (p *PPTP) UnmarshalJSON(data []byte) error {
+	type plain PPTP
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
 }
 
-// Get retrieves a PPTP resource by its full path name.
-func (cr *PPTPResource) Get(fullPathName string) (*PPTP, error) {
-	var item PPTP
-	// Perform a GET request to retrieve a specific PPTP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(PPTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into PPTP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new PPTP resource using the provided PPTP item.
-func (cr *PPTPResource) Create(item PPTP) error {
-	// Marshal the PPTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new PPTP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(PPTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of PPTP.
+func (p PPTP) MarshalJSON() ([]byte, error) {
+	type plain PPTP
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
-// Update modifies a PPTP resource identified by its full path name using the provided PPTP item.
-func (cr *PPTPResource) Update(fullPathName string, item PPTP) error {
-	// Marshal the PPTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const PPTPEndpoint = "pptp"
 
-	// Perform a PUT request to update the specified PPTP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(PPTPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type PPTPResource struct {
+	bigip.Resource[PPTP, PPTPList]
 }
 
-// Delete removes a PPTP resource by its full path name.
-func (cr *PPTPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified PPTP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(PPTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newPPTPResource(b *bigip.BigIP) PPTPResource {
+	return PPTPResource{bigip.NewResource[PPTP, PPTPList](b, LtmManager, ProfileEndpoint, PPTPEndpoint)}
 }
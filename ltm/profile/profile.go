@@ -80,71 +80,71 @@ type ProfileResource struct {
 
 func NewProfile(b *bigip.BigIP) ProfileResource {
 	return ProfileResource{
-		certificateAuthority: CertificateAuthorityResource{b: b},
-		clientLDAP:           ClientLDAPResource{b: b},
-		clientSSL:            ClientSSLResource{b: b},
-		connector:            ConnectorResource{b: b},
+		certificateAuthority: newCertificateAuthorityResource(b),
+		clientLDAP:           newClientLDAPResource(b),
+		clientSSL:            newClientSSLResource(b),
+		connector:            newConnectorResource(b),
 		//dhcpv4:               DHCPv4Resource{b: b},
 		//dhcpv6:               DHCPv6Resource{b: b},
-		diameter: DiameterResource{b: b},
-		dns:      DNSResource{b: b},
+		diameter: newDiameterResource(b),
+		dns:      newDNSResource(b),
 		//dns_logging:          DNSLoggingResource{b: b},
 		//doh_proxy:            DOHProxyResource{b: b},
 		//doh_server:           DOHServerResource{b: b},
-		fasthttp: FastHTTPResource{b: b},
-		fastL4:   FastL4Resource{b: b},
-		fix:      FIXResource{b: b},
-		ftp:      FTPResource{b: b},
+		fasthttp: newFastHTTPResource(b),
+		fastL4:   newFastL4Resource(b),
+		fix:      newFIXResource(b),
+		ftp:      newFTPResource(b),
 		//georedundancy:        GeoRedundancyResource{b: b},
-		gtp:              GTPResource{b: b},
-		html:             HTMLResource{b: b},
-		http:             HTTPResource{b: b},
-		httpCompression:  HTTPCompressionResource{b: b},
-		httpProxyConnect: HTTPProxyConnectResource{b: b},
-		http2:            HTTP2Resource{b: b},
-		http3:            HTTP3Resource{b: b},
-		httprouter:       HTTPRouterResource{b: b},
-		icap:             ICAPResource{b: b},
-		imap:             IMAPResource{b: b},
+		gtp:              newGTPResource(b),
+		html:             newHTMLResource(b),
+		http:             newHTTPResource(b),
+		httpCompression:  newHTTPCompressionResource(b),
+		httpProxyConnect: newHTTPProxyConnectResource(b),
+		http2:            newHTTP2Resource(b),
+		http3:            newHTTP3Resource(b),
+		httprouter:       newHTTPRouterResource(b),
+		icap:             newICAPResource(b),
+		imap:             newIMAPResource(b),
 		//ipother:              IPOtherResource{b: b},
 		//ipsecalg:             IPsecALGResource{b: b},
 		//mblb:                 MBLBResource{b: b},
-		mqtt: MQTTResource{b: b},
+		mqtt: newMQTTResource(b),
 		//mr_ratelimit:         MRRateLimitResource{b: b},
-		netflow: NetflowResource{b: b},
-		ntlm:    NTLMResource{b: b},
-		ocsp:    OCSPResource{b: b},
+		netflow: newNetflowResource(b),
+		ntlm:    newNTLMResource(b),
+		ocsp:    newOCSPResource(b),
 		//ocsp_stapling_params: OCSPStaplingParamsResource{b: b},
-		oneConnect: OneConnectResource{b: b},
-		pop3:       POP3Resource{b: b},
-		pptp:       PPTPResource{b: b},
-		qoe:        QOEResource{b: b},
-		quic:       QUICResource{b: b},
-		radius:     RADIUSResource{b: b},
+		oneConnect: newOneConnectResource(b),
+		pop3:       newPOP3Resource(b),
+		pptp:       newPPTPResource(b),
+		qoe:        newQOEResource(b),
+		quic:       newQUICResource(b),
+		radius:     newRADIUSResource(b),
 		//request_adapt:        RequestAdaptResource{b: b},
 		//request_log:          RequestLogResource{b: b},
 		//response_adapt:       ResponseAdaptResource{b: b},
-		rewrite:    RewriteResource{b: b},
-		rtsp:       RTSPResource{b: b},
-		sctp:       SCTPResource{b: b},
-		serverLDAP: ServerLDAPResource{b: b},
-		serverSSL:  ServerSSLResource{b: b},
-		service:    ServiceResource{b: b},
-		sip:        SIPResource{b: b},
-		smtps:      SMTPSResource{b: b},
-		socks:      SocksResource{b: b},
+		rewrite:    newRewriteResource(b),
+		rtsp:       newRTSPResource(b),
+		sctp:       newSCTPResource(b),
+		serverLDAP: newServerLDAPResource(b),
+		serverSSL:  newServerSSLResource(b),
+		service:    newServiceResource(b),
+		sip:        newSIPResource(b),
+		smtps:      newSMTPSResource(b),
+		socks:      newSocksResource(b),
 		//splitsessionclient:   SplitSessionClientResource{b: b},
 		//splitsessionserver:   SplitSessionServerResource{b: b},
-		statistics:      StatisticsResource{b: b},
-		stream:          StreamResource{b: b},
-		tcp:             TCPResource{b: b},
-		tcpAnalytics:    TCPAnalyticsResource{b: b},
-		tdr:             TDRResource{b: b},
-		tftp:            TFTPResource{b: b},
-		udp:             UDPResource{b: b},
-		webAcceleration: WebAccelerationResource{b: b},
-		websocket:       WebSocketResource{b: b},
-		xml:             XMLResource{b: b},
+		statistics:      newStatisticsResource(b),
+		stream:          newStreamResource(b),
+		tcp:             newTCPResource(b),
+		tcpAnalytics:    newTCPAnalyticsResource(b),
+		tdr:             newTDRResource(b),
+		tftp:            newTFTPResource(b),
+		udp:             newUDPResource(b),
+		webAcceleration: newWebAccelerationResource(b),
+		websocket:       newWebSocketResource(b),
+		xml:             newXMLResource(b),
 	}
 }
 
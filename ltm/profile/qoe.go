@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type QOEList struct {
@@ -15,92 +11,37 @@ type QOEList struct {
 }
 
 type QOE struct {
-	Kind         string `json:"kind,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	AppService   string `json:"appService,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Video        string `json:"video,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Video        string      `json:"video,omitempty"`
+	Extra        bigip.Extra `json:"-"`
 }
 
-const QOEEndpoint = "qoe"
-
-type QOEResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of QOE resources.
-func (qr *QOEResource) List() (*QOEList, error) {
-	var items QOEList
-	res, err := qr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QOEEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes QOE does not model in Extra.
+func (q *QOE) UnmarshalJSON(data []byte) error {
+	type plain QOE
+	return bigip.UnmarshalExtra(data, (*plain)(q), &q.Extra)
 }
 
-// Get retrieves a QOE resource by its full path name.
-func (qr *QOEResource) Get(fullPathName string) (*QOE, error) {
-	var item QOE
-	res, err := qr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QOEEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new QOE resource using the provided QOE item.
-func (qr *QOEResource) Create(item QOE) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	_, err = qr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QOEEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of QOE.
+func (q QOE) MarshalJSON() ([]byte, error) {
+	type plain QOE
+	return bigip.MarshalExtra(plain(q), q.Extra)
 }
 
-// Update modifies a QOE resource identified by its full path name using the provided QOE item.
-func (qr *QOEResource) Update(fullPathName string, item QOE) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const QOEEndpoint = "qoe"
 
-	_, err = qr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QOEEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type QOEResource struct {
+	bigip.Resource[QOE, QOEList]
 }
 
-// Delete removes a QOE resource by its full path name.
-func (qr *QOEResource) Delete(fullPathName string) error {
-	_, err := qr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QOEEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newQOEResource(b *bigip.BigIP) QOEResource {
+	return QOEResource{bigip.NewResource[QOE, QOEList](b, LtmManager, ProfileEndpoint, QOEEndpoint)}
 }
@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 //radius
@@ -31,91 +27,27 @@ type RADIUS struct {
 	DefaultsFromReference struct {
 		Link string `json:"link,omitempty"`
 	} `json:"defaultsFromReference,omitempty"`
+	Extra bigip.Extra `json:"-"`
 }
 
-const RADIUSEndpoint = "radius"
-
-type RADIUSResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of RADIUS resources.
-func (cr *RADIUSResource) List() (*RADIUSList, error) {
-	var items RADIUSList
-	// Perform a GET request to retrieve a list of RADIUS resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RADIUSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into RADIUSList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes RADIUS does not model in Extra.
+func (r *RADIUS) UnmarshalJSON(data []byte) error {
+	type plain RADIUS
+	return bigip.UnmarshalExtra(data, (*plain)(r), &r.Extra)
 }
 
-// Get retrieves a RADIUS resource by its full path name.
-func (cr *RADIUSResource) Get(fullPathName string) (*RADIUS, error) {
-	var item RADIUS
-	// Perform a GET request to retrieve a specific RADIUS resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RADIUSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into RADIUS struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new RADIUS resource using the provided RADIUS item.
-func (cr *RADIUSResource) Create(item RADIUS) error {
-	// Marshal the RADIUS struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new RADIUS resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RADIUSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of RADIUS.
+func (r RADIUS) MarshalJSON() ([]byte, error) {
+	type plain RADIUS
+	return bigip.MarshalExtra(plain(r), r.Extra)
 }
 
-// Update modifies a RADIUS resource identified by its full path name using the provided RADIUS item.
-func (cr *RADIUSResource) Update(fullPathName string, item RADIUS) error {
-	// Marshal the RADIUS struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const RADIUSEndpoint = "radius"
 
-	// Perform a PUT request to update the specified RADIUS resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RADIUSEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type RADIUSResource struct {
+	bigip.Resource[RADIUS, RADIUSList]
 }
 
-// Delete removes a RADIUS resource by its full path name.
-func (cr *RADIUSResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified RADIUS resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RADIUSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newRADIUSResource(b *bigip.BigIP) RADIUSResource {
+	return RADIUSResource{bigip.NewResource[RADIUS, RADIUSList](b, LtmManager, ProfileEndpoint, RADIUSEndpoint)}
 }
@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type RewriteList struct {
@@ -59,91 +55,27 @@ type Rewrite struct {
 		Link            string `json:"link,omitempty"`
 		IsSubcollection bool   `json:"isSubcollection,omitempty"`
 	} `json:"uriRulesReference,omitempty"`
+	Extra bigip.Extra `json:"-"`
 }
 
-const RewriteEndpoint = "rewrite"
-
-type RewriteResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of Rewrite resources.
-func (cr *RewriteResource) List() (*RewriteList, error) {
-	var items RewriteList
-	// Perform a GET request to retrieve a list of Rewrite resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RewriteEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into RewriteList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Rewrite does not model in Extra.
+func (r *Rewrite) UnmarshalJSON(data []byte) error {
+	type plain Rewrite
+	return bigip.UnmarshalExtra(data, (*plain)(r), &r.Extra)
 }
 
-// Get retrieves a Rewrite resource by its full path name.
-func (cr *RewriteResource) Get(fullPathName string) (*Rewrite, error) {
-	var item Rewrite
-	// Perform a GET request to retrieve a specific Rewrite resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RewriteEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into Rewrite struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new Rewrite resource using the provided Rewrite item.
-func (cr *RewriteResource) Create(item Rewrite) error {
-	// Marshal the Rewrite struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new Rewrite resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RewriteEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of Rewrite.
+func (r Rewrite) MarshalJSON() ([]byte, error) {
+	type plain Rewrite
+	return bigip.MarshalExtra(plain(r), r.Extra)
 }
 
-// Update modifies a Rewrite resource identified by its full path name using the provided Rewrite item.
-func (cr *RewriteResource) Update(fullPathName string, item Rewrite) error {
-	// Marshal the Rewrite struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const RewriteEndpoint = "rewrite"
 
-	// Perform a PUT request to update the specified Rewrite resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RewriteEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type RewriteResource struct {
+	bigip.Resource[Rewrite, RewriteList]
 }
 
-// Delete removes a Rewrite resource by its full path name.
-func (cr *RewriteResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified Rewrite resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RewriteEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newRewriteResource(b *bigip.BigIP) RewriteResource {
+	return RewriteResource{bigip.NewResource[Rewrite, RewriteList](b, LtmManager, ProfileEndpoint, RewriteEndpoint)}
 }
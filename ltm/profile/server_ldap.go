@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type ServerLDAPList struct {
@@ -15,92 +11,37 @@ type ServerLDAPList struct {
 }
 
 type ServerLDAP struct {
-	Kind           string `json:"kind,omitempty"`
-	Name           string `json:"name,omitempty"`
-	Partition      string `json:"partition,omitempty"`
-	FullPath       string `json:"fullPath,omitempty"`
-	Generation     int    `json:"generation,omitempty"`
-	SelfLink       string `json:"selfLink,omitempty"`
-	ActivationMode string `json:"activationMode,omitempty"`
-	AppService     string `json:"appService,omitempty"`
-	DefaultsFrom   string `json:"defaultsFrom,omitempty"`
-	Description    string `json:"description,omitempty"`
+	Kind           string      `json:"kind,omitempty"`
+	Name           string      `json:"name,omitempty"`
+	Partition      string      `json:"partition,omitempty"`
+	FullPath       string      `json:"fullPath,omitempty"`
+	Generation     int         `json:"generation,omitempty"`
+	SelfLink       string      `json:"selfLink,omitempty"`
+	ActivationMode string      `json:"activationMode,omitempty"`
+	AppService     string      `json:"appService,omitempty"`
+	DefaultsFrom   string      `json:"defaultsFrom,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	Extra          bigip.Extra `json:"-"`
 }
 
-const ServerLDAPEndpoint = "server-ldap"
-
-type ServerLDAPResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of ServerLDAP resources.
-func (sr *ServerLDAPResource) List() (*ServerLDAPList, error) {
-	var items ServerLDAPList
-	res, err := sr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerLDAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes ServerLDAP does not model in Extra.
+func (s *ServerLDAP) UnmarshalJSON(data []byte) error {
+	type plain ServerLDAP
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
 }
 
-// Get retrieves a ServerLDAP resource by its full path name.
-func (sr *ServerLDAPResource) Get(fullPathName string) (*ServerLDAP, error) {
-	var item ServerLDAP
-	res, err := sr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerLDAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new ServerLDAP resource using the provided ServerLDAP item.
-func (sr *ServerLDAPResource) Create(item ServerLDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	_, err = sr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerLDAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of ServerLDAP.
+func (s ServerLDAP) MarshalJSON() ([]byte, error) {
+	type plain ServerLDAP
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
-// Update modifies a ServerLDAP resource identified by its full path name using the provided ServerLDAP item.
-func (sr *ServerLDAPResource) Update(fullPathName string, item ServerLDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const ServerLDAPEndpoint = "server-ldap"
 
-	_, err = sr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerLDAPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type ServerLDAPResource struct {
+	bigip.Resource[ServerLDAP, ServerLDAPList]
 }
 
-// Delete removes a ServerLDAP resource by its full path name.
-func (sr *ServerLDAPResource) Delete(fullPathName string) error {
-	_, err := sr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerLDAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newServerLDAPResource(b *bigip.BigIP) ServerLDAPResource {
+	return ServerLDAPResource{bigip.NewResource[ServerLDAP, ServerLDAPList](b, LtmManager, ProfileEndpoint, ServerLDAPEndpoint)}
 }
@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type ServerSSLList struct {
@@ -79,91 +75,27 @@ type ServerSSL struct {
 	UncleanShutdown                  string        `json:"uncleanShutdown,omitempty"`
 	UnknownCertStatusResponseControl string        `json:"unknownCertStatusResponseControl,omitempty"`
 	UntrustedCertResponseControl     string        `json:"untrustedCertResponseControl,omitempty"`
+	Extra                            bigip.Extra   `json:"-"`
 }
 
-const ServerSSLEndpoint = "server-ssl"
-
-type ServerSSLResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of ServerSSL resources.
-func (cr *ServerSSLResource) List() (*ServerSSLList, error) {
-	var items ServerSSLList
-	// Perform a GET request to retrieve a list of ServerSSL resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerSSLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into ServerSSLList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes ServerSSL does not model in Extra.
+func (s *ServerSSL) UnmarshalJSON(data []byte) error {
+	type plain ServerSSL
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
 }
 
-// Get retrieves a ServerSSL resource by its full path name.
-func (cr *ServerSSLResource) Get(fullPathName string) (*ServerSSL, error) {
-	var item ServerSSL
-	// Perform a GET request to retrieve a specific ServerSSL resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerSSLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into ServerSSL struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new ServerSSL resource using the provided ServerSSL item.
-func (cr *ServerSSLResource) Create(item ServerSSL) error {
-	// Marshal the ServerSSL struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new ServerSSL resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerSSLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of ServerSSL.
+func (s ServerSSL) MarshalJSON() ([]byte, error) {
+	type plain ServerSSL
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
-// Update modifies a ServerSSL resource identified by its full path name using the provided ServerSSL item.
-func (cr *ServerSSLResource) Update(fullPathName string, item ServerSSL) error {
-	// Marshal the ServerSSL struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const ServerSSLEndpoint = "server-ssl"
 
-	// Perform a PUT request to update the specified ServerSSL resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerSSLEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type ServerSSLResource struct {
+	bigip.Resource[ServerSSL, ServerSSLList]
 }
 
-// Delete removes a ServerSSL resource by its full path name.
-func (cr *ServerSSLResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified ServerSSL resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerSSLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newServerSSLResource(b *bigip.BigIP) ServerSSLResource {
+	return ServerSSLResource{bigip.NewResource[ServerSSL, ServerSSLList](b, LtmManager, ProfileEndpoint, ServerSSLEndpoint)}
 }
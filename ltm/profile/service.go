@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type ServiceList struct {
@@ -14,99 +10,35 @@ type ServiceList struct {
 	SelfLink string    `json:"selflink,omitempty"`
 }
 type Service struct {
-	Kind       string `json:"kind,omitempty"`
-	Name       string `json:"name,omitempty"`
-	Partition  string `json:"partition,omitempty"`
-	FullPath   string `json:"fullPath,omitempty"`
-	Generation int    `json:"generation,omitempty"`
-	SelfLink   string `json:"selfLink,omitempty"`
-	AppService string `json:"appService,omitempty"`
-	Type       string `json:"type,omitempty"`
+	Kind       string      `json:"kind,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	Partition  string      `json:"partition,omitempty"`
+	FullPath   string      `json:"fullPath,omitempty"`
+	Generation int         `json:"generation,omitempty"`
+	SelfLink   string      `json:"selfLink,omitempty"`
+	AppService string      `json:"appService,omitempty"`
+	Type       string      `json:"type,omitempty"`
+	Extra      bigip.Extra `json:"-"`
 }
 
-const ServiceEndpoint = "service"
-
-type ServiceResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of Service resources.
-func (cr *ServiceResource) List() (*ServiceList, error) {
-	var items ServiceList
-	// Perform a GET request to retrieve a list of Service resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServiceEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into ServiceList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Service does not model in Extra.
+func (s *Service) UnmarshalJSON(data []byte) error {
+	type plain Service
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
 }
 
-// Get retrieves a Service resource by its full path name.
-func (cr *ServiceResource) Get(fullPathName string) (*Service, error) {
-	var item Service
-	// Perform a GET request to retrieve a specific Service resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServiceEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into Service struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new Service resource using the provided Service item.
-func (cr *ServiceResource) Create(item Service) error {
-	// Marshal the Service struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new Service resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServiceEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of Service.
+func (s Service) MarshalJSON() ([]byte, error) {
+	type plain Service
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
-// Update modifies a Service resource identified by its full path name using the provided Service item.
-func (cr *ServiceResource) Update(fullPathName string, item Service) error {
-	// Marshal the Service struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const ServiceEndpoint = "service"
 
-	// Perform a PUT request to update the specified Service resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServiceEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type ServiceResource struct {
+	bigip.Resource[Service, ServiceList]
 }
 
-// Delete removes a Service resource by its full path name.
-func (cr *ServiceResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified Service resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServiceEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newServiceResource(b *bigip.BigIP) ServiceResource {
+	return ServiceResource{bigip.NewResource[Service, ServiceList](b, LtmManager, ProfileEndpoint, ServiceEndpoint)}
 }
@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type SocksList struct {
@@ -36,91 +32,27 @@ type Socks struct {
 	TunnelNameReference struct {
 		Link string `json:"link,omitempty"`
 	} `json:"tunnelNameReference,omitempty"`
+	Extra bigip.Extra `json:"-"`
 }
 
-const SocksEndpoint = "socks"
-
-type SocksResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of Socks resources.
-func (cr *SocksResource) List() (*SocksList, error) {
-	var items SocksList
-	// Perform a GET request to retrieve a list of Socks resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SocksEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into SocksList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Socks does not model in Extra.
+func (s *Socks) UnmarshalJSON(data []byte) error {
+	type plain Socks
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
 }
 
-// Get retrieves a Socks resource by its full path name.
-func (cr *SocksResource) Get(fullPathName string) (*Socks, error) {
-	var item Socks
-	// Perform a GET request to retrieve a specific Socks resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SocksEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into Socks struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new Socks resource using the provided Socks item.
-func (cr *SocksResource) Create(item Socks) error {
-	// Marshal the Socks struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new Socks resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SocksEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of Socks.
+func (s Socks) MarshalJSON() ([]byte, error) {
+	type plain Socks
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
-// Update modifies a Socks resource identified by its full path name using the provided Socks item.
-func (cr *SocksResource) Update(fullPathName string, item Socks) error {
-	// Marshal the Socks struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const SocksEndpoint = "socks"
 
-	// Perform a PUT request to update the specified Socks resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SocksEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type SocksResource struct {
+	bigip.Resource[Socks, SocksList]
 }
 
-// Delete removes a Socks resource by its full path name.
-func (cr *SocksResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified Socks resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SocksEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newSocksResource(b *bigip.BigIP) SocksResource {
+	return SocksResource{bigip.NewResource[Socks, SocksList](b, LtmManager, ProfileEndpoint, SocksEndpoint)}
 }
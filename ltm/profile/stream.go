@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type StreamList struct {
@@ -15,104 +11,40 @@ type StreamList struct {
 }
 
 type Stream struct {
-	Kind         string `json:"kind,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	AppService   string `json:"appService,omitempty"`
-	ChunkSize    int    `json:"chunkSize,omitempty"`
-	Chunking     string `json:"chunking,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Source       string `json:"source,omitempty"`
-	TmTarget     string `json:"tmTarget,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	ChunkSize    int         `json:"chunkSize,omitempty"`
+	Chunking     string      `json:"chunking,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Source       string      `json:"source,omitempty"`
+	TmTarget     string      `json:"tmTarget,omitempty"`
+	Extra        bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Stream does not model in Extra.
+func (s *Stream) UnmarshalJSON(data []byte) error {
+	type plain Stream
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Stream.
+func (s Stream) MarshalJSON() ([]byte, error) {
+	type plain Stream
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
 const StreamEndpoint = "stream"
 
 type StreamResource struct {
-	b *bigip.BigIP
+	bigip.Resource[Stream, StreamList]
 }
 
-// List retrieves a list of Stream resources.
-func (cr *StreamResource) List() (*StreamList, error) {
-	var items StreamList
-	// Perform a GET request to retrieve a list of Stream resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StreamEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into StreamList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
-}
-
-// Get retrieves a Stream resource by its full path name.
-func (cr *StreamResource) Get(fullPathName string) (*Stream, error) {
-	var item Stream
-	// Perform a GET request to retrieve a specific Stream resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StreamEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into Stream struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new Stream resource using the provided Stream item.
-func (cr *StreamResource) Create(item Stream) error {
-	// Marshal the Stream struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new Stream resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StreamEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Update modifies a Stream resource identified by its full path name using the provided Stream item.
-func (cr *StreamResource) Update(fullPathName string, item Stream) error {
-	// Marshal the Stream struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a PUT request to update the specified Stream resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StreamEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete removes a Stream resource by its full path name.
-func (cr *StreamResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified Stream resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StreamEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newStreamResource(b *bigip.BigIP) StreamResource {
+	return StreamResource{bigip.NewResource[Stream, StreamList](b, LtmManager, ProfileEndpoint, StreamEndpoint)}
 }
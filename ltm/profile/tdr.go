@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type TDRList struct {
@@ -35,91 +31,27 @@ type TDR struct {
 	DefaultsFromReference struct {
 		Link string `json:"link,omitempty"`
 	} `json:"defaultsFromReference,omitempty"`
+	Extra bigip.Extra `json:"-"`
 }
 
-const TDREndpoint = "tdr"
-
-type TDRResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of TDR resources.
-func (cr *TDRResource) List() (*TDRList, error) {
-	var items TDRList
-	// Perform a GET request to retrieve a list of TDR resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TDREndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into TDRList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes TDR does not model in Extra.
+func (t *TDR) UnmarshalJSON(data []byte) error {
+	type plain TDR
+	return bigip.UnmarshalExtra(data, (*plain)(t), &t.Extra)
 }
 
-// Get retrieves a TDR resource by its full path name.
-func (cr *TDRResource) Get(fullPathName string) (*TDR, error) {
-	var item TDR
-	// Perform a GET request to retrieve a specific TDR resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TDREndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into TDR struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new TDR resource using the provided TDR item.
-func (cr *TDRResource) Create(item TDR) error {
-	// Marshal the TDR struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new TDR resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TDREndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of TDR.
+func (t TDR) MarshalJSON() ([]byte, error) {
+	type plain TDR
+	return bigip.MarshalExtra(plain(t), t.Extra)
 }
 
-// Update modifies a TDR resource identified by its full path name using the provided TDR item.
-func (cr *TDRResource) Update(fullPathName string, item TDR) error {
-	// Marshal the TDR struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const TDREndpoint = "tdr"
 
-	// Perform a PUT request to update the specified TDR resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TDREndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type TDRResource struct {
+	bigip.Resource[TDR, TDRList]
 }
 
-// Delete removes a TDR resource by its full path name.
-func (cr *TDRResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified TDR resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TDREndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newTDRResource(b *bigip.BigIP) TDRResource {
+	return TDRResource{bigip.NewResource[TDR, TDRList](b, LtmManager, ProfileEndpoint, TDREndpoint)}
 }
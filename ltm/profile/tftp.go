@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type TFTPList struct {
@@ -15,103 +11,39 @@ type TFTPList struct {
 }
 
 type TFTP struct {
-	Kind         string `json:"kind,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Partition    string `json:"partition,omitempty"`
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
-	AppService   string `json:"appService,omitempty"`
-	DefaultsFrom string `json:"defaultsFrom,omitempty"`
-	Description  string `json:"description,omitempty"`
-	IdleTimeout  string `json:"idleTimeout,omitempty"`
-	LogProfile   string `json:"logProfile,omitempty"`
-	LogPublisher string `json:"logPublisher,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Partition    string      `json:"partition,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	AppService   string      `json:"appService,omitempty"`
+	DefaultsFrom string      `json:"defaultsFrom,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	IdleTimeout  string      `json:"idleTimeout,omitempty"`
+	LogProfile   string      `json:"logProfile,omitempty"`
+	LogPublisher string      `json:"logPublisher,omitempty"`
+	Extra        bigip.Extra `json:"-"`
 }
 
-const TFTPEndpoint = "tcp"
-
-type TFTPResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of TFTP resources.
-func (cr *TFTPResource) List() (*TFTPList, error) {
-	var items TFTPList
-	// Perform a GET request to retrieve a list of TFTP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TFTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into TFTPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes TFTP does not model in Extra.
+func (t *TFTP) UnmarshalJSON(data []byte) error {
+	type plain TFTP
+	return bigip.UnmarshalExtra(data, (*plain)(t), &t.Extra)
 }
 
-// Get retrieves a TFTP resource by its full path name.
-func (cr *TFTPResource) Get(fullPathName string) (*TFTP, error) {
-	var item TFTP
-	// Perform a GET request to retrieve a specific TFTP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TFTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into TFTP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new TFTP resource using the provided TFTP item.
-func (cr *TFTPResource) Create(item TFTP) error {
-	// Marshal the TFTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new TFTP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TFTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of TFTP.
+func (t TFTP) MarshalJSON() ([]byte, error) {
+	type plain TFTP
+	return bigip.MarshalExtra(plain(t), t.Extra)
 }
 
-// Update modifies a TFTP resource identified by its full path name using the provided TFTP item.
-func (cr *TFTPResource) Update(fullPathName string, item TFTP) error {
-	// Marshal the TFTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const TFTPEndpoint = "tcp"
 
-	// Perform a PUT request to update the specified TFTP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TFTPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type TFTPResource struct {
+	bigip.Resource[TFTP, TFTPList]
 }
 
-// Delete removes a TFTP resource by its full path name.
-func (cr *TFTPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified TFTP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TFTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newTFTPResource(b *bigip.BigIP) TFTPResource {
+	return TFTPResource{bigip.NewResource[TFTP, TFTPList](b, LtmManager, ProfileEndpoint, TFTPEndpoint)}
 }
@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type UDPList struct {
@@ -41,91 +37,27 @@ type UDP struct {
 	DefaultsFromReference struct {
 		Link string `json:"link,omitempty"`
 	} `json:"defaultsFromReference,omitempty"`
+	Extra bigip.Extra `json:"-"`
 }
 
-const UDPEndpoint = "udp"
-
-type UDPResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of UDP resources.
-func (cr *UDPResource) List() (*UDPList, error) {
-	var items UDPList
-	// Perform a GET request to retrieve a list of UDP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(UDPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into UDPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes UDP does not model in Extra.
+func (u *UDP) UnmarshalJSON(data []byte) error {
+	type plain UDP
+	return bigip.UnmarshalExtra(data, (*plain)(u), &u.Extra)
 }
 
-// Get retrieves a UDP resource by its full path name.
-func (cr *UDPResource) Get(fullPathName string) (*UDP, error) {
-	var item UDP
-	// Perform a GET request to retrieve a specific UDP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(UDPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into UDP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new UDP resource using the provided UDP item.
-func (cr *UDPResource) Create(item UDP) error {
-	// Marshal the UDP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new UDP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(UDPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of UDP.
+func (u UDP) MarshalJSON() ([]byte, error) {
+	type plain UDP
+	return bigip.MarshalExtra(plain(u), u.Extra)
 }
 
-// Update modifies a UDP resource identified by its full path name using the provided UDP item.
-func (cr *UDPResource) Update(fullPathName string, item UDP) error {
-	// Marshal the UDP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const UDPEndpoint = "udp"
 
-	// Perform a PUT request to update the specified UDP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(UDPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type UDPResource struct {
+	bigip.Resource[UDP, UDPList]
 }
 
-// Delete removes a UDP resource by its full path name.
-func (cr *UDPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified UDP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(UDPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newUDPResource(b *bigip.BigIP) UDPResource {
+	return UDPResource{bigip.NewResource[UDP, UDPList](b, LtmManager, ProfileEndpoint, UDPEndpoint)}
 }
@@ -1,11 +1,7 @@
 package profile
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 type WebAccelerationList struct {
@@ -40,92 +36,28 @@ type WebAcceleration struct {
 	DefaultsFromReference       struct {
 		Link string `json:"link,omitempty"`
 	} `json:"defaultsFromReference,omitempty"`
-	MetadataCacheMaxSize int `json:"metadataCacheMaxSize,omitempty"`
+	MetadataCacheMaxSize int         `json:"metadataCacheMaxSize,omitempty"`
+	Extra                bigip.Extra `json:"-"`
 }
 
-const WebAccelerationEndpoint = "webacceleration"
-
-type WebAccelerationResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves a list of WebAcceleration resources.
-func (cr *WebAccelerationResource) List() (*WebAccelerationList, error) {
-	var items WebAccelerationList
-	// Perform a GET request to retrieve a list of WebAcceleration resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebAccelerationEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into WebAccelerationList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes WebAcceleration does not model in Extra.
+func (w *WebAcceleration) UnmarshalJSON(data []byte) error {
+	type plain WebAcceleration
+	return bigip.UnmarshalExtra(data, (*plain)(w), &w.Extra)
 }
 
-// Get retrieves a WebAcceleration resource by its full path name.
-func (cr *WebAccelerationResource) Get(fullPathName string) (*WebAcceleration, error) {
-	var item WebAcceleration
-	// Perform a GET request to retrieve a specific WebAcceleration resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebAccelerationEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into WebAcceleration struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create adds a new WebAcceleration resource using the provided WebAcceleration item.
-func (cr *WebAccelerationResource) Create(item WebAcceleration) error {
-	// Marshal the WebAcceleration struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new WebAcceleration resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebAccelerationEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// MarshalJSON adds the attributes in Extra to those of WebAcceleration.
+func (w WebAcceleration) MarshalJSON() ([]byte, error) {
+	type plain WebAcceleration
+	return bigip.MarshalExtra(plain(w), w.Extra)
 }
 
-// Update modifies a WebAcceleration resource identified by its full path name using the provided WebAcceleration item.
-func (cr *WebAccelerationResource) Update(fullPathName string, item WebAcceleration) error {
-	// Marshal the WebAcceleration struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+const WebAccelerationEndpoint = "webacceleration"
 
-	// Perform a PUT request to update the specified WebAcceleration resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebAccelerationEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type WebAccelerationResource struct {
+	bigip.Resource[WebAcceleration, WebAccelerationList]
 }
 
-// Delete removes a WebAcceleration resource by its full path name.
-func (cr *WebAccelerationResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified WebAcceleration resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebAccelerationEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newWebAccelerationResource(b *bigip.BigIP) WebAccelerationResource {
+	return WebAccelerationResource{bigip.NewResource[WebAcceleration, WebAccelerationList](b, LtmManager, ProfileEndpoint, WebAccelerationEndpoint)}
 }
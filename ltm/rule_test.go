@@ -2,18 +2,20 @@ package ltm
 
 import (
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
 
 	"testing"
 )
 
 func TestRuleResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	s := bigiptest.NewServer()
+	defer s.Close()
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
-	ruleResource := RuleResource{
-		b: bigIP,
-	}
+	ruleResource := newRuleResource(bigIP)
 
 	ruleScript := "when HTTP_REQUEST {HTTP::respond 200 content \"Hello, iRule!\"}"
 	// Create an iRule
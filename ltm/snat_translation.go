@@ -36,98 +36,50 @@ type SnatTranslation struct {
 	TrafficGroupReference struct {
 		Link string `json:"link,omitempty"`
 	} `json:"trafficGroupReference,omitempty"`
-	UDPIdleTimeout string `json:"udpIdleTimeout,omitempty"`
-	Unit           int    `json:"unit,omitempty"`
+	UDPIdleTimeout string      `json:"udpIdleTimeout,omitempty"`
+	Unit           int         `json:"unit,omitempty"`
+	Extra          bigip.Extra `json:"-"`
 }
 
-// SnatTranslationResource provides an API to manage SnatTranslation object.
-type SnatTranslationResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes SnatTranslation does not model in Extra.
+func (s *SnatTranslation) UnmarshalJSON(data []byte) error {
+	type plain SnatTranslation
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
 }
 
-// SnatTranslationEndpoint represents the REST resource for managing SnatTranslation.
-const SnatTranslationEndpoint = "snat-translation"
-
-// List all the SnatTranslationstate instances.
-func (str *SnatTranslationResource) List() (*SnatTranslationList, error) {
-	res, err := str.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var items SnatTranslationList
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of SnatTranslation.
+func (s SnatTranslation) MarshalJSON() ([]byte, error) {
+	type plain SnatTranslation
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
-// Get a single SnatTranslation identified by name.
-func (str *SnatTranslationResource) Get(fullPathName string) (*SnatTranslation, error) {
-	res, err := str.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var item SnatTranslation
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create a new SnatTranslation instance.
-func (str *SnatTranslationResource) Create(item SnatTranslation) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = str.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// SnatTranslationResource provides an API to manage SnatTranslation object.
+type SnatTranslationResource struct {
+	bigip.Resource[SnatTranslation, SnatTranslationList]
 }
 
-// Update a SnatTranslation instance identified by name.
-func (str *SnatTranslationResource) Update(fullPathName string, item SnatTranslation) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = str.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newSnatTranslationResource(b *bigip.BigIP) SnatTranslationResource {
+	return SnatTranslationResource{bigip.NewResource[SnatTranslation, SnatTranslationList](b, LtmManager, SnatTranslationEndpoint)}
 }
 
-// Delete a single SnatTranslation instance identified by name.
-func (str *SnatTranslationResource) Delete(fullPathName string) error {
-	_, err := str.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// SnatTranslationEndpoint represents the REST resource for managing SnatTranslation.
+const SnatTranslationEndpoint = "snat-translation"
 
 // Enabling a SnatTranslation item identified by the SnatTranslation name.
 func (str *SnatTranslationResource) Enable(name string) error {
+	return str.EnableContext(context.Background(), name)
+}
+
+// EnableContext is like Enable but uses ctx for the request.
+func (str *SnatTranslationResource) EnableContext(ctx context.Context, name string) error {
 	item := SnatTranslation{Enabled: true}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = str.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+	_, err = str.BigIP().RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(SnatTranslationEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -136,14 +88,19 @@ func (str *SnatTranslationResource) Enable(name string) error {
 
 // Disabling a SnatTranslation item identified by the SnatTranslationname.
 func (str *SnatTranslationResource) Disable(name string) error {
+	return str.DisableContext(context.Background(), name)
+}
+
+// DisableContext is like Disable but uses ctx for the request.
+func (str *SnatTranslationResource) DisableContext(ctx context.Context, name string) error {
 	item := SnatTranslation{Disabled: true}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = str.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+	_, err = str.BigIP().RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(SnatTranslationEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -2,10 +2,7 @@ package ltm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 //	You can use this traffic-matching-criteria component to configure the
@@ -45,7 +42,20 @@ type TrafficMatchingCriteria struct {
 	SourceAddressListReference struct {
 		Link string `json:"link"`
 	} `json:"sourceAddressListReference"`
-	SourcePortInline int `json:"sourcePortInline"`
+	SourcePortInline int         `json:"sourcePortInline"`
+	Extra            bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes TrafficMatchingCriteria does not model in Extra.
+func (t *TrafficMatchingCriteria) UnmarshalJSON(data []byte) error {
+	type plain TrafficMatchingCriteria
+	return bigip.UnmarshalExtra(data, (*plain)(t), &t.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of TrafficMatchingCriteria.
+func (t TrafficMatchingCriteria) MarshalJSON() ([]byte, error) {
+	type plain TrafficMatchingCriteria
+	return bigip.MarshalExtra(plain(t), t.Extra)
 }
 
 // TrafficMatchingCriteriaEndpoint represents the REST resource for managing TrafficMatchingCriteria.
@@ -53,28 +63,22 @@ const TrafficMatchingCriteriaEndpoint = "traffic-matching-criteria"
 
 // TrafficMatchingCriteriaResource provides an API to manage virtual server of the address list object.
 type TrafficMatchingCriteriaResource struct {
-	b *bigip.BigIP
+	bigip.Resource[TrafficMatchingCriteria, TrafficMatchingCriteriaList]
 }
 
-// List all TrafficMatchingCriteria details
-func (tmcr *TrafficMatchingCriteriaResource) List() (*TrafficMatchingCriteriaList, error) {
-	var nl TrafficMatchingCriteriaList
-	res, err := tmcr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(TrafficMatchingCriteriaEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &nl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &nl, nil
+func newTrafficMatchingCriteriaResource(b *bigip.BigIP) TrafficMatchingCriteriaResource {
+	return TrafficMatchingCriteriaResource{bigip.NewResource[TrafficMatchingCriteria, TrafficMatchingCriteriaList](b, LtmManager, TrafficMatchingCriteriaEndpoint)}
 }
 
 // ListName all TrafficMatchingCriteria fullpath name
 func (tmcr *TrafficMatchingCriteriaResource) ListName() ([]string, error) {
+	return tmcr.ListNameContext(context.Background())
+}
+
+// ListNameContext is like ListName but uses ctx for the request.
+func (tmcr *TrafficMatchingCriteriaResource) ListNameContext(ctx context.Context) ([]string, error) {
 	items := &TrafficMatchingCriteriaList{}
-	items, err := tmcr.List()
+	items, err := tmcr.ListContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -85,57 +89,3 @@ func (tmcr *TrafficMatchingCriteriaResource) ListName() ([]string, error) {
 
 	return names, nil
 }
-
-// Get a single TrafficMatchingCriteria details by the TrafficMatchingCriteria name
-func (tmcr *TrafficMatchingCriteriaResource) Get(fullPathName string) (*TrafficMatchingCriteria, error) {
-	var node TrafficMatchingCriteria
-	res, err := tmcr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(TrafficMatchingCriteriaEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &node); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &node, nil
-}
-
-// Create a new TrafficMatchingCriteria item
-func (tmcr *TrafficMatchingCriteriaResource) Create(item TrafficMatchingCriteria) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = tmcr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(TrafficMatchingCriteriaEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Update the TrafficMatchingCriteria item identified by the TrafficMatchingCriteria name, otherwise an error will be reported.
-func (tmcr *TrafficMatchingCriteriaResource) Update(fullPathName string, item TrafficMatchingCriteria) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = tmcr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(TrafficMatchingCriteriaEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single TrafficMatchingCriteria identified by the TrafficMatchingCriteria name. if it is not exist return error
-func (tmcr *TrafficMatchingCriteriaResource) Delete(fullPathName string) error {
-	_, err := tmcr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(TrafficMatchingCriteriaEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
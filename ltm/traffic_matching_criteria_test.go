@@ -4,17 +4,19 @@ import (
 	"testing"
 
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
 )
 
 func TestTrafficMatchingCriteriaResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.13.91", "admin", "MsTac@2001")
+	s := bigiptest.NewServer()
+	defer s.Close()
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
 
-	tcResource := &TrafficMatchingCriteriaResource{
-		b: bigIP,
-	}
+	tcResource := newTrafficMatchingCriteriaResource(bigIP)
 
 	// Create a TrafficMatchingCriteria
 	tc := TrafficMatchingCriteria{
@@ -27,12 +27,12 @@ type VirtualServer struct {
 	AddressStatus                    string                   `json:"addressStatus,omitempty"`
 	AutoLasthop                      string                   `json:"autoLasthop,omitempty"`
 	CmpEnabled                       string                   `json:"cmpEnabled,omitempty"`
-	ConnectionLimit                  int                      `json:"connectionLimit,omitempty"`
+	ConnectionLimit                  bigip.Opt[int]           `json:"connectionLimit,omitempty"`
 	CreationTime                     time.Time                `json:"creationTime,omitempty"`
-	Description                      string                   `json:"description,omitempty"`
+	Description                      bigip.Opt[string]        `json:"description,omitempty"`
 	Destination                      string                   `json:"destination,omitempty"`
-	Enabled                          bool                     `json:"enabled,omitempty"`
-	Disabled                         bool                     `json:"disabled,omitempty"`
+	Enabled                          bigip.Opt[bool]          `json:"enabled,omitempty"`
+	Disabled                         bigip.Opt[bool]          `json:"disabled,omitempty"`
 	EvictionProtected                string                   `json:"evictionProtected,omitempty"`
 	FallbackPersistence              string                   `json:"fallbackPersistence,omitempty"`
 	GtmScore                         int64                    `json:"gtmScore,omitempty"`
@@ -48,25 +48,25 @@ type VirtualServer struct {
 	RateLimitDstMask                 int64                    `json:"rateLimitDstMask,omitempty"`
 	RateLimitMode                    string                   `json:"rateLimitMode,omitempty"`
 	RateLimitSrcMask                 int64                    `json:"rateLimitSrcMask,omitempty"`
-	ReselectTries                    int64                    `json:"reselectTries,omitempty"`
+	ReselectTries                    bigip.Opt[int64]         `json:"reselectTries,omitempty"`
 	ServersslUseSni                  string                   `json:"serversslUseSni,omitempty"`
 	ServiceDownAction                string                   `json:"serviceDownAction,omitempty"`
 	ServiceDownImmediateAction       string                   `json:"serviceDownImmediateAction,omitempty"`
 	Source                           string                   `json:"source,omitempty"`
 	SourceAddressTranslation         SourceAddressTranslation `json:"sourceAddressTranslation,omitempty"`
 	SourcePort                       string                   `json:"sourcePort,omitempty"`
-	Rules                            []string                 `json:"rules,omitempty"`
-	SlowRampTime                     int                      `json:"slowRampTime,omitempty"`
+	Rules                            bigip.Opt[[]string]      `json:"rules,omitempty"`
+	SlowRampTime                     bigip.Opt[int]           `json:"slowRampTime,omitempty"`
 	SynCookieStatus                  string                   `json:"synCookieStatus,omitempty"`
 	TrafficMatchingCriteria          string                   `json:"trafficMatchingCriteria,omitempty"`
 	TrafficMatchingCriteriaReference struct {
 		Link string `json:"link,omitempty"`
 	} `json:"trafficMatchingCriteriaReference,omitempty"`
-	TranslateAddress string   `json:"translateAddress,omitempty"`
-	TranslatePort    string   `json:"translatePort,omitempty"`
-	Vlans            []string `json:"vlans,omitempty"`
-	VlansEnabled     bool     `json:"vlansEnabled,omitempty"`
-	VlansDisabled    bool     `json:"vlansDisabled,omitempty"`
+	TranslateAddress string              `json:"translateAddress,omitempty"`
+	TranslatePort    string              `json:"translatePort,omitempty"`
+	Vlans            bigip.Opt[[]string] `json:"vlans,omitempty"`
+	VlansEnabled     bigip.Opt[bool]     `json:"vlansEnabled,omitempty"`
+	VlansDisabled    bigip.Opt[bool]     `json:"vlansDisabled,omitempty"`
 	PoolReference    struct {
 		Link string `json:"link,omitempty"`
 	} `json:"poolReference,omitempty"`
@@ -80,6 +80,19 @@ type VirtualServer struct {
 		IsSubcollection bool      `json:"isSubcollection,omitempty"`
 		Profiles        []Profile `json:"items,omitempty"`
 	} `json:"profilesReference,omitempty"`
+	Extra bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes VirtualServer does not model in Extra.
+func (v *VirtualServer) UnmarshalJSON(data []byte) error {
+	type plain VirtualServer
+	return bigip.UnmarshalExtra(data, (*plain)(v), &v.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of VirtualServer.
+func (v VirtualServer) MarshalJSON() ([]byte, error) {
+	type plain VirtualServer
+	return bigip.MarshalExtra(plain(v), v.Extra)
 }
 
 type SourceAddressTranslation struct {
@@ -103,43 +116,31 @@ const VirtualEndpoint = "virtual"
 
 // VirtualResource provides an API to manage virtual server.
 type VirtualResource struct {
-	b *bigip.BigIP
+	bigip.Resource[VirtualServer, VirtualServerList]
 }
 
-// List all virtual server items
-func (vr *VirtualResource) List() (*VirtualServerList, error) {
-
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var vsl VirtualServerList
-	if err := json.Unmarshal(res, &vsl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &vsl, nil
+func newVirtualResource(b *bigip.BigIP) VirtualResource {
+	return VirtualResource{bigip.NewResource[VirtualServer, VirtualServerList](b, LtmManager, VirtualEndpoint)}
 }
 
 // List all the details of the virtual server, including: profile, policy, etc.
 func (vr *VirtualResource) ListDetail() (*VirtualServerList, error) {
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).SetParams("expandSubcollections", "true").DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+	return vr.ListDetailContext(context.Background())
+}
 
-	var vsl VirtualServerList
-	if err := json.Unmarshal(res, &vsl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &vsl, nil
+// ListDetailContext is like ListDetail but uses ctx for the request.
+func (vr *VirtualResource) ListDetailContext(ctx context.Context) (*VirtualServerList, error) {
+	return vr.ListContext(ctx, bigip.ListOptions{ExpandSubcollections: true})
 }
 
 // ListVirtualServerName get all virtual server names
 func (vr *VirtualResource) ListVirtualServerName() ([]string, error) {
-	vsl, err := vr.List()
+	return vr.ListVirtualServerNameContext(context.Background())
+}
+
+// ListVirtualServerNameContext is like ListVirtualServerName but uses ctx for the request.
+func (vr *VirtualResource) ListVirtualServerNameContext(ctx context.Context) ([]string, error) {
+	vsl, err := vr.ListContext(ctx, bigip.ListOptions{Select: []string{"fullPath"}})
 	if err != nil {
 		return nil, err
 	}
@@ -151,71 +152,21 @@ func (vr *VirtualResource) ListVirtualServerName() ([]string, error) {
 	return items, nil
 }
 
-// Get a single virtual server identified by name.
-func (vr *VirtualResource) Get(fullPathName string) (*VirtualServer, error) {
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var vs VirtualServer
-	if err := json.Unmarshal(res, &vs); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &vs, nil
-}
-
-// Create a new virtual server item
-func (vr *VirtualResource) Create(item VirtualServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Update the virtual server identified by the virtual server name
-func (vr *VirtualResource) Update(name string, item VirtualServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single virtual server identified by the virtual server name. if it is not exist return error
-func (vr *VirtualResource) Delete(name string) error {
-	_, err := vr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
 // Enabling a virtual server item identified by the virtual server name.
 func (vr *VirtualResource) Enable(name string) error {
-	item := VirtualServer{Enabled: true}
+	return vr.EnableContext(context.Background(), name)
+}
+
+// EnableContext is like Enable but uses ctx for the request.
+func (vr *VirtualResource) EnableContext(ctx context.Context, name string) error {
+	item := VirtualServer{Enabled: bigip.Some(true)}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+	_, err = vr.BigIP().RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(VirtualEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -224,15 +175,20 @@ func (vr *VirtualResource) Enable(name string) error {
 
 // Disabling a virtual server item identified by the virtual server name.
 func (vr *VirtualResource) Disable(name string) error {
-	item := VirtualServer{Disabled: true}
+	return vr.DisableContext(context.Background(), name)
+}
+
+// DisableContext is like Disable but uses ctx for the request.
+func (vr *VirtualResource) DisableContext(ctx context.Context, name string) error {
+	item := VirtualServer{Disabled: bigip.Some(true)}
 
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+	_, err = vr.BigIP().RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(VirtualEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -240,28 +196,39 @@ func (vr *VirtualResource) Disable(name string) error {
 }
 
 // removes a single iRule from the virtual server identified by virtual server name.
+// ruleName may be given with or without its partition. The rules of the virtual
+// server are read and the remaining ones written back, so a rule attached by
+// another client in between is lost.
 func (vr *VirtualResource) RemoveRuleForVirtualServer(vsName, ruleName string) error {
-	item := VirtualServer{
-		Rules: []string{
-			ruleName,
-		},
-	}
-	res, err := vr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(vsName).Body(item).DoRaw(context.Background())
+	return vr.RemoveRuleForVirtualServerContext(context.Background(), vsName, ruleName)
+}
+
+// RemoveRuleForVirtualServerContext is like RemoveRuleForVirtualServer but uses ctx for the request.
+func (vr *VirtualResource) RemoveRuleForVirtualServerContext(ctx context.Context, vsName, ruleName string) error {
+	vs, err := vr.GetContext(ctx, vsName)
 	if err != nil {
 		return err
 	}
-	var vs VirtualServer
-	if err := json.Unmarshal(res, &vs); err != nil {
-		panic(err)
+	// BIG-IP has no call to remove a single rule, so the remaining ones replace the
+	// rules of the virtual server.
+	rules := []string{}
+	for _, rule := range vs.Rules.Value() {
+		if rule != ruleName && !strings.HasSuffix(rule, "/"+ruleName) {
+			rules = append(rules, rule)
+		}
 	}
-	return nil
+	return vr.PatchContext(ctx, vsName, map[string]any{"rules": rules})
 }
 
 // gets the iRules for a virtual server identified by name.
 func (vr *VirtualResource) GetRulesByVirtualServer(name string) ([]Rule, error) {
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(name).DoRaw(context.Background())
+	return vr.GetRulesByVirtualServerContext(context.Background(), name)
+}
+
+// GetRulesByVirtualServerContext is like GetRulesByVirtualServer but uses ctx for the request.
+func (vr *VirtualResource) GetRulesByVirtualServerContext(ctx context.Context, name string) ([]Rule, error) {
+	res, err := vr.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(VirtualEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -275,13 +242,18 @@ func (vr *VirtualResource) GetRulesByVirtualServer(name string) ([]Rule, error)
 
 // adds an iRule to the virtual server identified by name.
 func (vr *VirtualResource) AddRuleForVirtualServer(vsName string, rule Rule) error {
+	return vr.AddRuleForVirtualServerContext(context.Background(), vsName, rule)
+}
+
+// AddRuleForVirtualServerContext is like AddRuleForVirtualServer but uses ctx for the request.
+func (vr *VirtualResource) AddRuleForVirtualServerContext(ctx context.Context, vsName string, rule Rule) error {
 	jsonData, err := json.Marshal(rule)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).SubResource(RuleEndpoint).ResourceInstance(vsName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+	_, err = vr.BigIP().RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(VirtualEndpoint).SubResource(RuleEndpoint).ResourceInstance(vsName).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -39,7 +39,20 @@ type VirtualAddress struct {
 	TrafficGroupReference struct {
 		Link string `json:"link,omitempty"`
 	} `json:"trafficGroupReference,omitempty"`
-	Unit int `json:"unit,omitempty"`
+	Unit  int         `json:"unit,omitempty"`
+	Extra bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes VirtualAddress does not model in Extra.
+func (v *VirtualAddress) UnmarshalJSON(data []byte) error {
+	type plain VirtualAddress
+	return bigip.UnmarshalExtra(data, (*plain)(v), &v.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of VirtualAddress.
+func (v VirtualAddress) MarshalJSON() ([]byte, error) {
+	type plain VirtualAddress
+	return bigip.MarshalExtra(plain(v), v.Extra)
 }
 
 // VirtualAddressEndpoint is the base path of the ltm API.
@@ -47,43 +60,23 @@ const VirtualAddressEndpoint = "virtual-address"
 
 // VirtualAddressResource is used to interact with the virtual address API.
 type VirtualAddressResource struct {
-	b *bigip.BigIP
+	bigip.Resource[VirtualAddress, VirtualAddressList]
 }
 
-// List retrieves the list of all virtual addresses configured on the BIG-IP.
-func (vars *VirtualAddressResource) List() (*VirtualAddressList, error) {
-	var val VirtualAddressList
-	res, err := vars.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &val); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &val, nil
-}
-
-// Get retrieves a single virtual address configuration identified by fullPathName.
-func (vars *VirtualAddressResource) Get(fullPathName string) (*VirtualAddress, error) {
-	var va VirtualAddress
-	res, err := vars.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &va); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &va, nil
+func newVirtualAddressResource(b *bigip.BigIP) VirtualAddressResource {
+	return VirtualAddressResource{bigip.NewResource[VirtualAddress, VirtualAddressList](b, LtmManager, VirtualAddressEndpoint)}
 }
 
 // GetAddressByVirtualServerName retrieves the IP address for a given virtual server identified by fullPathName.
 func (vars *VirtualAddressResource) GetAddressByVirtualServerName(fullPathName string) (string, error) {
+	return vars.GetAddressByVirtualServerNameContext(context.Background(), fullPathName)
+}
+
+// GetAddressByVirtualServerNameContext is like GetAddressByVirtualServerName but uses ctx for the request.
+func (vars *VirtualAddressResource) GetAddressByVirtualServerNameContext(ctx context.Context, fullPathName string) (string, error) {
 	var va VirtualAddress
-	res, err := vars.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
+	res, err := vars.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(VirtualAddressEndpoint).ResourceInstance(fullPathName).DoRaw(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -93,41 +86,21 @@ func (vars *VirtualAddressResource) GetAddressByVirtualServerName(fullPathName s
 	return va.Address, nil
 }
 
-// Update modifies a virtual address configuration identified by name with the given VirtualAddress object.
-func (vr *VirtualAddressResource) Update(name string, item VirtualAddress) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single virtual address identified by the virtual address name. if it is not exist return error
-func (vr *VirtualAddressResource) Delete(name string) error {
-	_, err := vr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
 // Enabling a virtual address item identified by the virtual address.
 func (vr *VirtualAddressResource) Enable(name string) error {
+	return vr.EnableContext(context.Background(), name)
+}
+
+// EnableContext is like Enable but uses ctx for the request.
+func (vr *VirtualAddressResource) EnableContext(ctx context.Context, name string) error {
 	item := VirtualAddress{Enabled: "yes"}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+	_, err = vr.BigIP().RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(VirtualAddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -136,6 +109,11 @@ func (vr *VirtualAddressResource) Enable(name string) error {
 
 // Disabling a virtual address item identified by the virtual address.
 func (vr *VirtualAddressResource) Disable(name string) error {
+	return vr.DisableContext(context.Background(), name)
+}
+
+// DisableContext is like Disable but uses ctx for the request.
+func (vr *VirtualAddressResource) DisableContext(ctx context.Context, name string) error {
 	item := VirtualAddress{Enabled: "no"}
 
 	jsonData, err := json.Marshal(item)
@@ -143,8 +121,8 @@ func (vr *VirtualAddressResource) Disable(name string) error {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+	_, err = vr.BigIP().RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(VirtualAddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
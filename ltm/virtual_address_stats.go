@@ -48,8 +48,13 @@ type VirtualAddressStatsResource struct {
 }
 
 func (vasr *VirtualAddressStatsResource) List() (*VirtualAddressStatsList, error) {
+	return vasr.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (vasr *VirtualAddressStatsResource) ListContext(ctx context.Context) (*VirtualAddressStatsList, error) {
 	res, err := vasr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(VirtualAddressEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +67,13 @@ func (vasr *VirtualAddressStatsResource) List() (*VirtualAddressStatsList, error
 }
 
 func (vasr *VirtualAddressStatsResource) Get(name string) (*VirtualAddressStatsList, error) {
+	return vasr.GetContext(context.Background(), name)
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (vasr *VirtualAddressStatsResource) GetContext(ctx context.Context, name string) (*VirtualAddressStatsList, error) {
 	res, err := vasr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(VirtualAddressEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -158,8 +158,13 @@ type VirtualStatsResource struct {
 }
 
 func (vsr *VirtualStatsResource) List() (*VirtualStatsList, error) {
+	return vsr.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (vsr *VirtualStatsResource) ListContext(ctx context.Context) (*VirtualStatsList, error) {
 	res, err := vsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(VirtualEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -172,8 +177,13 @@ func (vsr *VirtualStatsResource) List() (*VirtualStatsList, error) {
 }
 
 func (vsr *VirtualStatsResource) Get(name string) (*VirtualStatsList, error) {
+	return vsr.GetContext(context.Background(), name)
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (vsr *VirtualStatsResource) GetContext(ctx context.Context, name string) (*VirtualStatsList, error) {
 	res, err := vsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(VirtualEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
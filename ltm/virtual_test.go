@@ -1,19 +1,25 @@
 package ltm
 
 import (
+	"bytes"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"io"
+	"net/http"
+	"reflect"
 	"testing"
 )
 
 func TestVirtualResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	s := bigiptest.NewServer()
+	defer s.Close()
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
 
-	vResource := &VirtualResource{
-		b: bigIP,
-	}
+	vResource := newVirtualResource(bigIP)
 
 	// Create a VirtualServer
 	vs := VirtualServer{
@@ -41,7 +47,7 @@ func TestVirtualResource(t *testing.T) {
 	}
 
 	// Update properties of the VirtualServer
-	vsCheck.Description = "Test VirtualServer"
+	vsCheck.Description = bigip.Some("Test VirtualServer")
 	if err := vResource.Update(vsCheck.FullPath, *vsCheck); err != nil {
 		t.Fatalf("Error updating VirtualServer: %v", err)
 	}
@@ -51,7 +57,7 @@ func TestVirtualResource(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Error getting updated VirtualServer: %v", err)
 	}
-	if vsUpdated.Description != "Test VirtualServer" {
+	if vsUpdated.Description.Value() != "Test VirtualServer" {
 		t.Error("Failed to update Description of VirtualServer")
 	}
 
@@ -75,3 +81,64 @@ func TestVirtualResource(t *testing.T) {
 		t.Error("VirtualServer still exists after attempting deletion")
 	}
 }
+
+// patchRecorder records the bodies of the PATCH requests made through rt.
+type patchRecorder struct {
+	rt     http.RoundTripper
+	bodies []string
+}
+
+func (r *patchRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPatch {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		r.bodies = append(r.bodies, string(body))
+	}
+	return r.rt.RoundTrip(req)
+}
+
+func TestRemoveRuleForVirtualServer(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+	s.Add("ltm/virtual", map[string]any{"name": "vs1", "partition": "Common", "rules": []string{"/Common/r1", "/Common/r2"}})
+
+	recorder := &patchRecorder{}
+	bigIP, err := bigip.New(s.URL, bigip.WithBasicAuth(s.Username, s.Password),
+		bigip.WithWrapTransport(func(rt http.RoundTripper) http.RoundTripper {
+			recorder.rt = rt
+			return recorder
+		}))
+	if err != nil {
+		t.Fatalf("connect to bigip failed: %v", err)
+	}
+	vResource := newVirtualResource(bigIP)
+
+	for _, tt := range []struct {
+		rule     string
+		expected string
+	}{
+		{"r1", `{"rules":["/Common/r2"]}`},
+		{"/Common/r3", `{"rules":["/Common/r2"]}`},
+		{"/Common/r2", `{"rules":[]}`},
+	} {
+		recorder.bodies = nil
+		if err := vResource.RemoveRuleForVirtualServer("/Common/vs1", tt.rule); err != nil {
+			t.Fatalf("removing %s: %v", tt.rule, err)
+		}
+		if len(recorder.bodies) != 1 || recorder.bodies[0] != tt.expected {
+			t.Errorf("removing %s: expected the PATCH body %s, got %v", tt.rule, tt.expected, recorder.bodies)
+		}
+	}
+	if obj, _ := s.Object("ltm/virtual/~Common~vs1"); !reflect.DeepEqual(obj["rules"], []any{}) {
+		t.Errorf("expected no rules left, got %v", obj["rules"])
+	}
+
+	// The first request is the one of bigip.New checking the credentials.
+	expected := []string{"GET /mgmt/tm/ltm/virtual/~Common~vs1", "PATCH /mgmt/tm/ltm/virtual/~Common~vs1"}
+	if requests := s.Requests(); !reflect.DeepEqual(requests[1:3], expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
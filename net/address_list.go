@@ -1,11 +1,7 @@
 package net
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // AddressList contains a list of Address.
@@ -26,80 +22,27 @@ type Address struct {
 	Addresses  []struct {
 		Name string `json:"name,omitempty"`
 	} `json:"addresses,omitempty"`
+	Extra bigip.Extra `json:"-"`
 }
 
-const AddressEndpoint = "address-list"
-
-type AddressResource struct {
-	b *bigip.BigIP
-}
-
-// List lists all the address configurations.
-func (ar *AddressResource) List() (*AddressList, error) {
-	var al AddressList
-	res, err := ar.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(AddressEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &al); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &al, nil
+// UnmarshalJSON keeps the attributes Address does not model in Extra.
+func (a *Address) UnmarshalJSON(data []byte) error {
+	type plain Address
+	return bigip.UnmarshalExtra(data, (*plain)(a), &a.Extra)
 }
 
-// Get a single address configuration identified by fullPathName.
-func (ar *AddressResource) Get(fullPathName string) (*Address, error) {
-	var address Address
-	res, err := ar.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(AddressEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &address); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &address, nil
+// MarshalJSON adds the attributes in Extra to those of Address.
+func (a Address) MarshalJSON() ([]byte, error) {
+	type plain Address
+	return bigip.MarshalExtra(plain(a), a.Extra)
 }
 
-// Create a new address configuration.
-func (ar *AddressResource) Create(item Address) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = ar.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(AddressEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+const AddressEndpoint = "address-list"
 
-// Edit an address configuration identified by name.
-func (ar *AddressResource) Update(name string, item Address) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = ar.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(AddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+type AddressResource struct {
+	bigip.Resource[Address, AddressList]
 }
 
-// Delete a single address configuration identified by name.
-func (ar *AddressResource) Delete(name string) error {
-	_, err := ar.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(AddressEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newAddressResource(b *bigip.BigIP) AddressResource {
+	return AddressResource{bigip.NewResource[Address, AddressList](b, NetManager, AddressEndpoint)}
 }
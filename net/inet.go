@@ -40,10 +40,23 @@ type Interface struct {
 		PollInterval       int    `json:"pollInterval,omitempty"`
 		PollIntervalGlobal string `json:"pollIntervalGlobal,omitempty"`
 	} `json:"sflow,omitempty"`
-	Stp             string `json:"stp,omitempty"`
-	StpAutoEdgePort string `json:"stpAutoEdgePort,omitempty"`
-	StpEdgePort     string `json:"stpEdgePort,omitempty"`
-	StpLinkType     string `json:"stpLinkType,omitempty"`
+	Stp             string      `json:"stp,omitempty"`
+	StpAutoEdgePort string      `json:"stpAutoEdgePort,omitempty"`
+	StpEdgePort     string      `json:"stpEdgePort,omitempty"`
+	StpLinkType     string      `json:"stpLinkType,omitempty"`
+	Extra           bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Interface does not model in Extra.
+func (i *Interface) UnmarshalJSON(data []byte) error {
+	type plain Interface
+	return bigip.UnmarshalExtra(data, (*plain)(i), &i.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Interface.
+func (i Interface) MarshalJSON() ([]byte, error) {
+	type plain Interface
+	return bigip.MarshalExtra(plain(i), i.Extra)
 }
 
 // InterfaceEndpoint represents the REST resource for managing interfaces.
@@ -51,40 +64,22 @@ const InterfaceEndpoint = "interface"
 
 // A InetResource provides an API to manage Interface object.
 type InetResource struct {
-	b *bigip.BigIP
+	bigip.Resource[Interface, InterfaceList]
 }
 
-// ListAll lists all interfaces uration.
-func (ir *InetResource) List() (*InterfaceList, error) {
-	var items InterfaceList
-	res, err := ir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).Resource(InterfaceEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func newInetResource(b *bigip.BigIP) InetResource {
+	return InetResource{bigip.NewResource[Interface, InterfaceList](b, NetManager, InterfaceEndpoint)}
 }
 
-// Get a single interface uration identified by id.
-func (ir *InetResource) Get(fullPathName string) (*Interface, error) {
-	var item Interface
-	res, err := ir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(InterfaceEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (ir *InetResource) ShowStats(fullPathName string) (*InterfaceStatsList, error) {
+	return ir.ShowStatsContext(context.Background(), fullPathName)
 }
 
-func (ir *InetResource) ShowStats(fullPathName string) (*InterfaceStatsList, error) {
+// ShowStatsContext is like ShowStats but uses ctx for the request.
+func (ir *InetResource) ShowStatsContext(ctx context.Context, fullPathName string) (*InterfaceStatsList, error) {
 	var item InterfaceStatsList
-	res, err := ir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(InterfaceEndpoint).SubResourceInstance(fullPathName).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+	res, err := ir.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
+		Resource(InterfaceEndpoint).SubResourceInstance(fullPathName).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +59,13 @@ type InetStatsResource struct {
 }
 
 func (isr *InetStatsResource) List() (*InterfaceStatsList, error) {
+	return isr.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (isr *InetStatsResource) ListContext(ctx context.Context) (*InterfaceStatsList, error) {
 	var item InterfaceStatsList
-	res, err := isr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).Resource(InterfaceStatsEndpoint).SubResource(StatsEndpoint).DoRaw(context.Background())
+	res, err := isr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).Resource(InterfaceStatsEndpoint).SubResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
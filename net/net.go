@@ -21,15 +21,15 @@ type Net struct {
 // New creates a new NET client.
 func New(b *bigip.BigIP) Net {
 	return Net{
-		inet:        InetResource{b: b},
+		inet:        newInetResource(b),
 		inetStats:   InetStatsResource{b: b},
-		route:       RouteResource{b: b},
-		vlan:        VlanResource{b: b},
-		self:        SelfResource{b: b},
-		routeDomain: RouteDomainResource{b: b},
-		trunk:       TrunkResource{b: b},
-		address:     AddressResource{b: b},
-		port:        PortResource{b: b},
+		route:       newRouteResource(b),
+		vlan:        newVlanResource(b),
+		self:        newSelfResource(b),
+		routeDomain: newRouteDomainResource(b),
+		trunk:       newTrunkResource(b),
+		address:     newAddressResource(b),
+		port:        newPortResource(b),
 	}
 }
 
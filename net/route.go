@@ -1,11 +1,7 @@
 package net
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // A RouteList holds a list of Route.
@@ -17,89 +13,39 @@ type RouteList struct {
 
 // A Route hold the uration for a route.
 type Route struct {
-	FullPath   string `json:"fullPath,omitempty"`
-	Generation int    `json:"generation,omitempty"`
-	Gw         string `json:"gw,omitempty"`
-	Kind       string `json:"kind,omitempty"`
-	Mtu        int    `json:"mtu,omitempty"`
-	Name       string `json:"name,omitempty"`
-	Network    string `json:"network,omitempty"`
-	Partition  string `json:"partition,omitempty"`
-	SelfLink   string `json:"selfLink,omitempty"`
+	FullPath    string            `json:"fullPath,omitempty"`
+	Description bigip.Opt[string] `json:"description,omitempty"`
+	Generation  int               `json:"generation,omitempty"`
+	Gw          string            `json:"gw,omitempty"`
+	Kind        string            `json:"kind,omitempty"`
+	Mtu         bigip.Opt[int]    `json:"mtu,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Network     string            `json:"network,omitempty"`
+	Partition   string            `json:"partition,omitempty"`
+	SelfLink    string            `json:"selfLink,omitempty"`
+	Extra       bigip.Extra       `json:"-"`
 }
 
-// RouteEndpoint represents the REST resource for managing a route.
-const RouteEndpoint = "route"
-
-// A RouteResource provides API to manage routes uration.
-type RouteResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes Route does not model in Extra.
+func (r *Route) UnmarshalJSON(data []byte) error {
+	type plain Route
+	return bigip.UnmarshalExtra(data, (*plain)(r), &r.Extra)
 }
 
-// List lists all the route urations.
-func (rr *RouteResource) List() (*RouteList, error) {
-	var rl RouteList
-	res, err := rr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).Resource(RouteEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &rl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &rl, nil
+// MarshalJSON adds the attributes in Extra to those of Route.
+func (r Route) MarshalJSON() ([]byte, error) {
+	type plain Route
+	return bigip.MarshalExtra(plain(r), r.Extra)
 }
 
-// Get a single route uration identified by id.
-func (rr *RouteResource) Get(fullPathName string) (*Route, error) {
-	res, err := rr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var route Route
-	if err := json.Unmarshal(res, &route); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &route, nil
-}
-
-// Create a new route uration.
-func (rr *RouteResource) Create(item Route) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = rr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// RouteEndpoint represents the REST resource for managing a route.
+const RouteEndpoint = "route"
 
-// Edit a route uration identified by id.
-func (rr *RouteResource) Update(name string, item Route) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = rr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// A RouteResource provides API to manage routes uration.
+type RouteResource struct {
+	bigip.Resource[Route, RouteList]
 }
 
-// Delete a single route uration identified by id.
-func (rr *RouteResource) Delete(name string) error {
-	_, err := rr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newRouteResource(b *bigip.BigIP) RouteResource {
+	return RouteResource{bigip.NewResource[Route, RouteList](b, NetManager, RouteEndpoint)}
 }
@@ -1,11 +1,7 @@
 package net
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // A RouteDomainList includes a list of RouteDomain.
@@ -32,6 +28,19 @@ type RouteDomain struct {
 	VlansReference     []struct {
 		Link string `json:"link,omitempty"`
 	} `json:"vlansReference,omitempty"`
+	Extra bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes RouteDomain does not model in Extra.
+func (r *RouteDomain) UnmarshalJSON(data []byte) error {
+	type plain RouteDomain
+	return bigip.UnmarshalExtra(data, (*plain)(r), &r.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of RouteDomain.
+func (r RouteDomain) MarshalJSON() ([]byte, error) {
+	type plain RouteDomain
+	return bigip.MarshalExtra(plain(r), r.Extra)
 }
 
 // RouteDomainEndpoint represents the REST resource for managing a route domain.
@@ -39,72 +48,16 @@ const RouteDomainEndpoint = "route-domain"
 
 // A RouteDomainResource provides API to manage route domain uration.
 type RouteDomainResource struct {
-	b *bigip.BigIP
-}
-
-// List lists all the route domain urations.
-func (rdr *RouteDomainResource) List() (*RouteDomainList, error) {
-	var items RouteDomainList
-	res, err := rdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).Resource(RouteDomainEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
-}
-
-// Get a single route domain uration identified by name.
-func (rdr *RouteDomainResource) Get(fullPathName string) (*RouteDomain, error) {
-	var item RouteDomain
-	res, err := rdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteDomainEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+	bigip.Resource[RouteDomain, RouteDomainList]
 }
 
-// Create a new route domain uration.
-func (rdr *RouteDomainResource) Create(item RouteDomain) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = rdr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteDomainEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newRouteDomainResource(b *bigip.BigIP) RouteDomainResource {
+	return RouteDomainResource{bigip.NewResource[RouteDomain, RouteDomainList](b, NetManager, RouteDomainEndpoint)}
 }
 
-// Update a route domain uration identified by name.
+// Edit modifies the configuration identified by name.
+//
+// Deprecated: use Update.
 func (rdr *RouteDomainResource) Edit(fullPathName string, item RouteDomain) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = rdr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteDomainEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single route domain uration identified by name.
-func (rdr *RouteDomainResource) Delete(fullPathName string) error {
-	_, err := rdr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteDomainEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+	return rdr.Update(fullPathName, item)
 }
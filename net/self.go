@@ -1,11 +1,7 @@
 package net
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // A SelfList holds a list of Self.
@@ -17,16 +13,17 @@ type SelfList struct {
 
 // A Self hold the uration for a Self IP.
 type Self struct {
-	Address               string `json:"address,omitempty"`
-	AddressSource         string `json:"addressSource,omitempty"`
-	Floating              string `json:"floating,omitempty"`
-	FullPath              string `json:"fullPath,omitempty"`
-	Generation            int    `json:"generation,omitempty"`
-	InheritedTrafficGroup string `json:"inheritedTrafficGroup,omitempty"`
-	Kind                  string `json:"kind,omitempty"`
-	Name                  string `json:"name,omitempty"`
-	SelfLink              string `json:"selfLink,omitempty"`
-	TrafficGroup          string `json:"trafficGroup,omitempty"`
+	Address               string            `json:"address,omitempty"`
+	AddressSource         string            `json:"addressSource,omitempty"`
+	Description           bigip.Opt[string] `json:"description,omitempty"`
+	Floating              string            `json:"floating,omitempty"`
+	FullPath              string            `json:"fullPath,omitempty"`
+	Generation            int               `json:"generation,omitempty"`
+	InheritedTrafficGroup string            `json:"inheritedTrafficGroup,omitempty"`
+	Kind                  string            `json:"kind,omitempty"`
+	Name                  string            `json:"name,omitempty"`
+	SelfLink              string            `json:"selfLink,omitempty"`
+	TrafficGroup          string            `json:"trafficGroup,omitempty"`
 	TrafficGroupReference struct {
 		Link string `json:"link,omitempty"`
 	} `json:"trafficGroupReference,omitempty"`
@@ -35,82 +32,36 @@ type Self struct {
 	VlanReference struct {
 		Link string `json:"link,omitempty"`
 	} `json:"vlanReference,omitempty"`
+	Extra bigip.Extra `json:"-"`
 }
 
-// SelfEndpoint represents the REST resource for managing a self IP.
-const SelfEndpoint = "self"
-
-// A SelfResource provides API to manage self ip uration.
-type SelfResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes Self does not model in Extra.
+func (s *Self) UnmarshalJSON(data []byte) error {
+	type plain Self
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
 }
 
-// ListAll lists all the self ip urations.
-func (sr *SelfResource) List() (*SelfList, error) {
-	var sl SelfList
-	res, err := sr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(SelfEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &sl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &sl, nil
+// MarshalJSON adds the attributes in Extra to those of Self.
+func (s Self) MarshalJSON() ([]byte, error) {
+	type plain Self
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
-// Get a single self ip uration identified by id.
-func (sr *SelfResource) Get(fullPathName string) (*Self, error) {
-	var self Self
-	res, err := sr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(SelfEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// SelfEndpoint represents the REST resource for managing a self IP.
+const SelfEndpoint = "self"
 
-	if err := json.Unmarshal(res, &self); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &self, nil
+// A SelfResource provides API to manage self ip uration.
+type SelfResource struct {
+	bigip.Resource[Self, SelfList]
 }
 
-// Create a new self ip uration.
-func (sr *SelfResource) Create(item Self) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = sr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(SelfEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newSelfResource(b *bigip.BigIP) SelfResource {
+	return SelfResource{bigip.NewResource[Self, SelfList](b, NetManager, SelfEndpoint)}
 }
 
-// Edit a self ip uration identified by id.
+// Edit modifies the configuration identified by name.
+//
+// Deprecated: use Update.
 func (sr *SelfResource) Edit(name string, item Self) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = sr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(SelfEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single self ip uration identified by id.
-func (sr *SelfResource) Delete(name string) error {
-	_, err := sr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(SelfEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+	return sr.Update(name, item)
 }
@@ -17,33 +17,47 @@ type VlanList struct {
 
 // A Vlan hold the uration for a vlan.
 type Vlan struct {
-	AutoLasthop         string `json:"autoLasthop,omitempty"`
-	CmpHash             string `json:"cmpHash,omitempty"`
-	DagRoundRobin       string `json:"dagRoundRobin,omitempty"`
-	DagTunnel           string `json:"dagTunnel,omitempty"`
-	Failsafe            string `json:"failsafe,omitempty"`
-	FailsafeAction      string `json:"failsafeAction,omitempty"`
-	FailsafeTimeout     int    `json:"failsafeTimeout,omitempty"`
-	FullPath            string `json:"fullPath,omitempty"`
-	Generation          int    `json:"generation,omitempty"`
-	IfIndex             int    `json:"ifIndex,omitempty"`
+	AutoLasthop         string            `json:"autoLasthop,omitempty"`
+	CmpHash             string            `json:"cmpHash,omitempty"`
+	Description         bigip.Opt[string] `json:"description,omitempty"`
+	DagRoundRobin       string            `json:"dagRoundRobin,omitempty"`
+	DagTunnel           string            `json:"dagTunnel,omitempty"`
+	Failsafe            string            `json:"failsafe,omitempty"`
+	FailsafeAction      string            `json:"failsafeAction,omitempty"`
+	FailsafeTimeout     bigip.Opt[int]    `json:"failsafeTimeout,omitempty"`
+	FullPath            string            `json:"fullPath,omitempty"`
+	Generation          int               `json:"generation,omitempty"`
+	IfIndex             int               `json:"ifIndex,omitempty"`
 	InterfacesReference struct {
 		IsSubcollection bool   `json:"isSubcollection,omitempty"`
 		Link            string `json:"link,omitempty"`
 	} `json:"interfacesReference,omitempty"`
-	Kind     string `json:"kind,omitempty"`
-	Learning string `json:"learning,omitempty"`
-	Mtu      int    `json:"mtu,omitempty"`
-	Name     string `json:"name,omitempty"`
-	SelfLink string `json:"selfLink,omitempty"`
+	Kind     string         `json:"kind,omitempty"`
+	Learning string         `json:"learning,omitempty"`
+	Mtu      bigip.Opt[int] `json:"mtu,omitempty"`
+	Name     string         `json:"name,omitempty"`
+	SelfLink string         `json:"selfLink,omitempty"`
 	Sflow    struct {
 		PollInterval       int    `json:"pollInterval,omitempty"`
 		PollIntervalGlobal string `json:"pollIntervalGlobal,omitempty"`
 		SamplingRate       int    `json:"samplingRate,omitempty"`
 		SamplingRateGlobal string `json:"samplingRateGlobal,omitempty"`
 	} `json:"sflow,omitempty"`
-	SourceChecking string `json:"sourceChecking,omitempty"`
-	Tag            int    `json:"tag,omitempty"`
+	SourceChecking string         `json:"sourceChecking,omitempty"`
+	Tag            bigip.Opt[int] `json:"tag,omitempty"`
+	Extra          bigip.Extra    `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Vlan does not model in Extra.
+func (v *Vlan) UnmarshalJSON(data []byte) error {
+	type plain Vlan
+	return bigip.UnmarshalExtra(data, (*plain)(v), &v.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Vlan.
+func (v Vlan) MarshalJSON() ([]byte, error) {
+	type plain Vlan
+	return bigip.MarshalExtra(plain(v), v.Extra)
 }
 
 type AssignedInterfaceList struct {
@@ -53,13 +67,26 @@ type AssignedInterfaceList struct {
 }
 
 type AssignedInterface struct {
-	FullPath   string `json:"fullPath,omitempty"`
-	Generation int    `json:"generation,omitempty"`
-	Kind       string `json:"kind,omitempty"`
-	Name       string `json:"name,omitempty"`
-	SelfLink   string `json:"selfLink,omitempty"`
-	TagMode    string `json:"tagMode,omitempty"`
-	Tagged     bool   `json:"tagged,omitempty"`
+	FullPath   string      `json:"fullPath,omitempty"`
+	Generation int         `json:"generation,omitempty"`
+	Kind       string      `json:"kind,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	SelfLink   string      `json:"selfLink,omitempty"`
+	TagMode    string      `json:"tagMode,omitempty"`
+	Tagged     bool        `json:"tagged,omitempty"`
+	Extra      bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes AssignedInterface does not model in Extra.
+func (a *AssignedInterface) UnmarshalJSON(data []byte) error {
+	type plain AssignedInterface
+	return bigip.UnmarshalExtra(data, (*plain)(a), &a.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of AssignedInterface.
+func (a AssignedInterface) MarshalJSON() ([]byte, error) {
+	type plain AssignedInterface
+	return bigip.MarshalExtra(plain(a), a.Extra)
 }
 
 // VlanEndpoint represents the REST resource for managing a vlan.
@@ -69,87 +96,26 @@ const VlanInterfacesEndpoint = "interfaces"
 
 // A VlanResource provides API to manage vlan uration.
 type VlanResource struct {
-	b *bigip.BigIP
+	bigip.Resource[Vlan, VlanList]
 }
 
-// ListAll lists all the vlan urations.
-func (vr *VlanResource) List() (*VlanList, error) {
-	var vl VlanList
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &vl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &vl, nil
-}
-
-// Get a single vlan uration identified by name.
-func (vr *VlanResource) Get(fullPathName string) (*Vlan, error) {
-	var vlan Vlan
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &vlan); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &vlan, nil
-}
-
-// Create a new vlan uration.
-func (vr *VlanResource) Create(item Vlan) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Edit a vlan uration identified by id.
-func (vr *VlanResource) Update(name string, item Vlan) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// Delete a single vlan uration identified by id.
-func (vr *VlanResource) Delete(name string) error {
-	_, err := vr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newVlanResource(b *bigip.BigIP) VlanResource {
+	return VlanResource{bigip.NewResource[Vlan, VlanList](b, NetManager, VlanEndpoint)}
 }
 
 // GetInterfaces gets all interfaces associated to the vlan identified by id.
 func (vr *VlanResource) GetVlanAssociatedInterfaces(name string) (*AssignedInterfaceList, error) {
+	return vr.GetVlanAssociatedInterfacesContext(context.Background(), name)
+}
+
+// GetVlanAssociatedInterfacesContext is like GetVlanAssociatedInterfaces but uses ctx for the request.
+func (vr *VlanResource) GetVlanAssociatedInterfacesContext(ctx context.Context, name string) (*AssignedInterfaceList, error) {
 	var ail AssignedInterfaceList
 	//if err := vr.c.ReadQuery(bigip.GetBaseResource()+VlanEndpoint+"/"+id+"/interfaces", &list); err != nil {
 	//	return nil, err
 	//}
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).ResourceInstance(name).SubResource(VlanInterfacesEndpoint).DoRaw(context.Background())
+	res, err := vr.BigIP().RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
+		Resource(VlanEndpoint).ResourceInstance(name).SubResource(VlanInterfacesEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -162,13 +128,18 @@ func (vr *VlanResource) GetVlanAssociatedInterfaces(name string) (*AssignedInter
 
 // Edit a vlan uration identified by id.
 func (vr *VlanResource) AddInterfaceForVlan(name string, item AssignedInterface) error {
+	return vr.AddInterfaceForVlanContext(context.Background(), name, item)
+}
+
+// AddInterfaceForVlanContext is like AddInterfaceForVlan but uses ctx for the request.
+func (vr *VlanResource) AddInterfaceForVlanContext(ctx context.Context, name string, item AssignedInterface) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).ResourceInstance(name).SubResource(VlanInterfacesEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+	_, err = vr.BigIP().RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
+		Resource(VlanEndpoint).ResourceInstance(name).SubResource(VlanInterfacesEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,98 @@
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Opt is an optional attribute of a resource that tells apart being unset from
+// being set to its zero value. Unlike a plain field with omitempty, an Opt can send
+// 0, false, an empty string or an empty list, and an explicit null:
+//
+//	item := ltm.Pool{
+//		MinActiveMembers: bigip.Some[int64](0),
+//		Description:      bigip.Some(""),
+//	}
+//
+// The zero value is unset. Resource structs marshal through MarshalExtra, which
+// omits unset attributes of type Opt that have an omitempty tag; other structs
+// send them as null. When read from a response, an attribute the device did not
+// return is unset and one it returned as null is null.
+type Opt[T any] struct {
+	v     T
+	state optState
+}
+
+// optState tells whether an Opt is unset, null or set to a value.
+type optState uint8
+
+const (
+	optUnset optState = iota
+	optNull
+	optSome
+)
+
+// Some returns an Opt set to v.
+func Some[T any](v T) Opt[T] {
+	return Opt[T]{v: v, state: optSome}
+}
+
+// Null returns an Opt set to null.
+func Null[T any]() Opt[T] {
+	return Opt[T]{state: optNull}
+}
+
+// Get returns the value of o and whether it is set to a value.
+func (o Opt[T]) Get() (T, bool) {
+	return o.v, o.state == optSome
+}
+
+// Value returns the value of o, or the zero value of T if it is unset or null.
+func (o Opt[T]) Value() T {
+	return o.v
+}
+
+// IsSet reports whether o is set, to a value or to null.
+func (o Opt[T]) IsSet() bool {
+	return o.state != optUnset
+}
+
+// IsNull reports whether o is set to null.
+func (o Opt[T]) IsNull() bool {
+	return o.state == optNull
+}
+
+// isUnset lets MarshalExtra find unset Opts whatever their type parameter.
+func (o Opt[T]) isUnset() bool {
+	return o.state == optUnset
+}
+
+// String returns the value of o formatted with %v, or "null".
+func (o Opt[T]) String() string {
+	if v, ok := o.Get(); ok {
+		return fmt.Sprint(v)
+	}
+	return "null"
+}
+
+// MarshalJSON marshals o as its value, or as null if it is null or unset.
+func (o Opt[T]) MarshalJSON() ([]byte, error) {
+	if v, ok := o.Get(); ok {
+		return json.Marshal(v)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets o to the value in data, or to null.
+func (o *Opt[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
@@ -0,0 +1,100 @@
+package bigip
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type optItem struct {
+	Name             string        `json:"name,omitempty"`
+	Description      Opt[string]   `json:"description,omitempty"`
+	MinActiveMembers Opt[int64]    `json:"minActiveMembers,omitempty"`
+	Enabled          Opt[bool]     `json:"enabled,omitempty"`
+	Rules            Opt[[]string] `json:"rules,omitempty"`
+	Extra            Extra         `json:"-"`
+}
+
+func (i *optItem) UnmarshalJSON(data []byte) error {
+	type plain optItem
+	return UnmarshalExtra(data, (*plain)(i), &i.Extra)
+}
+
+func (i optItem) MarshalJSON() ([]byte, error) {
+	type plain optItem
+	return MarshalExtra(plain(i), i.Extra)
+}
+
+func TestOptMarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     optItem
+		expected string
+	}{
+		{name: "unset", item: optItem{Name: "p1"}, expected: `{"name":"p1"}`},
+		{name: "zero values", item: optItem{Description: Some(""), MinActiveMembers: Some[int64](0), Enabled: Some(false), Rules: Some([]string{})},
+			expected: `{"description":"","minActiveMembers":0,"enabled":false,"rules":[]}`},
+		{name: "values", item: optItem{MinActiveMembers: Some[int64](2), Rules: Some([]string{"/Common/r1"})},
+			expected: `{"minActiveMembers":2,"rules":["/Common/r1"]}`},
+		{name: "null", item: optItem{Description: Null[string]()}, expected: `{"description":null}`},
+		{name: "extra", item: optItem{Name: "p1", Extra: Extra{"monitor": json.RawMessage(`"/Common/http"`)}},
+			expected: `{"name":"p1","monitor":"/Common/http"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.item)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+}
+
+func TestOptUnmarshal(t *testing.T) {
+	var item optItem
+	if err := json.Unmarshal([]byte(`{"name":"p1","description":null,"minActiveMembers":0,"rules":["/Common/r1"]}`), &item); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if item.Enabled.IsSet() {
+		t.Errorf("expected enabled to be unset, got %v", item.Enabled)
+	}
+	if !item.Description.IsSet() || !item.Description.IsNull() {
+		t.Errorf("expected description to be null, got %v", item.Description)
+	}
+	if v, ok := item.MinActiveMembers.Get(); !ok || v != 0 {
+		t.Errorf("expected minActiveMembers to be 0, got %v", item.MinActiveMembers)
+	}
+	if rules := item.Rules.Value(); len(rules) != 1 || rules[0] != "/Common/r1" {
+		t.Errorf("unexpected rules %v", rules)
+	}
+
+	if err := json.Unmarshal([]byte(`{"enabled":"yes"}`), &item); err == nil {
+		t.Error("expected an error for a value of the wrong type")
+	}
+}
+
+func TestOptPatchBody(t *testing.T) {
+	body, err := PatchBody(optItem{Enabled: Some(false)}, "description", "enabled")
+	if err != nil {
+		t.Fatalf("PatchBody: %v", err)
+	}
+	if expected := `{"description":null,"enabled":false}`; string(body) != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+}
+
+func TestOptCopy(t *testing.T) {
+	a := Some[int64](1)
+	b := a
+	if err := json.Unmarshal([]byte(`2`), &b); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if a != Some[int64](1) || b != Some[int64](2) {
+		t.Errorf("expected a copy to be independent, got %v and %v", a, b)
+	}
+	if Null[int64]() == (Opt[int64]{}) || Null[int64]() == Some[int64](0) {
+		t.Error("expected null to differ from unset and from zero")
+	}
+}
@@ -0,0 +1,208 @@
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// PatchBody returns the body of a PATCH request modifying only the attributes in
+// fields, which is either a struct, usually a partially filled resource struct, or
+// a map[string]any.
+//
+// Only the attributes of a struct that are set are sent: those not holding their
+// zero value, nested structs, such as references, and times included, and the
+// attributes in its Extra field. The JSON names listed in mask are sent even when
+// they hold their zero value, so that an attribute can be set to 0, false or an
+// empty string; a nil slice in mask is sent as an empty list and an unset Opt as
+// null. For example, to remove the monitor of a pool:
+//
+//	err := pools.Patch("/Common/p1", ltm.Pool{}, "monitor")
+//
+// Attributes of type Opt need no mask, as they tell apart unset from zero values
+// themselves. Nested structs are sent with their set attributes only, the
+// elements of lists as a whole.
+func PatchBody(fields any, mask ...string) ([]byte, error) {
+	v := reflect.ValueOf(fields)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		data, err := json.Marshal(fields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+		}
+		return data, nil
+	}
+
+	body := make(map[string]json.RawMessage)
+	if err := addSetFields(v, body); err != nil {
+		return nil, err
+	}
+	for _, name := range mask {
+		if _, ok := body[name]; ok {
+			continue
+		}
+		field, ok := fieldByJSONName(v, name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q in field mask of %s", name, v.Type())
+		}
+		value, err := marshalZero(field)
+		if err != nil {
+			return nil, err
+		}
+		body[name] = value
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	return data, nil
+}
+
+// extraType is the type of the Extra field of resource structs.
+var extraType = reflect.TypeOf(Extra(nil))
+
+// addSetFields adds the attributes of the struct v that are set to body, see
+// PatchBody.
+func addSetFields(v reflect.Value, body map[string]json.RawMessage) error {
+	t := v.Type()
+	var extra Extra
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		field := v.Field(i)
+		tag := f.Tag.Get("json")
+		if f.Type == extraType && f.IsExported() {
+			extra = field.Interface().(Extra)
+			continue
+		}
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			if field.Kind() == reflect.Pointer {
+				if field.IsNil() {
+					continue
+				}
+				field = field.Elem()
+			}
+			if field.Kind() == reflect.Struct {
+				if err := addSetFields(field, body); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		value, ok, err := marshalSet(field)
+		if err != nil {
+			return err
+		}
+		if ok {
+			body[name] = value
+		}
+	}
+
+	known := jsonFieldNames(t)
+	for name, value := range extra {
+		if _, ok := body[name]; !ok && !known[strings.ToLower(name)] {
+			if len(value) == 0 {
+				value = json.RawMessage("null")
+			}
+			body[name] = value
+		}
+	}
+	return nil
+}
+
+// marshalSet marshals v if it is set, see PatchBody.
+func marshalSet(v reflect.Value) (json.RawMessage, bool, error) {
+	if opt, ok := v.Interface().(interface{ isUnset() bool }); ok {
+		if opt.isUnset() {
+			return nil, false, nil
+		}
+	} else if v.IsZero() {
+		return nil, false, nil
+	}
+
+	if _, marshaler := v.Interface().(json.Marshaler); !marshaler && v.Kind() == reflect.Struct {
+		nested := make(map[string]json.RawMessage)
+		if err := addSetFields(v, nested); err != nil {
+			return nil, false, err
+		}
+		data, err := json.Marshal(nested)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to marshal JSON data: %w", err)
+		}
+		return data, true, nil
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	return data, true, nil
+}
+
+// marshalZero marshals v, which omitempty dropped, sending nil lists and maps as
+// empty ones. An unset Opt is sent as null.
+func marshalZero(v reflect.Value) (json.RawMessage, error) {
+	_, marshaler := v.Interface().(json.Marshaler)
+	switch {
+	case marshaler:
+	case v.Kind() == reflect.Slice && v.IsNil():
+		return json.RawMessage("[]"), nil
+	case v.Kind() == reflect.Map && v.IsNil():
+		return json.RawMessage("{}"), nil
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	return data, nil
+}
+
+// fieldByJSONName returns the field of the struct v that encoding/json marshals
+// under name, looking into embedded structs.
+func fieldByJSONName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		tagName, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && tagName == "" {
+			embedded := v.Field(i)
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if field, ok := fieldByJSONName(embedded, name); ok {
+					return field, true
+				}
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if tagName == "" {
+			tagName = f.Name
+		}
+		if tagName == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
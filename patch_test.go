@@ -0,0 +1,54 @@
+package bigip
+
+import (
+	"testing"
+)
+
+type patchBase struct {
+	Description string `json:"description,omitempty"`
+}
+
+type patchItem struct {
+	patchBase
+	Name            string   `json:"name,omitempty"`
+	ConnectionLimit int64    `json:"connectionLimit,omitempty"`
+	Enabled         bool     `json:"enabled,omitempty"`
+	Rules           []string `json:"rules,omitempty"`
+	Internal        string   `json:"-"`
+}
+
+func TestPatchBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   any
+		mask     []string
+		expected string
+		wantErr  bool
+	}{
+		{name: "partial struct", fields: patchItem{ConnectionLimit: 10}, expected: `{"connectionLimit":10}`},
+		{name: "zero values in mask", fields: &patchItem{Name: "p1"}, mask: []string{"connectionLimit", "enabled", "rules", "description"},
+			expected: `{"connectionLimit":0,"description":"","enabled":false,"name":"p1","rules":[]}`},
+		{name: "set values in mask", fields: patchItem{Enabled: true}, mask: []string{"enabled"}, expected: `{"enabled":true}`},
+		{name: "map", fields: map[string]any{"connectionLimit": 0, "enabled": false}, mask: []string{"ignored"},
+			expected: `{"connectionLimit":0,"enabled":false}`},
+		{name: "unknown field", fields: patchItem{}, mask: []string{"connectionlimit"}, wantErr: true},
+		{name: "ignored field", fields: patchItem{}, mask: []string{"Internal"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := PatchBody(tt.fields, tt.mask...)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", body)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PatchBody: %v", err)
+			}
+			if string(body) != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, body)
+			}
+		})
+	}
+}
@@ -1,5 +1,14 @@
 package bigip
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"net/http"
+	"path"
+)
+
 func GetBaseResource() string {
 	return "mgmt"
 }
@@ -15,3 +24,224 @@ func GetTMResource() string {
 func GetCMResource() string {
 	return "cm"
 }
+
+// Resource implements the operations shared by every BIG-IP collection, such as
+// /mgmt/tm/ltm/pool or /mgmt/tm/ltm/monitor/http. T is the type of a single item
+// and L the type of the collection returned by List.
+//
+// Resource types of the ltm, gtm, net, sys and auth packages embed a Resource and
+// only add the methods that are specific to them, for example:
+//
+//	type PoolResource struct {
+//		bigip.Resource[Pool, PoolList]
+//	}
+type Resource[T any, L any] struct {
+	b           *BigIP
+	manager     string
+	endpoint    string
+	subEndpoint string
+}
+
+// NewResource returns a Resource for the collection located at
+// /mgmt/tm/<manager>/<endpoint>[/<subEndpoints>...].
+func NewResource[T any, L any](b *BigIP, manager, endpoint string, subEndpoints ...string) Resource[T, L] {
+	return Resource[T, L]{
+		b:           b,
+		manager:     manager,
+		endpoint:    endpoint,
+		subEndpoint: path.Join(subEndpoints...),
+	}
+}
+
+// BigIP returns the session the resource sends its requests through.
+func (r *Resource[T, L]) BigIP() *BigIP {
+	return r.b
+}
+
+// Collection begins a request with the given verb against the collection path.
+func (r *Resource[T, L]) Collection(verb string) *rest.Request {
+	req := r.b.RestClient.Verb(verb).Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(r.manager).Resource(r.endpoint)
+	if len(r.subEndpoint) != 0 {
+		req = req.SubResource(r.subEndpoint)
+	}
+	return req
+}
+
+// Instance begins a request with the given verb against a single item of the collection.
+func (r *Resource[T, L]) Instance(verb, name string) *rest.Request {
+	if len(r.subEndpoint) != 0 {
+		return r.Collection(verb).SubResourceInstance(name)
+	}
+	return r.Collection(verb).ResourceInstance(name)
+}
+
+// List retrieves the items of the collection, all of them unless opts say otherwise.
+func (r *Resource[T, L]) List(opts ...ListOptions) (*L, error) {
+	return r.ListContext(context.Background(), opts...)
+}
+
+// ListContext is like List but uses ctx for the request.
+func (r *Resource[T, L]) ListContext(ctx context.Context, opts ...ListOptions) (*L, error) {
+	res, err := ApplyListOptions(r.Collection(http.MethodGet), opts...).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var list L
+	if err := json.Unmarshal(res, &list); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &list, nil
+}
+
+// Get retrieves a single item identified by its name or full path, e.g. /Common/name.
+func (r *Resource[T, L]) Get(name string) (*T, error) {
+	return r.GetContext(context.Background(), name)
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (r *Resource[T, L]) GetContext(ctx context.Context, name string) (*T, error) {
+	res, err := r.Instance(http.MethodGet, name).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var item T
+	if err := json.Unmarshal(res, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &item, nil
+}
+
+// Create creates a new item in the collection.
+func (r *Resource[T, L]) Create(item T) error {
+	return r.CreateContext(context.Background(), item)
+}
+
+// CreateContext is like Create but uses ctx for the request.
+func (r *Resource[T, L]) CreateContext(ctx context.Context, item T) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	_, err = r.Collection(http.MethodPost).Body(data).DoRaw(ctx)
+	return err
+}
+
+// Update replaces the configuration of the item identified by name.
+func (r *Resource[T, L]) Update(name string, item T) error {
+	return r.UpdateContext(context.Background(), name, item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *Resource[T, L]) UpdateContext(ctx context.Context, name string, item T) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	_, err = r.Instance(http.MethodPut, name).Body(data).DoRaw(ctx)
+	return err
+}
+
+// Patch modifies only the given attributes of the item identified by name, leaving
+// the others as they are. fields is a partially filled T, any other struct or a
+// map[string]any; mask lists attributes to send even though they hold their zero
+// value. See PatchBody.
+func (r *Resource[T, L]) Patch(name string, fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), name, fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *Resource[T, L]) PatchContext(ctx context.Context, name string, fields any, mask ...string) error {
+	data, err := PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.Instance(http.MethodPatch, name).Body(data).DoRaw(ctx)
+	return err
+}
+
+// Delete removes the item identified by name.
+func (r *Resource[T, L]) Delete(name string) error {
+	return r.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (r *Resource[T, L]) DeleteContext(ctx context.Context, name string) error {
+	_, err := r.Instance(http.MethodDelete, name).DoRaw(ctx)
+	return err
+}
+
+// Exists reports whether the item identified by name is configured on the device.
+func (r *Resource[T, L]) Exists(name string) (bool, error) {
+	return r.ExistsContext(context.Background(), name)
+}
+
+// ExistsContext is like Exists but uses ctx for the request.
+func (r *Resource[T, L]) ExistsContext(ctx context.Context, name string) (bool, error) {
+	_, err := r.Instance(http.MethodGet, name).DoRaw(ctx)
+	if err == nil {
+		return true, nil
+	}
+	if rest.IsNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// StatsEndpoint is the sub path BIG-IP exposes the statistics of a collection or an item on.
+const StatsEndpoint = "stats"
+
+// StatsList is the generic representation of a stats response. Every entry is
+// keyed by the self link of the object the statistics belong to.
+type StatsList struct {
+	Entries  map[string]StatsEntry `json:"entries,omitempty"`
+	Kind     string                `json:"kind,omitempty"`
+	SelfLink string                `json:"selfLink,omitempty"`
+}
+
+// StatsEntry holds the statistics of a single object.
+type StatsEntry struct {
+	NestedStats struct {
+		Kind     string                `json:"kind,omitempty"`
+		SelfLink string                `json:"selfLink,omitempty"`
+		Entries  map[string]StatsValue `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
+
+// StatsValue is a single counter or description of a stats entry.
+type StatsValue struct {
+	Value       int64  `json:"value,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ListStats retrieves the statistics of every item of the collection.
+func (r *Resource[T, L]) ListStats() (*StatsList, error) {
+	return r.ListStatsContext(context.Background())
+}
+
+// ListStatsContext is like ListStats but uses ctx for the request.
+func (r *Resource[T, L]) ListStatsContext(ctx context.Context) (*StatsList, error) {
+	return r.stats(ctx, r.Collection(http.MethodGet))
+}
+
+// Stats retrieves the statistics of the item identified by name.
+func (r *Resource[T, L]) Stats(name string) (*StatsList, error) {
+	return r.StatsContext(context.Background(), name)
+}
+
+// StatsContext is like Stats but uses ctx for the request.
+func (r *Resource[T, L]) StatsContext(ctx context.Context, name string) (*StatsList, error) {
+	return r.stats(ctx, r.Instance(http.MethodGet, name))
+}
+
+func (r *Resource[T, L]) stats(ctx context.Context, req *rest.Request) (*StatsList, error) {
+	res, err := req.SubStatsResource(StatsEndpoint).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var stats StatsList
+	if err := json.Unmarshal(res, &stats); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &stats, nil
+}
@@ -0,0 +1,147 @@
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/transport"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testItem struct {
+	Name     string `json:"name,omitempty"`
+	FullPath string `json:"fullPath,omitempty"`
+}
+
+type testItemList struct {
+	Items []testItem `json:"items,omitempty"`
+}
+
+func TestResource(t *testing.T) {
+	var requests []string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/mgmt/tm/ltm/monitor/http/~Common~missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"01020036:3: The requested monitor (/Common/missing) was not found."}`))
+		case r.URL.Path == "/mgmt/tm/ltm/monitor/http" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(testItemList{Items: []testItem{{Name: "http", FullPath: "/Common/http"}}})
+		case r.URL.Path == "/mgmt/tm/ltm/monitor/http/stats":
+			w.Write([]byte(`{"entries":{"https://localhost/mgmt/tm/ltm/monitor/http/~Common~http/stats":{"nestedStats":{"entries":{"tmName":{"description":"/Common/http"}}}}}}`))
+		default:
+			body, _ := io.ReadAll(r.Body)
+			if len(body) == 0 {
+				body = []byte(`{"name":"http","fullPath":"/Common/http"}`)
+			}
+			w.Write(body)
+		}
+	}))
+	defer ts.Close()
+
+	b, err := NewSession(ts.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	r := NewResource[testItem, testItemList](b, "ltm", "monitor", "http")
+
+	list, err := r.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].FullPath != "/Common/http" {
+		t.Errorf("unexpected list %+v", list)
+	}
+	item, err := r.Get("/Common/http")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if item.Name != "http" {
+		t.Errorf("expected name http, got %q", item.Name)
+	}
+	if err := r.Create(testItem{Name: "new"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := r.Update("/Common/new", testItem{Name: "new"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if err := r.Patch("/Common/new", testItem{Name: "new"}); err != nil {
+		t.Fatalf("Patch: %v", err)
+	}
+	if err := r.Delete("/Common/new"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	exists, err := r.Exists("/Common/missing")
+	if err != nil || exists {
+		t.Errorf("Exists(missing) = %v, %v", exists, err)
+	}
+	stats, err := r.ListStats()
+	if err != nil {
+		t.Fatalf("ListStats: %v", err)
+	}
+	for _, entry := range stats.Entries {
+		if entry.NestedStats.Entries["tmName"].Description != "/Common/http" {
+			t.Errorf("unexpected stats entry %+v", entry)
+		}
+	}
+
+	expected := []string{
+		"GET /mgmt/tm/ltm/monitor/http",
+		"GET /mgmt/tm/ltm/monitor/http/~Common~http",
+		"POST /mgmt/tm/ltm/monitor/http",
+		"PUT /mgmt/tm/ltm/monitor/http/~Common~new",
+		"PATCH /mgmt/tm/ltm/monitor/http/~Common~new",
+		"DELETE /mgmt/tm/ltm/monitor/http/~Common~new",
+		"GET /mgmt/tm/ltm/monitor/http/~Common~missing",
+		"GET /mgmt/tm/ltm/monitor/http/stats",
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("expected %d requests, got %v", len(expected), requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("request %d: expected %q, got %q", i, expected[i], requests[i])
+		}
+	}
+}
+
+type labelRecorder []string
+
+func (l *labelRecorder) RequestStarted(labels transport.Labels) {}
+
+func (l *labelRecorder) RequestDone(labels transport.Labels, code int, latency time.Duration) {
+	*l = append(*l, fmt.Sprintf("%s %d", labels, code))
+}
+
+func TestResourceLabels(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+	s.Add("ltm/monitor/http", map[string]any{"name": "http"})
+
+	var labels labelRecorder
+	b, err := New(s.URL, WithBasicAuth(s.Username, s.Password), WithInstrumentation(transport.InstrumentConfig{Metrics: &labels}))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	r := NewResource[testItem, testItemList](b, "ltm", "monitor", "http")
+	if _, err := r.Get("/Common/http"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if _, err := r.Get("/Common/missing"); err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, err := b.Dynamic().Resource("ltm/policy").List(); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	expected := []string{"GET tm/ltm/monitor/http 200", "GET tm/ltm/monitor/http 404", "GET tm/ltm 200"}
+	if len(labels) < len(expected) || strings.Join(labels[len(labels)-3:], "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected labels:\n%s", strings.Join(labels, "\n"))
+	}
+}
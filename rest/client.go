@@ -28,6 +28,8 @@ type RESTClient struct {
 	content ClientContentConfig
 	// Set specific behavior of the client.  If not set http.DefaultClient will be used.
 	Client *http.Client
+	// headers are set on every request made by the client.
+	headers http.Header
 }
 
 var _ Interface = &RESTClient{}
@@ -52,6 +54,17 @@ func NewRESTClient(baseURL *url.URL, baseAPIPath string, config ClientContentCon
 	}, nil
 }
 
+// WithHeader returns a copy of c that sets the header key to values on every request.
+func (c *RESTClient) WithHeader(key string, values ...string) *RESTClient {
+	client := *c
+	client.headers = c.headers.Clone()
+	if client.headers == nil {
+		client.headers = http.Header{}
+	}
+	client.headers[http.CanonicalHeaderKey(key)] = values
+	return &client
+}
+
 // Verb begins a request with a verb (GET, POST, PUT, DELETE).
 //
 // Example usage of RESTClient's request building interface:
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"github.com/lefeck/go-bigip/transport"
+	"golang.org/x/oauth2"
 	"net/http"
 	"time"
 )
@@ -13,9 +14,15 @@ type Config struct {
 	Username    string
 	Password    string
 	BearerToken string
+	// TokenSource provides a bearer token that is refreshed when it expires.
+	// It takes precedence over BearerToken.
+	TokenSource oauth2.TokenSource
 	//BearerTokenFile   string
 	// The maximum length of time to wait before giving up on a server request. A value of zero means no timeout.
-	Timeout       time.Duration
+	Timeout time.Duration
+	// TLS configures the verification of the server certificate and the client
+	// certificate. When nil, the server certificate is not verified.
+	TLS           *transport.TLSConfig
 	Transport     http.RoundTripper
 	WrapTransport transport.WrapperFunc
 }
@@ -3,8 +3,11 @@ package rest
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
 )
 
 type RequestError struct {
@@ -35,3 +38,122 @@ func (err RequestError) String() string {
 	}
 	return buf.String()
 }
+
+// Sentinel errors matched by an *APIError with errors.Is.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrBusy         = errors.New("device busy")
+)
+
+// maxErrorBody is how much of a response body that is not JSON is kept as the message.
+const maxErrorBody = 512
+
+// APIError is returned for a response with an error status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code is the error code BIG-IP reported in the body, which usually matches
+	// StatusCode. It is zero when the body was not JSON.
+	Code int
+	// Message is the message BIG-IP reported, or the beginning of the body when it
+	// was not JSON.
+	Message  string
+	ErrStack []string
+	// Method and URL identify the request that failed.
+	Method string
+	URL    string
+}
+
+// NewAPIError reads the error described by resp. It consumes the body of resp.
+func NewAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.URL = resp.Request.URL.String()
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	var reqErr RequestError
+	if strings.Contains(resp.Header.Get("Content-Type"), "application/json") && json.Unmarshal(body, &reqErr) == nil {
+		apiErr.Code = reqErr.Code
+		apiErr.Message = reqErr.Message
+		apiErr.ErrStack = reqErr.ErrStack
+	}
+	if apiErr.Message == "" {
+		message := strings.TrimSpace(string(body))
+		if len(message) > maxErrorBody {
+			message = message[:maxErrorBody]
+		}
+		if message == "" {
+			message = resp.Status
+		}
+		apiErr.Message = message
+	}
+	return apiErr
+}
+
+// Error implements the errors.Error interface
+func (e *APIError) Error() string {
+	code := e.Code
+	if code == 0 {
+		code = e.StatusCode
+	}
+	if e.Method == "" {
+		return fmt.Sprintf("%s (code: %d)", e.Message, code)
+	}
+	return fmt.Sprintf("%s %s: %s (code: %d)", e.Method, e.URL, e.Message, code)
+}
+
+// Is reports whether e is of the kind of ErrNotFound, ErrConflict, ErrUnauthorized
+// or ErrBusy.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.hasStatus(http.StatusNotFound)
+	case ErrConflict:
+		return e.hasStatus(http.StatusConflict)
+	case ErrUnauthorized:
+		return e.hasStatus(http.StatusUnauthorized)
+	case ErrBusy:
+		return e.hasStatus(http.StatusServiceUnavailable) || e.hasStatus(http.StatusTooManyRequests)
+	}
+	return false
+}
+
+// As allows an *APIError to be used where a *RequestError was returned before.
+func (e *APIError) As(target interface{}) bool {
+	if reqErr, ok := target.(**RequestError); ok {
+		*reqErr = &RequestError{Code: e.Code, Message: e.Message, ErrStack: e.ErrStack}
+		if (*reqErr).Code == 0 {
+			(*reqErr).Code = e.StatusCode
+		}
+		return true
+	}
+	return false
+}
+
+func (e *APIError) hasStatus(code int) bool {
+	return e.StatusCode == code || e.Code == code
+}
+
+// IsNotFound reports whether err says the object does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict reports whether err says the object already exists or is in use.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsUnauthorized reports whether err says the credentials or token were rejected.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsBusy reports whether err says the device is too busy to handle the request,
+// which usually passes when retried later.
+func IsBusy(err error) bool {
+	return errors.Is(err, ErrBusy)
+}
@@ -0,0 +1,75 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mgmt/tm/ltm/pool/~Common~missing":
+			w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"01020036:3: The requested Pool (/Common/missing) was not found.","errorStack":[],"apiError":3}`))
+		case "/mgmt/tm/ltm/pool":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"code":409,"message":"01020066:3: The requested Pool (/Common/p1) already exists in partition Common."}`))
+		default:
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("restjavad is busy"))
+		}
+	}))
+	defer server.Close()
+
+	baseURL, _ := url.Parse(server.URL)
+	newRequest := func(verb string) *Request {
+		return NewRequestWithClient(baseURL, "", ClientContentConfig{}, http.DefaultClient).Verb(verb).
+			Prefix("mgmt").ResourceCategory("tm").ManagerName("ltm").Resource("pool")
+	}
+
+	_, err := newRequest(http.MethodGet).ResourceInstance("/Common/missing").DoRaw(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an *APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Code != 404 || apiErr.Method != http.MethodGet ||
+		apiErr.URL != server.URL+"/mgmt/tm/ltm/pool/~Common~missing" {
+		t.Errorf("Unexpected error %#v", apiErr)
+	}
+	if !IsNotFound(err) || IsConflict(err) || IsBusy(err) || IsUnauthorized(err) {
+		t.Errorf("Expected only IsNotFound for %v", err)
+	}
+	// Code that looks for the RequestError returned before keeps working.
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) || reqErr.Code != 404 {
+		t.Errorf("Expected a *RequestError with code 404, got %v", reqErr)
+	}
+
+	_, err = newRequest(http.MethodPost).Body([]byte(`{"name":"p1"}`)).DoRaw(context.Background())
+	if !IsConflict(err) || !errors.Is(err, ErrConflict) {
+		t.Errorf("Expected a conflict, got %v", err)
+	}
+
+	_, err = newRequest(http.MethodGet).SubResource("stats").DoRaw(context.Background())
+	if !IsBusy(err) {
+		t.Errorf("Expected busy, got %v", err)
+	}
+	if !errors.As(err, &apiErr) || apiErr.Message != "restjavad is busy" || apiErr.Code != 0 {
+		t.Errorf("Expected the body as message, got %#v", apiErr)
+	}
+}
+
+func TestDoRawRequestError(t *testing.T) {
+	req := NewRequestWithClient(&url.URL{Scheme: "http", Host: "localhost:1"}, "", ClientContentConfig{}, http.DefaultClient).
+		Verb(http.MethodPost).Body(42)
+	if _, err := req.DoRaw(context.Background()); err == nil || err != req.Error() {
+		t.Fatalf("Expected the body error, got %v", err)
+	}
+}
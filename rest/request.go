@@ -3,21 +3,21 @@ package rest
 import (
 	"bytes"
 	"context"
-	"errors"
 	"fmt"
+	"github.com/lefeck/go-bigip/transport"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
 	"strings"
+	"sync"
 	"time"
 )
 
 type Request struct {
-	c       *RESTClient
-	timeout time.Duration
-	//maxRetries       int
+	c                *RESTClient
+	timeout          time.Duration
 	verb             string
 	pathPrefix       string
 	subpath          string
@@ -47,9 +47,8 @@ func NewRequest(c *RESTClient) *Request {
 		timeout = c.Client.Timeout
 	}
 	r := Request{
-		c:       c,
-		timeout: timeout,
-		//maxRetries: 10,
+		c:          c,
+		timeout:    timeout,
 		pathPrefix: pathPrefix,
 	}
 	switch {
@@ -58,6 +57,9 @@ func NewRequest(c *RESTClient) *Request {
 	case len(c.content.ContentType) > 0:
 		r.SetHeader("Accept", c.content.ContentType+", */*")
 	}
+	for key, values := range c.headers {
+		r.SetHeader(key, values...)
+	}
 	return &r
 }
 
@@ -136,7 +138,7 @@ func (r *Request) ResourceInstance(fullPaths ...string) *Request {
 	}
 	fullPath := path.Join(fullPaths...)
 	if len(r.fullPath) != 0 {
-		fmt.Errorf("fullPath already set to %q, cannot change to %q", r.fullPath, fullPath)
+		r.err = fmt.Errorf("fullPath already set to %q, cannot change to %q", r.fullPath, fullPath)
 		return r
 	}
 	newfullPath := convertSubPath(fullPath)
@@ -151,7 +153,7 @@ func (r *Request) SubResourceInstance(subFullPaths ...string) *Request {
 	}
 	subfullPath := path.Join(subFullPaths...)
 	if len(r.subFullPath) != 0 {
-		fmt.Errorf("subfullPath already set to %q, cannot change to %q", r.subFullPath, subFullPaths)
+		r.err = fmt.Errorf("subfullPath already set to %q, cannot change to %q", r.subFullPath, subFullPaths)
 		return r
 	}
 	newsubFullPath := convertSubPath(subfullPath)
@@ -239,9 +241,10 @@ func (r *Request) AbsPath(segments ...string) *Request {
 	return r
 }
 
+// SetParams sets the query parameter paramName to s, replacing any value it had.
 func (r *Request) SetParams(paramName, s string) *Request {
 	if r.params != nil {
-		return r
+		delete(r.params, paramName)
 	}
 	return r.setParams(paramName, s)
 }
@@ -269,17 +272,10 @@ func (r *Request) Error() error {
 	return r.err
 }
 
-// HandleError checks if a HTTP response contains an error and returns it.
+// HandleError checks if a HTTP response contains an error and returns it as an *APIError.
 func (r *Request) HandleError(resp *http.Response) error {
 	if resp.StatusCode < http.StatusOK || resp.StatusCode > http.StatusPartialContent {
-		if contentType := resp.Header.Get("Content-Type"); !strings.Contains(contentType, "application/json") {
-			return fmt.Errorf("The http response error status code: %s \n", resp.Status)
-		}
-		errResp, err := NewRequestError(resp.Body)
-		if err != nil {
-			return errors.New("cannot read error message from response body: " + err.Error())
-		}
-		return errResp
+		return NewAPIError(resp)
 	}
 	return nil
 }
@@ -330,6 +326,9 @@ func ValidatePathSegmentName(name string, prefix bool) []string {
 }
 
 func (r *Request) request(ctx context.Context, fn func(req *http.Request, resp *http.Response)) error {
+	if r.err != nil {
+		return r.err
+	}
 	client := r.c.Client
 	if client == nil {
 		client = http.DefaultClient
@@ -350,6 +349,11 @@ func (r *Request) request(ctx context.Context, fn func(req *http.Request, resp *
 		return err
 	}
 	defer resp.Body.Close()
+
+	stop := watchContext(ctx, resp.Body)
+	defer stop()
+	resp.Body = &contextReadCloser{ctx: ctx, rc: resp.Body}
+
 	if err := r.HandleError(resp); err != nil {
 		return err
 	}
@@ -359,6 +363,101 @@ func (r *Request) request(ctx context.Context, fn func(req *http.Request, resp *
 	return nil
 }
 
+// Stream executes the request and returns the response body without reading it,
+// for responses too large to be held in memory. The caller must close the body.
+// Reading the body is aborted once ctx is done.
+func (r *Request) Stream(ctx context.Context) (io.ReadCloser, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	client := r.c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	cancel := context.CancelFunc(func() {})
+	if r.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+	}
+
+	req, err := r.newHTTPRequest(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := r.HandleError(resp); err != nil {
+		resp.Body.Close()
+		cancel()
+		return nil, err
+	}
+
+	stop := watchContext(ctx, resp.Body)
+	return &streamReadCloser{
+		contextReadCloser: contextReadCloser{ctx: ctx, rc: resp.Body},
+		stop: func() {
+			stop()
+			cancel()
+		},
+	}, nil
+}
+
+// watchContext closes body once ctx is done, until stop is called. Not every
+// RoundTripper aborts a body read when the context is done, so this unblocks a
+// read that is stuck on a slow server.
+func watchContext(ctx context.Context, body io.Closer) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			body.Close()
+		case <-done:
+		}
+	}()
+	return func() { close(done) }
+}
+
+// contextReadCloser reports the context error instead of the error of a read on
+// a closed body once the context of the request is done.
+type contextReadCloser struct {
+	ctx context.Context
+	rc  io.ReadCloser
+}
+
+func (c *contextReadCloser) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := c.rc.Read(p)
+	if err != nil && err != io.EOF {
+		if ctxErr := c.ctx.Err(); ctxErr != nil {
+			return n, ctxErr
+		}
+	}
+	return n, err
+}
+
+func (c *contextReadCloser) Close() error {
+	return c.rc.Close()
+}
+
+// streamReadCloser is the body returned by Stream. Closing it releases the
+// resources of the request.
+type streamReadCloser struct {
+	contextReadCloser
+	once sync.Once
+	stop func()
+}
+
+func (s *streamReadCloser) Close() error {
+	err := s.contextReadCloser.Close()
+	s.once.Do(s.stop)
+	return err
+}
+
 // Body makes the request use obj as the body. Optional.
 // If obj is a string, try to read a file of that name.
 // If obj is a []byte, send it directly.
@@ -389,7 +488,8 @@ func (r *Request) Body(obj interface{}) *Request {
 	return r
 }
 
-// DoRaw executes the request but does not process the response body.
+// DoRaw executes the request but does not process the response body. The request,
+// including reading the response body, is aborted once ctx is done.
 func (r *Request) DoRaw(ctx context.Context) ([]byte, error) {
 	var result Result
 	err := r.request(ctx, func(req *http.Request, resp *http.Response) {
@@ -401,10 +501,28 @@ func (r *Request) DoRaw(ctx context.Context) ([]byte, error) {
 	return result.Body, result.Err
 }
 
+// Do executes the request and returns its response body along with its status
+// code and headers, for the responses whose headers matter, such as the chunks
+// of a file download. The request is aborted once ctx is done.
+func (r *Request) Do(ctx context.Context) Result {
+	var result Result
+	err := r.request(ctx, func(req *http.Request, resp *http.Response) {
+		result.Code = resp.StatusCode
+		result.Header = resp.Header
+		result.ContentType = resp.Header.Get("Content-Type")
+		result.Body, result.Err = io.ReadAll(resp.Body)
+	})
+	if err != nil {
+		result.Err = err
+	}
+	return result
+}
+
 // Result contains the result of calling Request.Do().
 type Result struct {
 	Body        []byte
 	ContentType string
+	Header      http.Header
 	Err         error
 	Code        int
 }
@@ -437,10 +555,19 @@ func (r *Request) URL() *url.URL {
 	if r.timeout != 0 {
 		query.Set("timeout", r.timeout.String())
 	}
-	finalURL.RawQuery = query.Encode()
+	finalURL.RawQuery = encodeQuery(query)
 	return finalURL
 }
 
+// encodeQuery encodes query like url.Values.Encode, but keeps the "$" of OData
+// parameters such as $filter and escapes spaces as %20, the way iControl REST
+// documents them.
+func encodeQuery(query url.Values) string {
+	encoded := query.Encode()
+	encoded = strings.ReplaceAll(encoded, "%24", "$")
+	return strings.ReplaceAll(encoded, "+", "%20")
+}
+
 func (r *Request) newHTTPRequest(ctx context.Context) (*http.Request, error) {
 	var body io.Reader
 	switch {
@@ -455,6 +582,18 @@ func (r *Request) newHTTPRequest(ctx context.Context) (*http.Request, error) {
 	default:
 		body = nil
 	}
+	if len(r.managerName) != 0 {
+		subResource := r.subResource
+		if len(r.subStatsResource) != 0 {
+			subResource = r.subStatsResource
+		}
+		ctx = transport.WithLabels(ctx, transport.Labels{
+			ResourceCategory: r.resourceCategory,
+			Manager:          r.managerName,
+			Resource:         r.resource,
+			SubResource:      subResource,
+		})
+	}
 	url := r.URL().String()
 	req, err := http.NewRequestWithContext(ctx, r.verb, url, body)
 	if err != nil {
@@ -3,11 +3,14 @@ package rest
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"time"
 
 	"testing"
 )
@@ -97,6 +100,17 @@ func TestSetParams(t *testing.T) {
 		t.Errorf("Expected param value is testValue, got %s", req.params["testKey"][0])
 	}
 }
+
+func TestSetParamsMultiple(t *testing.T) {
+	req := NewRequestWithClient(&url.URL{Scheme: "https", Host: "localhost"}, "/mgmt", ClientContentConfig{}, http.DefaultClient)
+	req.SetParams("$filter", "partition eq Common").SetParams("$top", "10").SetParams("$top", "20")
+
+	expected := "$filter=partition%20eq%20Common&$top=20"
+	if query := req.URL().RawQuery; query != expected {
+		t.Errorf("Expected query %q, got %q", expected, query)
+	}
+}
+
 func TestBody(t *testing.T) {
 	testByte := []byte("test byte")
 	testFile := "test.txt"
@@ -152,3 +166,69 @@ func TestDoRaw(t *testing.T) {
 		t.Fatalf("Expected response %q, got %q", expectedResponse, resp)
 	}
 }
+
+func TestDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Range", "0-4/13")
+		w.Write([]byte("Hello"))
+	}))
+	defer server.Close()
+
+	baseURL, _ := url.Parse(server.URL)
+	result := NewRequestWithClient(baseURL, "/test", ClientContentConfig{}, http.DefaultClient).Verb("GET").Do(context.Background())
+	if result.Err != nil {
+		t.Fatalf("Unexpected error: %v", result.Err)
+	}
+	if string(result.Body) != "Hello" || result.Code != http.StatusOK || result.ContentType != "application/octet-stream" ||
+		result.Header.Get("Content-Range") != "0-4/13" {
+		t.Fatalf("Unexpected result %+v", result)
+	}
+}
+
+func TestDoRawContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("partial"))
+		w.(http.Flusher).Flush()
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	baseURL, _ := url.Parse(server.URL)
+	req := NewRequestWithClient(baseURL, "/test", ClientContentConfig{}, http.DefaultClient).Verb("GET")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := req.DoRaw(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/test/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("Hello, World!"))
+	}))
+	defer server.Close()
+
+	baseURL, _ := url.Parse(server.URL)
+	body, err := NewRequestWithClient(baseURL, "/test", ClientContentConfig{}, http.DefaultClient).Verb("GET").Stream(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data, err := io.ReadAll(body)
+	body.Close()
+	if err != nil || string(data) != "Hello, World!" {
+		t.Fatalf("Expected response %q, got %q (%v)", "Hello, World!", data, err)
+	}
+
+	_, err = NewRequestWithClient(baseURL, "/test/missing", ClientContentConfig{}, http.DefaultClient).Verb("GET").Stream(context.Background())
+	if !IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+}
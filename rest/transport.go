@@ -48,11 +48,13 @@ func HTTPWrappersFor(config *Config, rt http.RoundTripper) (http.RoundTripper, e
 // Transport converts a client  to an appropriate transport .
 func (c *Config) TransportConfig() (*transport.Config, error) {
 	conf := &transport.Config{
+		TLS:           c.TLS,
 		Transport:     c.Transport,
 		WrapTransport: c.WrapTransport,
 		Username:      c.Username,
 		Password:      c.Password,
 		BearerToken:   c.BearerToken,
+		TokenSource:   c.TokenSource,
 		//BearerTokenFile: c.BearerTokenFile,
 	}
 	return conf, nil
@@ -28,10 +28,16 @@ type AlertResource struct {
 }
 
 // ListAll  lists all the Alert configurations.
-func (r *AlertResource) List() (*AlertConfigList, error) {
+func (r *AlertResource) List(opts ...bigip.ListOptions) (*AlertConfigList, error) {
+	return r.ListContext(context.Background(), opts...)
+}
+
+// ListContext is like List but uses ctx for the request.
+func (r *AlertResource) ListContext(ctx context.Context, opts ...bigip.ListOptions) (*AlertConfigList, error) {
 	var items AlertConfigList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(AlertEndpoint).DoRaw(context.Background())
+	req := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
+		Resource(AlertEndpoint)
+	res, err := bigip.ApplyListOptions(req, opts...).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
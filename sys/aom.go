@@ -10,13 +10,26 @@ import (
 
 // AOMConfig holds the configuration of a single AOM.
 type AOM struct {
-	Kind             string `json:"kind"`
-	SelfLink         string `json:"selfLink"`
-	Ipmi             string `json:"ipmi"`
-	MediaRedirection string `json:"mediaRedirection"`
-	Readonly         string `json:"readonly"`
-	Vkvm             string `json:"vkvm"`
-	Webui            string `json:"webui"`
+	Kind             string      `json:"kind"`
+	SelfLink         string      `json:"selfLink"`
+	Ipmi             string      `json:"ipmi"`
+	MediaRedirection string      `json:"mediaRedirection"`
+	Readonly         string      `json:"readonly"`
+	Vkvm             string      `json:"vkvm"`
+	Webui            string      `json:"webui"`
+	Extra            bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes AOM does not model in Extra.
+func (a *AOM) UnmarshalJSON(data []byte) error {
+	type plain AOM
+	return bigip.UnmarshalExtra(data, (*plain)(a), &a.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of AOM.
+func (a AOM) MarshalJSON() ([]byte, error) {
+	type plain AOM
+	return bigip.MarshalExtra(plain(a), a.Extra)
 }
 
 // AOMEndpoint represents the REST resource for managing AOM.
@@ -29,9 +42,14 @@ type AOMResource struct {
 
 // List retrieves all AOM details.
 func (r *AOMResource) Show() (*AOM, error) {
+	return r.ShowContext(context.Background())
+}
+
+// ShowContext is like Show but uses ctx for the request.
+func (r *AOMResource) ShowContext(ctx context.Context) (*AOM, error) {
 	var items AOM
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(AOMEndpoint).DoRaw(context.Background())
+		Resource(AOMEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -44,13 +62,38 @@ func (r *AOMResource) Show() (*AOM, error) {
 
 // Update modifies the AOM item identified by the AOM name.
 func (r *AOMResource) Update(name string, item AOM) error {
+	return r.UpdateContext(context.Background(), name, item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *AOMResource) UpdateContext(ctx context.Context, name string, item AOM) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(AOMEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(AOMEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (r *AOMResource) Patch(name string, fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), name, fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *AOMResource) PatchContext(ctx context.Context, name string, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
+		Resource(AOMEndpoint).ResourceInstance(name).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -1,12 +1,7 @@
 package application
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
-
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // APLScriptList holds a list of APLScript configurations.
@@ -31,84 +26,31 @@ type APLScript struct {
 	SigningKeyReference struct {
 		Link string `json:"link"`
 	} `json:"signingKeyReference"`
-	TotalSigningStatus string `json:"totalSigningStatus"`
-	VerificationStatus string `json:"verificationStatus"`
+	TotalSigningStatus string      `json:"totalSigningStatus"`
+	VerificationStatus string      `json:"verificationStatus"`
+	Extra              bigip.Extra `json:"-"`
 }
 
-// APLScriptEndpoint represents the REST resource for managing APLScript.
-const APLScriptEndpoint = "apl-script"
-
-// APLScriptResource provides an API to manage APLScript configurations.
-type APLScriptResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes APLScript does not model in Extra.
+func (a *APLScript) UnmarshalJSON(data []byte) error {
+	type plain APLScript
+	return bigip.UnmarshalExtra(data, (*plain)(a), &a.Extra)
 }
 
-// List retrieves all APLScript details.
-func (r *APLScriptResource) List() (*APLScriptList, error) {
-	var items APLScriptList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(APLScriptEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of APLScript.
+func (a APLScript) MarshalJSON() ([]byte, error) {
+	type plain APLScript
+	return bigip.MarshalExtra(plain(a), a.Extra)
 }
 
-// Get retrieves the details of a single APLScript by node name.
-func (r *APLScriptResource) Get(name string) (*APLScript, error) {
-	var item APLScript
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(APLScriptEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create creates a new APLScript item.
-func (r *APLScriptResource) Create(item APLScript) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(APLScriptEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// APLScriptEndpoint represents the REST resource for managing APLScript.
+const APLScriptEndpoint = "apl-script"
 
-// Update modifies the APLScript item identified by the APLScript name.
-func (r *APLScriptResource) Update(name string, item APLScript) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(APLScriptEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// APLScriptResource provides an API to manage APLScript configurations.
+type APLScriptResource struct {
+	bigip.Resource[APLScript, APLScriptList]
 }
 
-// Delete a single APLScript identified by the APLScript name. if it is not exist return error
-func (r *APLScriptResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(APLScriptEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newAPLScriptResource(b *bigip.BigIP) APLScriptResource {
+	return APLScriptResource{bigip.NewResource[APLScript, APLScriptList](b, SysManager, ApplicationEndpoint, APLScriptEndpoint)}
 }
@@ -18,10 +18,10 @@ type ApplicationResource struct {
 
 func NewApplication(b *bigip.BigIP) ApplicationResource {
 	return ApplicationResource{
-		service:    ServiceResource{b: b},
-		aplScript:  APLScriptResource{b: b},
-		customStat: CustomStatResource{b: b},
-		template:   TemplateResource{b: b},
+		service:    newServiceResource(b),
+		aplScript:  newAPLScriptResource(b),
+		customStat: newCustomStatResource(b),
+		template:   newTemplateResource(b),
 	}
 }
 
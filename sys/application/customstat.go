@@ -1,11 +1,7 @@
 package application
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // CustomStatList holds a list of CustomStat configurations.
@@ -17,83 +13,29 @@ type CustomStatList struct {
 
 // CustomStat holds the configuration of a single CustomStat.
 type CustomStat struct {
-	// ... Fields representing the CustomStat configuration
+	Extra bigip.Extra `json:"-"`
 }
 
-// CustomStatEndpoint represents the REST resource for managing CustomStat.
-const CustomStatEndpoint = "custom-stat"
-
-// CustomStatResource provides an API to manage CustomStat configurations.
-type CustomStatResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all CustomStat details.
-func (r *CustomStatResource) List() (*CustomStatList, error) {
-	var items CustomStatList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(CustomStatEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes CustomStat does not model in Extra.
+func (c *CustomStat) UnmarshalJSON(data []byte) error {
+	type plain CustomStat
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// Get retrieves the details of a single CustomStat by node name.
-func (r *CustomStatResource) Get(name string) (*CustomStat, error) {
-	var item CustomStat
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(CustomStatEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of CustomStat.
+func (c CustomStat) MarshalJSON() ([]byte, error) {
+	type plain CustomStat
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Create creates a new CustomStat item.
-func (r *CustomStatResource) Create(item CustomStat) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(CustomStatEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// CustomStatEndpoint represents the REST resource for managing CustomStat.
+const CustomStatEndpoint = "custom-stat"
 
-// Update modifies the CustomStat item identified by the CustomStat name.
-func (r *CustomStatResource) Update(name string, item CustomStat) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(CustomStatEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// CustomStatResource provides an API to manage CustomStat configurations.
+type CustomStatResource struct {
+	bigip.Resource[CustomStat, CustomStatList]
 }
 
-// Delete a single CustomStat identified by the CustomStat name. if it is not exist return error
-func (r *CustomStatResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(CustomStatEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newCustomStatResource(b *bigip.BigIP) CustomStatResource {
+	return CustomStatResource{bigip.NewResource[CustomStat, CustomStatList](b, SysManager, ApplicationEndpoint, CustomStatEndpoint)}
 }
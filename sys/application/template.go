@@ -1,11 +1,7 @@
 package application
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // TemplateList holds a list of Template configurations.
@@ -38,82 +34,29 @@ type Template struct {
 		Link            string `json:"link"`
 		IsSubcollection bool   `json:"isSubcollection"`
 	} `json:"actionsReference"`
+	Extra bigip.Extra `json:"-"`
 }
 
-// TemplateEndpoint represents the REST resource for managing Template.
-const TemplateEndpoint = "template"
-
-// TemplateResource provides an API to manage Template configurations.
-type TemplateResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all Template details.
-func (r *TemplateResource) List() (*TemplateList, error) {
-	var items TemplateList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(TemplateEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Template does not model in Extra.
+func (t *Template) UnmarshalJSON(data []byte) error {
+	type plain Template
+	return bigip.UnmarshalExtra(data, (*plain)(t), &t.Extra)
 }
 
-// Get retrieves the details of a single Template by node name.
-func (r *TemplateResource) Get(name string) (*Template, error) {
-	var item Template
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(TemplateEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of Template.
+func (t Template) MarshalJSON() ([]byte, error) {
+	type plain Template
+	return bigip.MarshalExtra(plain(t), t.Extra)
 }
 
-// Create creates a new Template item.
-func (r *TemplateResource) Create(item Template) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(TemplateEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// TemplateEndpoint represents the REST resource for managing Template.
+const TemplateEndpoint = "template"
 
-// Update modifies the Template item identified by the Template name.
-func (r *TemplateResource) Update(name string, item Template) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(TemplateEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// TemplateResource provides an API to manage Template configurations.
+type TemplateResource struct {
+	bigip.Resource[Template, TemplateList]
 }
 
-// Delete a single Template identified by the Template name. if it is not exist return error
-func (r *TemplateResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(TemplateEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newTemplateResource(b *bigip.BigIP) TemplateResource {
+	return TemplateResource{bigip.NewResource[Template, TemplateList](b, SysManager, ApplicationEndpoint, TemplateEndpoint)}
 }
@@ -35,9 +35,22 @@ type MainEntry struct {
 }
 
 type Clock struct {
-	Kind     string    `json:"kind"`
-	SelfLink string    `json:"selfLink"`
-	Entries  MainEntry `json:"entries"`
+	Kind     string      `json:"kind"`
+	SelfLink string      `json:"selfLink"`
+	Entries  MainEntry   `json:"entries"`
+	Extra    bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Clock does not model in Extra.
+func (c *Clock) UnmarshalJSON(data []byte) error {
+	type plain Clock
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Clock.
+func (c Clock) MarshalJSON() ([]byte, error) {
+	type plain Clock
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
 // ClockEndpoint represents the REST resource for managing Clock.
@@ -50,9 +63,14 @@ type ClockResource struct {
 
 // Get retrieves the details of a single Clock by node name.
 func (r *ClockResource) Show() (*Clock, error) {
+	return r.ShowContext(context.Background())
+}
+
+// ShowContext is like Show but uses ctx for the request.
+func (r *ClockResource) ShowContext(ctx context.Context) (*Clock, error) {
 	var item Clock
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClockEndpoint).DoRaw(context.Background())
+		Resource(ClockEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -64,13 +82,18 @@ func (r *ClockResource) Show() (*Clock, error) {
 
 // Create creates a new Clock item.
 func (r *ClockResource) Create(item Clock) error {
+	return r.CreateContext(context.Background(), item)
+}
+
+// CreateContext is like Create but uses ctx for the request.
+func (r *ClockResource) CreateContext(ctx context.Context, item Clock) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClockEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(ClockEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -79,13 +102,38 @@ func (r *ClockResource) Create(item Clock) error {
 
 // Update modifies the Clock item identified by the Clock name.
 func (r *ClockResource) Update(name string, item Clock) error {
+	return r.UpdateContext(context.Background(), name, item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *ClockResource) UpdateContext(ctx context.Context, name string, item Clock) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClockEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(ClockEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (r *ClockResource) Patch(name string, fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), name, fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *ClockResource) PatchContext(ctx context.Context, name string, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
+		Resource(ClockEndpoint).ResourceInstance(name).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -94,8 +142,13 @@ func (r *ClockResource) Update(name string, item Clock) error {
 
 // Delete a single Clock identified by the Clock name. if it is not exist return error
 func (r *ClockResource) Delete(name string) error {
+	return r.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (r *ClockResource) DeleteContext(ctx context.Context, name string) error {
 	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClockEndpoint).ResourceInstance(name).DoRaw(context.Background())
+		Resource(ClockEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -1,11 +1,7 @@
 package sys
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ClusterList holds a list of Cluster configurations.
@@ -17,81 +13,29 @@ type ClusterList struct {
 
 // Cluster holds the configuration of a single Cluster.
 type Cluster struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// ClusterEndpoint represents the REST resource for managing Cluster.
-const ClusterEndpoint = "cluster"
-
-// ClusterResource provides an API to manage Cluster configurations.
-type ClusterResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes Cluster does not model in Extra.
+func (c *Cluster) UnmarshalJSON(data []byte) error {
+	type plain Cluster
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// List retrieves all Cluster details.
-func (r *ClusterResource) List() (*ClusterList, error) {
-	var items ClusterList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClusterEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of Cluster.
+func (c Cluster) MarshalJSON() ([]byte, error) {
+	type plain Cluster
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Get retrieves the details of a single Cluster by node name.
-func (r *ClusterResource) Get(name string) (*Cluster, error) {
-	var item Cluster
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClusterEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create creates a new Cluster item.
-func (r *ClusterResource) Create(item Cluster) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClusterEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// ClusterEndpoint represents the REST resource for managing Cluster.
+const ClusterEndpoint = "cluster"
 
-// Update modifies the Cluster item identified by the Cluster name.
-func (r *ClusterResource) Update(name string, item Cluster) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClusterEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// ClusterResource provides an API to manage Cluster configurations.
+type ClusterResource struct {
+	bigip.Resource[Cluster, ClusterList]
 }
 
-// Delete a single Cluster identified by the Cluster name. If it does not exist, return an error.
-func (r *ClusterResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClusterEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newClusterResource(b *bigip.BigIP) ClusterResource {
+	return ClusterResource{bigip.NewResource[Cluster, ClusterList](b, SysManager, ClusterEndpoint)}
 }
@@ -1,11 +1,7 @@
 package sys
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ConnectionList holds a list of Connection configuration.
@@ -17,82 +13,29 @@ type ConnectionList struct {
 
 // Connection holds the configuration of a single Connection.
 type Connection struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// ConnectionEndpoint represents the REST resource for managing Connection.
-const ConnectionEndpoint = "connection"
-
-// ConnectionResource provides an API to manage Connection configurations.
-type ConnectionResource struct {
-	b *bigip.BigIP
-}
-
-// List all connection details
-func (r *ConnectionResource) List() (*ConnectionList, error) {
-	var items ConnectionList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConnectionEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Connection does not model in Extra.
+func (c *Connection) UnmarshalJSON(data []byte) error {
+	type plain Connection
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// Get a single connection details by the node name
-func (r *ConnectionResource) Get(name string) (*Connection, error) {
-	var item Connection
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConnectionEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of Connection.
+func (c Connection) MarshalJSON() ([]byte, error) {
+	type plain Connection
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Create a new connection item
-func (r *ConnectionResource) Create(item Connection) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConnectionEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// ConnectionEndpoint represents the REST resource for managing Connection.
+const ConnectionEndpoint = "connection"
 
-// Update the connection item identified by the connection name, otherwise an error will be reported.
-func (r *ConnectionResource) Update(name string, item Connection) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConnectionEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// ConnectionResource provides an API to manage Connection configurations.
+type ConnectionResource struct {
+	bigip.Resource[Connection, ConnectionList]
 }
 
-// Delete a single connection identified by the connection name. if it is not exist return error
-func (r *ConnectionResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConnectionEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newConnectionResource(b *bigip.BigIP) ConnectionResource {
+	return ConnectionResource{bigip.NewResource[Connection, ConnectionList](b, SysManager, ConnectionEndpoint)}
 }
@@ -5,11 +5,7 @@
 package sys
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ConsoleList holds a list of Console configuration.
@@ -21,82 +17,29 @@ type ConsoleList struct {
 
 // Console holds the configuration of a single Console.
 type Console struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// ConsoleEndpoint represents the REST resource for managing Console.
-const ConsoleEndpoint = "console"
-
-// ConsoleResource provides an API to manage Console configurations.
-type ConsoleResource struct {
-	b *bigip.BigIP
-}
-
-// List all console details
-func (r *ConsoleResource) List() (*ConsoleList, error) {
-	var items ConsoleList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConsoleEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Console does not model in Extra.
+func (c *Console) UnmarshalJSON(data []byte) error {
+	type plain Console
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// Get a single console details by the node name
-func (r *ConsoleResource) Get(name string) (*Console, error) {
-	var item Console
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConsoleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of Console.
+func (c Console) MarshalJSON() ([]byte, error) {
+	type plain Console
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Create a new console item
-func (r *ConsoleResource) Create(item Console) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConsoleEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// ConsoleEndpoint represents the REST resource for managing Console.
+const ConsoleEndpoint = "console"
 
-// Update the console item identified by the console name, otherwise an error will be reported.
-func (r *ConsoleResource) Update(name string, item Console) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConsoleEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// ConsoleResource provides an API to manage Console configurations.
+type ConsoleResource struct {
+	bigip.Resource[Console, ConsoleList]
 }
 
-// Delete a single console identified by the console name. if it is not exist return error
-func (r *ConsoleResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConsoleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newConsoleResource(b *bigip.BigIP) ConsoleResource {
+	return ConsoleResource{bigip.NewResource[Console, ConsoleList](b, SysManager, ConsoleEndpoint)}
 }
@@ -154,9 +154,14 @@ type CPUStatsResource struct {
 }
 
 func (r *CPUStatsResource) Show() (*CPUList, error) {
+	return r.ShowContext(context.Background())
+}
+
+// ShowContext is like Show but uses ctx for the request.
+func (r *CPUStatsResource) ShowContext(ctx context.Context) (*CPUList, error) {
 	var items CPUList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CPUStatsEndpoint).DoRaw(context.Background())
+		Resource(CPUStatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,7 @@
 package crypto
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // CheckCertList holds a list of CheckCert configurations.
@@ -17,81 +13,29 @@ type CheckCertList struct {
 
 // CheckCert holds the configuration of a single CheckCert.
 type CheckCert struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// CheckCertEndpoint represents the REST resource for managing CheckCert.
-const CheckCertEndpoint = "check-cert"
-
-// CheckCertResource provides an API to manage CheckCert configurations.
-type CheckCertResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes CheckCert does not model in Extra.
+func (c *CheckCert) UnmarshalJSON(data []byte) error {
+	type plain CheckCert
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// List retrieves all CheckCert details.
-func (r *CheckCertResource) List() (*CheckCertList, error) {
-	var items CheckCertList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CheckCertEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of CheckCert.
+func (c CheckCert) MarshalJSON() ([]byte, error) {
+	type plain CheckCert
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Get retrieves the details of a single CheckCert by node name.
-func (r *CheckCertResource) Get(name string) (*CheckCert, error) {
-	var item CheckCert
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CheckCertEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create creates a new CheckCert item.
-func (r *CheckCertResource) Create(item CheckCert) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CheckCertEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// CheckCertEndpoint represents the REST resource for managing CheckCert.
+const CheckCertEndpoint = "check-cert"
 
-// Update modifies the CheckCert item identified by the CheckCert name.
-func (r *CheckCertResource) Update(name string, item CheckCert) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CheckCertEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// CheckCertResource provides an API to manage CheckCert configurations.
+type CheckCertResource struct {
+	bigip.Resource[CheckCert, CheckCertList]
 }
 
-// Delete a single CheckCert identified by the CheckCert name. If it does not exist, return an error.
-func (r *CheckCertResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CheckCertEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newCheckCertResource(b *bigip.BigIP) CheckCertResource {
+	return CheckCertResource{bigip.NewResource[CheckCert, CheckCertList](b, SysManager, CryptoEndpoint, CheckCertEndpoint)}
 }
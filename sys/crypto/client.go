@@ -1,11 +1,7 @@
 package crypto
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ClientList holds a list of Client configurations.
@@ -17,81 +13,29 @@ type ClientList struct {
 
 // Client holds the configuration of a single Client.
 type Client struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// ClientEndpoint represents the REST resource for managing Client.
-const ClientEndpoint = "client"
-
-// ClientResource provides an API to manage Client configurations.
-type ClientResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes Client does not model in Extra.
+func (c *Client) UnmarshalJSON(data []byte) error {
+	type plain Client
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// List retrieves all Client details.
-func (r *ClientResource) List() (*ClientList, error) {
-	var items ClientList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ClientEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of Client.
+func (c Client) MarshalJSON() ([]byte, error) {
+	type plain Client
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Get retrieves the details of a single Client by node name.
-func (r *ClientResource) Get(name string) (*Client, error) {
-	var item Client
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ClientEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create creates a new Client item.
-func (r *ClientResource) Create(item Client) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ClientEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// ClientEndpoint represents the REST resource for managing Client.
+const ClientEndpoint = "client"
 
-// Update modifies the Client item identified by the Client name.
-func (r *ClientResource) Update(name string, item Client) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ClientEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// ClientResource provides an API to manage Client configurations.
+type ClientResource struct {
+	bigip.Resource[Client, ClientList]
 }
 
-// Delete a single Client identified by the Client name. If it does not exist, return an error.
-func (r *ClientResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ClientEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newClientResource(b *bigip.BigIP) ClientResource {
+	return ClientResource{bigip.NewResource[Client, ClientList](b, SysManager, CryptoEndpoint, ClientEndpoint)}
 }
@@ -1,11 +1,7 @@
 package crypto
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // CrlList holds a list of Crl configurations.
@@ -17,84 +13,32 @@ type CrlList struct {
 
 // Crl holds the configuration of a single Crl.
 type Crl struct {
-	Name       string `json:"name,omitempty"`
-	FullPath   string `json:"fullPath,omitempty"`
-	Generation int64  `json:"generation,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	FullPath   string      `json:"fullPath,omitempty"`
+	Generation int64       `json:"generation,omitempty"`
+	Extra      bigip.Extra `json:"-"`
 }
 
-// CrlEndpoint represents the REST resource for managing Crl.
-const CrlEndpoint = "crl"
-
-// CrlResource provides an API to manage Crl configurations.
-type CrlResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes Crl does not model in Extra.
+func (c *Crl) UnmarshalJSON(data []byte) error {
+	type plain Crl
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// List retrieves all Crl details.
-func (r *CrlResource) List() (*CrlList, error) {
-	var items CrlList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CrlEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of Crl.
+func (c Crl) MarshalJSON() ([]byte, error) {
+	type plain Crl
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Get retrieves the details of a single Crl by node name.
-func (r *CrlResource) Get(name string) (*Crl, error) {
-	var item Crl
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CrlEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create creates a new Crl item.
-func (r *CrlResource) Create(item Crl) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CrlEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// CrlEndpoint represents the REST resource for managing Crl.
+const CrlEndpoint = "crl"
 
-// Update modifies the Crl item identified by the Crl name.
-func (r *CrlResource) Update(name string, item Crl) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CrlEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// CrlResource provides an API to manage Crl configurations.
+type CrlResource struct {
+	bigip.Resource[Crl, CrlList]
 }
 
-// Delete a single Crl identified by the Crl name. If it does not exist, return an error.
-func (r *CrlResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CrlEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newCrlResource(b *bigip.BigIP) CrlResource {
+	return CrlResource{bigip.NewResource[Crl, CrlList](b, SysManager, CryptoEndpoint, CrlEndpoint)}
 }
@@ -20,12 +20,12 @@ type CryptoResource struct {
 
 func NewCrypto(b *bigip.BigIP) CryptoResource {
 	return CryptoResource{
-		cert:   CertResource{b: b},
-		client: ClientResource{b: b},
-		crl:    CrlResource{b: b},
-		csr:    CsrResource{b: b},
-		key:    KeyResource{b: b},
-		server: ServerResource{b: b},
+		cert:   newCertResource(b),
+		client: newClientResource(b),
+		crl:    newCrlResource(b),
+		csr:    newCsrResource(b),
+		key:    newKeyResource(b),
+		server: newServerResource(b),
 	}
 }
 
@@ -1,11 +1,7 @@
 package crypto
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // CsrList holds a list of Csr configurations.
@@ -17,81 +13,29 @@ type CsrList struct {
 
 // Csr holds the configuration of a single Csr.
 type Csr struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// CsrEndpoint represents the REST resource for managing Csr.
-const CsrEndpoint = "csr"
-
-// CsrResource provides an API to manage Csr configurations.
-type CsrResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes Csr does not model in Extra.
+func (c *Csr) UnmarshalJSON(data []byte) error {
+	type plain Csr
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// List retrieves all Csr details.
-func (r *CsrResource) List() (*CsrList, error) {
-	var items CsrList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CsrEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of Csr.
+func (c Csr) MarshalJSON() ([]byte, error) {
+	type plain Csr
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Get retrieves the details of a single Csr by node name.
-func (r *CsrResource) Get(name string) (*Csr, error) {
-	var item Csr
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CsrEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create creates a new Csr item.
-func (r *CsrResource) Create(item Csr) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CsrEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// CsrEndpoint represents the REST resource for managing Csr.
+const CsrEndpoint = "csr"
 
-// Update modifies the Csr item identified by the Csr name.
-func (r *CsrResource) Update(name string, item Csr) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CsrEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// CsrResource provides an API to manage Csr configurations.
+type CsrResource struct {
+	bigip.Resource[Csr, CsrList]
 }
 
-// Delete a single Csr identified by the Csr name. If it does not exist, return an error.
-func (r *CsrResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CsrEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newCsrResource(b *bigip.BigIP) CsrResource {
+	return CsrResource{bigip.NewResource[Csr, CsrList](b, SysManager, CryptoEndpoint, CsrEndpoint)}
 }
@@ -1,11 +1,7 @@
 package crypto
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ServerList holds a list of CryptoServer configuration.
@@ -17,89 +13,37 @@ type ServerList struct {
 
 // Server holds the configuration of a single CryptoServer.
 type Server struct {
-	FullPath     string `json:"fullPath,omitempty"`
-	Generation   int    `json:"generation,omitempty"`
-	ServerSize   string `json:"ServerSize,omitempty"`
-	ServerType   string `json:"ServerType,omitempty"`
-	Kind         string `json:"kind,omitempty"`
-	Name         string `json:"name,omitempty"`
-	SecurityType string `json:"securityType,omitempty"`
-	SelfLink     string `json:"selfLink,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	Generation   int         `json:"generation,omitempty"`
+	ServerSize   string      `json:"ServerSize,omitempty"`
+	ServerType   string      `json:"ServerType,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	SecurityType string      `json:"securityType,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	Extra        bigip.Extra `json:"-"`
 }
 
-// ServerEndpoint represents the REST resource for managing CryptoServer.
-const ServerEndpoint = "server"
-
-// ServerResource provides an API to manage Server configurations.
-type ServerResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes Server does not model in Extra.
+func (s *Server) UnmarshalJSON(data []byte) error {
+	type plain Server
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
 }
 
-// List retrieves all Server details.
-func (r *ServerResource) List() (*ServerList, error) {
-	var items ServerList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ServerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of Server.
+func (s Server) MarshalJSON() ([]byte, error) {
+	type plain Server
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
-// Get retrieves the details of a single Server by node name.
-func (r *ServerResource) Get(name string) (*Server, error) {
-	var item Server
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ServerEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create creates a new Server item.
-func (r *ServerResource) Create(item Server) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ServerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// ServerEndpoint represents the REST resource for managing CryptoServer.
+const ServerEndpoint = "server"
 
-// Update modifies the Server item identified by the Server name.
-func (r *ServerResource) Update(name string, item Server) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ServerEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// ServerResource provides an API to manage Server configurations.
+type ServerResource struct {
+	bigip.Resource[Server, ServerList]
 }
 
-// Delete a single Server identified by the Server name. If it does not exist, return an error.
-func (r *ServerResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ServerEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newServerResource(b *bigip.BigIP) ServerResource {
+	return ServerResource{bigip.NewResource[Server, ServerList](b, SysManager, CryptoEndpoint, ServerEndpoint)}
 }
@@ -11,16 +11,29 @@ type DaemonHAConfigList struct {
 
 // DaemonHAConfig holds the configuration of a single DaemonHA.
 type DaemonHAConfig struct {
-	FullPath         string `json:"fullPath"`
-	Generation       int    `json:"generation"`
-	Heartbeat        string `json:"heartbeat"`
-	HeartbeatAction  string `json:"heartbeatAction"`
-	Kind             string `json:"kind"`
-	Name             string `json:"name"`
-	NotRunningAction string `json:"notRunningAction"`
-	Running          string `json:"running"`
-	RunningTimeout   int    `json:"runningTimeout"`
-	SelfLink         string `json:"selfLink"`
+	FullPath         string      `json:"fullPath"`
+	Generation       int         `json:"generation"`
+	Heartbeat        string      `json:"heartbeat"`
+	HeartbeatAction  string      `json:"heartbeatAction"`
+	Kind             string      `json:"kind"`
+	Name             string      `json:"name"`
+	NotRunningAction string      `json:"notRunningAction"`
+	Running          string      `json:"running"`
+	RunningTimeout   int         `json:"runningTimeout"`
+	SelfLink         string      `json:"selfLink"`
+	Extra            bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes DaemonHAConfig does not model in Extra.
+func (d *DaemonHAConfig) UnmarshalJSON(data []byte) error {
+	type plain DaemonHAConfig
+	return bigip.UnmarshalExtra(data, (*plain)(d), &d.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of DaemonHAConfig.
+func (d DaemonHAConfig) MarshalJSON() ([]byte, error) {
+	type plain DaemonHAConfig
+	return bigip.MarshalExtra(plain(d), d.Extra)
 }
 
 // DaemonHAEndpoint represents the REST resource for managing DaemonHA.
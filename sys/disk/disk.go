@@ -16,8 +16,8 @@ type DiskResource struct {
 
 func NewDisk(b *bigip.BigIP) DiskResource {
 	return DiskResource{
-		logicalDisk:       LogicalDiskResource{b: b},
-		applicationVolume: ApplicationVolumeResource{b: b},
+		logicalDisk:       newLogicalDiskResource(b),
+		applicationVolume: newApplicationVolumeResource(b),
 	}
 }
 
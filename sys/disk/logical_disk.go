@@ -5,11 +5,7 @@
 package disk
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // LogicalDiskList holds a list of LogicalDisk configuration.
@@ -21,92 +17,39 @@ type LogicalDiskList struct {
 
 // LogicalDisk holds the configuration of a single LogicalDisk.
 type LogicalDisk struct {
-	FullPath   string `json:"fullPath,omitempty"`
-	Generation int    `json:"generation,omitempty"`
-	Kind       string `json:"kind,omitempty"`
-	Mode       string `json:"mode,omitempty"`
-	Name       string `json:"name,omitempty"`
-	SelfLink   string `json:"selfLink,omitempty"`
-	Size       int    `json:"size,omitempty"`
-	VgFree     int    `json:"vgFree,omitempty"`
-	VgInUse    int    `json:"vgInUse,omitempty"`
-	VgReserved int    `json:"vgReserved,omitempty"`
+	FullPath   string      `json:"fullPath,omitempty"`
+	Generation int         `json:"generation,omitempty"`
+	Kind       string      `json:"kind,omitempty"`
+	Mode       string      `json:"mode,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	SelfLink   string      `json:"selfLink,omitempty"`
+	Size       int         `json:"size,omitempty"`
+	VgFree     int         `json:"vgFree,omitempty"`
+	VgInUse    int         `json:"vgInUse,omitempty"`
+	VgReserved int         `json:"vgReserved,omitempty"`
+	Extra      bigip.Extra `json:"-"`
 }
 
-// LogicalDiskEndpoint represents the REST resource for managing LogicalDisk.
-const LogicalDiskEndpoint = "logical-disk"
-
-// LogicalDiskResource provides an API to manage LogicalDisk configurations.
-type LogicalDiskResource struct {
-	b *bigip.BigIP
-}
-
-// List all logical disk details
-func (r *LogicalDiskResource) List() (*LogicalDiskList, error) {
-	var items LogicalDiskList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LogicalDiskEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes LogicalDisk does not model in Extra.
+func (l *LogicalDisk) UnmarshalJSON(data []byte) error {
+	type plain LogicalDisk
+	return bigip.UnmarshalExtra(data, (*plain)(l), &l.Extra)
 }
 
-// Get a single logical disk details by the node name
-func (r *LogicalDiskResource) Get(name string) (*LogicalDisk, error) {
-	var item LogicalDisk
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LogicalDiskEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of LogicalDisk.
+func (l LogicalDisk) MarshalJSON() ([]byte, error) {
+	type plain LogicalDisk
+	return bigip.MarshalExtra(plain(l), l.Extra)
 }
 
-// Create a new logical disk item
-func (r *LogicalDiskResource) Create(item LogicalDisk) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LogicalDiskEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// LogicalDiskEndpoint represents the REST resource for managing LogicalDisk.
+const LogicalDiskEndpoint = "logical-disk"
 
-// Update the logical disk item identified by the logical disk name, otherwise an error will be reported.
-func (r *LogicalDiskResource) Update(name string, item LogicalDisk) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LogicalDiskEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// LogicalDiskResource provides an API to manage LogicalDisk configurations.
+type LogicalDiskResource struct {
+	bigip.Resource[LogicalDisk, LogicalDiskList]
 }
 
-// Delete a single logical disk identified by the logical disk name. if it is not exist return error
-func (r *LogicalDiskResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LogicalDiskEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newLogicalDiskResource(b *bigip.BigIP) LogicalDiskResource {
+	return LogicalDiskResource{bigip.NewResource[LogicalDisk, LogicalDiskList](b, SysManager, LogicalDiskEndpoint)}
 }
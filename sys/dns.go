@@ -4,12 +4,25 @@ import "github.com/lefeck/go-bigip"
 
 // DNSConfig holds the configuration of a single DNS.
 type DNSConfig struct {
-	Kind         string   `json:"kind,omitempty"`
-	SelfLink     string   `json:"selfLink,omitempty"`
-	Description  string   `json:"description,omitempty"`
-	NameServers  []string `json:"nameServers,omitempty"`
-	NumberOfDots int      `json:"numberOfDots,omitempty"`
-	Search       []string `json:"search,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+	SelfLink     string      `json:"selfLink,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	NameServers  []string    `json:"nameServers,omitempty"`
+	NumberOfDots int         `json:"numberOfDots,omitempty"`
+	Search       []string    `json:"search,omitempty"`
+	Extra        bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes DNSConfig does not model in Extra.
+func (d *DNSConfig) UnmarshalJSON(data []byte) error {
+	type plain DNSConfig
+	return bigip.UnmarshalExtra(data, (*plain)(d), &d.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of DNSConfig.
+func (d DNSConfig) MarshalJSON() ([]byte, error) {
+	type plain DNSConfig
+	return bigip.MarshalExtra(plain(d), d.Extra)
 }
 
 // DNSEndpoint represents the REST resource for managing DNS.
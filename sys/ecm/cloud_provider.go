@@ -1,11 +1,7 @@
 package ecm
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // CloudProviderList holds a list of CloudProvider configurations.
@@ -26,83 +22,30 @@ type CloudProvider struct {
 	PropertyTemplate []struct {
 		Name string `json:"name"`
 	} `json:"propertyTemplate"`
-	SelfLink string `json:"selfLink"`
+	SelfLink string      `json:"selfLink"`
+	Extra    bigip.Extra `json:"-"`
 }
 
-// ECMCloudProviderEndpoint represents the REST resource for managing ECMCloudProvider.
-const CloudProviderEndpoint = "cloud-provider"
-
-// CloudProviderResource provides an API to manage CloudProvider configurations.
-type CloudProviderResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all CloudProvider details.
-func (r *CloudProviderResource) List() (*CloudProviderList, error) {
-	var items CloudProviderList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(CloudProviderEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes CloudProvider does not model in Extra.
+func (c *CloudProvider) UnmarshalJSON(data []byte) error {
+	type plain CloudProvider
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// Get retrieves the details of a single CloudProvider by node name.
-func (r *CloudProviderResource) Get(name string) (*CloudProvider, error) {
-	var item CloudProvider
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(CloudProviderEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of CloudProvider.
+func (c CloudProvider) MarshalJSON() ([]byte, error) {
+	type plain CloudProvider
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Create creates a new CloudProvider item.
-func (r *CloudProviderResource) Create(item CloudProvider) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(CloudProviderEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// ECMCloudProviderEndpoint represents the REST resource for managing ECMCloudProvider.
+const CloudProviderEndpoint = "cloud-provider"
 
-// Update modifies the CloudProvider item identified by the CloudProvider name.
-func (r *CloudProviderResource) Update(name string, item CloudProvider) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(CloudProviderEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// CloudProviderResource provides an API to manage CloudProvider configurations.
+type CloudProviderResource struct {
+	bigip.Resource[CloudProvider, CloudProviderList]
 }
 
-// Delete a single CloudProvider identified by the CloudProvider name. If it does not exist, return an error.
-func (r *CloudProviderResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(CloudProviderEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newCloudProviderResource(b *bigip.BigIP) CloudProviderResource {
+	return CloudProviderResource{bigip.NewResource[CloudProvider, CloudProviderList](b, SysManager, ECMEndpoint, CloudProviderEndpoint)}
 }
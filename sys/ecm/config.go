@@ -14,15 +14,28 @@ import (
 
 // Config holds the configuration of a single Config.
 type Config struct {
-	Kind                           string `json:"kind"`
-	SelfLink                       string `json:"selfLink"`
-	MaxNumNodes                    int    `json:"maxNumNodes"`
-	NodesDynDisc                   string `json:"nodesDynDisc"`
-	NodesDynDiscUpd                string `json:"nodesDynDiscUpd"`
-	SeedIP                         string `json:"seedIp"`
-	SslKeyPairName                 string `json:"sslKeyPairName"`
-	TenantID                       string `json:"tenantId"`
-	TransportManagerMaxConnections int    `json:"transportManagerMaxConnections"`
+	Kind                           string      `json:"kind"`
+	SelfLink                       string      `json:"selfLink"`
+	MaxNumNodes                    int         `json:"maxNumNodes"`
+	NodesDynDisc                   string      `json:"nodesDynDisc"`
+	NodesDynDiscUpd                string      `json:"nodesDynDiscUpd"`
+	SeedIP                         string      `json:"seedIp"`
+	SslKeyPairName                 string      `json:"sslKeyPairName"`
+	TenantID                       string      `json:"tenantId"`
+	TransportManagerMaxConnections int         `json:"transportManagerMaxConnections"`
+	Extra                          bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Config does not model in Extra.
+func (c *Config) UnmarshalJSON(data []byte) error {
+	type plain Config
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Config.
+func (c Config) MarshalJSON() ([]byte, error) {
+	type plain Config
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
 // ConfigEndpoint represents the REST resource for managing Config.
@@ -35,9 +48,14 @@ type ConfigResource struct {
 
 // Get retrieves the details of a single Config by node name.
 func (r *ConfigResource) Get(name string) (*Config, error) {
+	return r.GetContext(context.Background(), name)
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (r *ConfigResource) GetContext(ctx context.Context, name string) (*Config, error) {
 	var item Config
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(ConfigEndpoint).DoRaw(context.Background())
+		Resource(ECMEndpoint).SubResource(ConfigEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -49,13 +67,18 @@ func (r *ConfigResource) Get(name string) (*Config, error) {
 
 // Create creates a new Config item.
 func (r *ConfigResource) Create(item Config) error {
+	return r.CreateContext(context.Background(), item)
+}
+
+// CreateContext is like Create but uses ctx for the request.
+func (r *ConfigResource) CreateContext(ctx context.Context, item Config) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(ConfigEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(ECMEndpoint).SubResource(ConfigEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -64,13 +87,38 @@ func (r *ConfigResource) Create(item Config) error {
 
 // Update modifies the Config item identified by the Config name.
 func (r *ConfigResource) Update(name string, item Config) error {
+	return r.UpdateContext(context.Background(), name, item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *ConfigResource) UpdateContext(ctx context.Context, name string, item Config) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(ConfigEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(ECMEndpoint).SubResource(ConfigEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (r *ConfigResource) Patch(name string, fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), name, fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *ConfigResource) PatchContext(ctx context.Context, name string, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
+		Resource(ECMEndpoint).SubResource(ConfigEndpoint).SubResourceInstance(name).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -79,8 +127,13 @@ func (r *ConfigResource) Update(name string, item Config) error {
 
 // Delete a single Config identified by the Config name. If it does not exist, return an error.
 func (r *ConfigResource) Delete(name string) error {
+	return r.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (r *ConfigResource) DeleteContext(ctx context.Context, name string) error {
 	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(ConfigEndpoint).SubResourceInstance(name).DoRaw(context.Background())
+		Resource(ECMEndpoint).SubResource(ConfigEndpoint).SubResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
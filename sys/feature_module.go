@@ -1,11 +1,7 @@
 package sys
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // FeatureModuleList holds a list of FeatureModule configurations.
@@ -17,88 +13,35 @@ type FeatureModuleList struct {
 
 // FeatureModule holds the configuration of a single FeatureModule.
 type FeatureModule struct {
-	Disabled   bool   `json:"disabled"`
-	FullPath   string `json:"fullPath"`
-	Generation int    `json:"generation"`
-	Kind       string `json:"kind"`
-	Name       string `json:"name"`
-	SelfLink   string `json:"selfLink"`
+	Disabled   bool        `json:"disabled"`
+	FullPath   string      `json:"fullPath"`
+	Generation int         `json:"generation"`
+	Kind       string      `json:"kind"`
+	Name       string      `json:"name"`
+	SelfLink   string      `json:"selfLink"`
+	Extra      bigip.Extra `json:"-"`
 }
 
-// FeatureModuleEndpoint represents the REST resource for managing FeatureModule.
-const FeatureModuleEndpoint = "feature-module"
-
-// FeatureModuleResource provides an API to manage FeatureModule configurations.
-type FeatureModuleResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all FeatureModule details.
-func (r *FeatureModuleResource) List() (*FeatureModuleList, error) {
-	var items FeatureModuleList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(FeatureModuleEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes FeatureModule does not model in Extra.
+func (f *FeatureModule) UnmarshalJSON(data []byte) error {
+	type plain FeatureModule
+	return bigip.UnmarshalExtra(data, (*plain)(f), &f.Extra)
 }
 
-// Get retrieves the details of a single FeatureModule by node name.
-func (r *FeatureModuleResource) Get(name string) (*FeatureModule, error) {
-	var item FeatureModule
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(FeatureModuleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of FeatureModule.
+func (f FeatureModule) MarshalJSON() ([]byte, error) {
+	type plain FeatureModule
+	return bigip.MarshalExtra(plain(f), f.Extra)
 }
 
-// Create creates a new FeatureModule item.
-func (r *FeatureModuleResource) Create(item FeatureModule) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(FeatureModuleEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// FeatureModuleEndpoint represents the REST resource for managing FeatureModule.
+const FeatureModuleEndpoint = "feature-module"
 
-// Update modifies the FeatureModule item identified by the FeatureModule name.
-func (r *FeatureModuleResource) Update(name string, item FeatureModule) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(FeatureModuleEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// FeatureModuleResource provides an API to manage FeatureModule configurations.
+type FeatureModuleResource struct {
+	bigip.Resource[FeatureModule, FeatureModuleList]
 }
 
-// Delete a single FeatureModule identified by the FeatureModule name. If it does not exist, return an error.
-func (r *FeatureModuleResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(FeatureModuleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newFeatureModuleResource(b *bigip.BigIP) FeatureModuleResource {
+	return FeatureModuleResource{bigip.NewResource[FeatureModule, FeatureModuleList](b, SysManager, FeatureModuleEndpoint)}
 }
@@ -1,5 +1,7 @@
 package sys
 
+import "github.com/lefeck/go-bigip"
+
 // FolderConfigList holds a list of Folder configuration.
 type FolderConfigList struct {
 	Items    []FolderConfig `json:"items"`
@@ -23,6 +25,19 @@ type FolderConfig struct {
 	TrafficGroupReference struct {
 		Link string `json:"link"`
 	} `json:"trafficGroupReference"`
+	Extra bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes FolderConfig does not model in Extra.
+func (f *FolderConfig) UnmarshalJSON(data []byte) error {
+	type plain FolderConfig
+	return bigip.UnmarshalExtra(data, (*plain)(f), &f.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of FolderConfig.
+func (f FolderConfig) MarshalJSON() ([]byte, error) {
+	type plain FolderConfig
+	return bigip.MarshalExtra(plain(f), f.Extra)
 }
 
 type NewFolderConfig struct {
@@ -13,34 +13,47 @@ const GlobalSettingsEndpoint = "global-settings"
 
 // GlobalSettings holds the configuration of a single GlobalSettings.
 type GlobalSettings struct {
-	Kind                            string `json:"kind"`
-	SelfLink                        string `json:"selfLink"`
-	AwsAPIMaxConcurrency            int    `json:"awsApiMaxConcurrency"`
-	ConsoleInactivityTimeout        int    `json:"consoleInactivityTimeout"`
-	CustomAddr                      string `json:"customAddr"`
-	FailsafeAction                  string `json:"failsafeAction"`
-	FileBlacklistPathPrefix         string `json:"fileBlacklistPathPrefix"`
-	FileBlacklistReadOnlyPathPrefix string `json:"fileBlacklistReadOnlyPathPrefix"`
-	FileLocalPathPrefix             string `json:"fileLocalPathPrefix"`
-	FileWhitelistPathPrefix         string `json:"fileWhitelistPathPrefix"`
-	GuiAudit                        string `json:"guiAudit"`
-	GuiExpiredCertAlert             string `json:"guiExpiredCertAlert"`
-	GuiSecurityBanner               string `json:"guiSecurityBanner"`
-	GuiSecurityBannerText           string `json:"guiSecurityBannerText"`
-	GuiSetup                        string `json:"guiSetup"`
-	HostAddrMode                    string `json:"hostAddrMode"`
-	Hostname                        string `json:"hostname"`
-	LcdDisplay                      string `json:"lcdDisplay"`
-	LedLocator                      string `json:"ledLocator"`
-	MgmtDhcp                        string `json:"mgmtDhcp"`
-	NetReboot                       string `json:"netReboot"`
-	PasswordPrompt                  string `json:"passwordPrompt"`
-	QuietBoot                       string `json:"quietBoot"`
-	SSHMaxSessionLimit              int    `json:"sshMaxSessionLimit"`
-	SSHMaxSessionLimitPerUser       int    `json:"sshMaxSessionLimitPerUser"`
-	SSHRootSessionLimit             string `json:"sshRootSessionLimit"`
-	SSHSessionLimit                 string `json:"sshSessionLimit"`
-	UsernamePrompt                  string `json:"usernamePrompt"`
+	Kind                            string      `json:"kind"`
+	SelfLink                        string      `json:"selfLink"`
+	AwsAPIMaxConcurrency            int         `json:"awsApiMaxConcurrency"`
+	ConsoleInactivityTimeout        int         `json:"consoleInactivityTimeout"`
+	CustomAddr                      string      `json:"customAddr"`
+	FailsafeAction                  string      `json:"failsafeAction"`
+	FileBlacklistPathPrefix         string      `json:"fileBlacklistPathPrefix"`
+	FileBlacklistReadOnlyPathPrefix string      `json:"fileBlacklistReadOnlyPathPrefix"`
+	FileLocalPathPrefix             string      `json:"fileLocalPathPrefix"`
+	FileWhitelistPathPrefix         string      `json:"fileWhitelistPathPrefix"`
+	GuiAudit                        string      `json:"guiAudit"`
+	GuiExpiredCertAlert             string      `json:"guiExpiredCertAlert"`
+	GuiSecurityBanner               string      `json:"guiSecurityBanner"`
+	GuiSecurityBannerText           string      `json:"guiSecurityBannerText"`
+	GuiSetup                        string      `json:"guiSetup"`
+	HostAddrMode                    string      `json:"hostAddrMode"`
+	Hostname                        string      `json:"hostname"`
+	LcdDisplay                      string      `json:"lcdDisplay"`
+	LedLocator                      string      `json:"ledLocator"`
+	MgmtDhcp                        string      `json:"mgmtDhcp"`
+	NetReboot                       string      `json:"netReboot"`
+	PasswordPrompt                  string      `json:"passwordPrompt"`
+	QuietBoot                       string      `json:"quietBoot"`
+	SSHMaxSessionLimit              int         `json:"sshMaxSessionLimit"`
+	SSHMaxSessionLimitPerUser       int         `json:"sshMaxSessionLimitPerUser"`
+	SSHRootSessionLimit             string      `json:"sshRootSessionLimit"`
+	SSHSessionLimit                 string      `json:"sshSessionLimit"`
+	UsernamePrompt                  string      `json:"usernamePrompt"`
+	Extra                           bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes GlobalSettings does not model in Extra.
+func (g *GlobalSettings) UnmarshalJSON(data []byte) error {
+	type plain GlobalSettings
+	return bigip.UnmarshalExtra(data, (*plain)(g), &g.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of GlobalSettings.
+func (g GlobalSettings) MarshalJSON() ([]byte, error) {
+	type plain GlobalSettings
+	return bigip.MarshalExtra(plain(g), g.Extra)
 }
 
 // GlobalSettingsResource provides an API to manage GlobalSettings configurations.
@@ -51,9 +64,14 @@ type GlobalSettingsResource struct {
 // about global-settting configuration link to https://clouddocs.f5.com/cli/tmsh-reference/v15/modules/sys/sys_global-settings.html
 // List retrieves all GlobalSettings details.
 func (r *GlobalSettingsResource) Show() (*GlobalSettings, error) {
+	return r.ShowContext(context.Background())
+}
+
+// ShowContext is like Show but uses ctx for the request.
+func (r *GlobalSettingsResource) ShowContext(ctx context.Context) (*GlobalSettings, error) {
 	var items GlobalSettings
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(GlobalSettingsEndpoint).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -66,13 +84,38 @@ func (r *GlobalSettingsResource) Show() (*GlobalSettings, error) {
 
 // Update modifies the GlobalSettings item identified by the GlobalSettings name.
 func (r *GlobalSettingsResource) Update(name string, item GlobalSettings) error {
+	return r.UpdateContext(context.Background(), name, item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *GlobalSettingsResource) UpdateContext(ctx context.Context, name string, item GlobalSettings) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(GlobalSettingsEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (r *GlobalSettingsResource) Patch(name string, fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), name, fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *GlobalSettingsResource) PatchContext(ctx context.Context, name string, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
+		Resource(GlobalSettingsEndpoint).ResourceInstance(name).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
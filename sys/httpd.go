@@ -9,38 +9,51 @@ import (
 
 // HTTPDConfig holds the configuration of a single HTTPD.
 type HTTPDConfig struct {
-	Kind                     string   `json:"kind"`
-	SelfLink                 string   `json:"selfLink"`
-	Allow                    []string `json:"allow"`
-	AuthName                 string   `json:"authName"`
-	AuthPamDashboardTimeout  string   `json:"authPamDashboardTimeout"`
-	AuthPamIdleTimeout       int      `json:"authPamIdleTimeout"`
-	AuthPamValidateIP        string   `json:"authPamValidateIp"`
-	FastcgiTimeout           int      `json:"fastcgiTimeout"`
-	FipsCipherVersion        int      `json:"fipsCipherVersion"`
-	HostnameLookup           string   `json:"hostnameLookup"`
-	LogLevel                 string   `json:"logLevel"`
-	MaxClients               int      `json:"maxClients"`
-	RedirectHTTPToHTTPS      string   `json:"redirectHttpToHttps"`
-	RequestBodyMaxTimeout    int      `json:"requestBodyMaxTimeout"`
-	RequestBodyMinRate       int      `json:"requestBodyMinRate"`
-	RequestBodyTimeout       int      `json:"requestBodyTimeout"`
-	RequestHeaderMaxTimeout  int      `json:"requestHeaderMaxTimeout"`
-	RequestHeaderMinRate     int      `json:"requestHeaderMinRate"`
-	RequestHeaderTimeout     int      `json:"requestHeaderTimeout"`
-	SslCertfile              string   `json:"sslCertfile"`
-	SslCertkeyfile           string   `json:"sslCertkeyfile"`
-	SslCiphersuite           string   `json:"sslCiphersuite"`
-	SslOcspDefaultResponder  string   `json:"sslOcspDefaultResponder"`
-	SslOcspEnable            string   `json:"sslOcspEnable"`
-	SslOcspOverrideResponder string   `json:"sslOcspOverrideResponder"`
-	SslOcspResponderTimeout  int      `json:"sslOcspResponderTimeout"`
-	SslOcspResponseMaxAge    int      `json:"sslOcspResponseMaxAge"`
-	SslOcspResponseTimeSkew  int      `json:"sslOcspResponseTimeSkew"`
-	SslPort                  int      `json:"sslPort"`
-	SslProtocol              string   `json:"sslProtocol"`
-	SslVerifyClient          string   `json:"sslVerifyClient"`
-	SslVerifyDepth           int      `json:"sslVerifyDepth"`
+	Kind                     string      `json:"kind"`
+	SelfLink                 string      `json:"selfLink"`
+	Allow                    []string    `json:"allow"`
+	AuthName                 string      `json:"authName"`
+	AuthPamDashboardTimeout  string      `json:"authPamDashboardTimeout"`
+	AuthPamIdleTimeout       int         `json:"authPamIdleTimeout"`
+	AuthPamValidateIP        string      `json:"authPamValidateIp"`
+	FastcgiTimeout           int         `json:"fastcgiTimeout"`
+	FipsCipherVersion        int         `json:"fipsCipherVersion"`
+	HostnameLookup           string      `json:"hostnameLookup"`
+	LogLevel                 string      `json:"logLevel"`
+	MaxClients               int         `json:"maxClients"`
+	RedirectHTTPToHTTPS      string      `json:"redirectHttpToHttps"`
+	RequestBodyMaxTimeout    int         `json:"requestBodyMaxTimeout"`
+	RequestBodyMinRate       int         `json:"requestBodyMinRate"`
+	RequestBodyTimeout       int         `json:"requestBodyTimeout"`
+	RequestHeaderMaxTimeout  int         `json:"requestHeaderMaxTimeout"`
+	RequestHeaderMinRate     int         `json:"requestHeaderMinRate"`
+	RequestHeaderTimeout     int         `json:"requestHeaderTimeout"`
+	SslCertfile              string      `json:"sslCertfile"`
+	SslCertkeyfile           string      `json:"sslCertkeyfile"`
+	SslCiphersuite           string      `json:"sslCiphersuite"`
+	SslOcspDefaultResponder  string      `json:"sslOcspDefaultResponder"`
+	SslOcspEnable            string      `json:"sslOcspEnable"`
+	SslOcspOverrideResponder string      `json:"sslOcspOverrideResponder"`
+	SslOcspResponderTimeout  int         `json:"sslOcspResponderTimeout"`
+	SslOcspResponseMaxAge    int         `json:"sslOcspResponseMaxAge"`
+	SslOcspResponseTimeSkew  int         `json:"sslOcspResponseTimeSkew"`
+	SslPort                  int         `json:"sslPort"`
+	SslProtocol              string      `json:"sslProtocol"`
+	SslVerifyClient          string      `json:"sslVerifyClient"`
+	SslVerifyDepth           int         `json:"sslVerifyDepth"`
+	Extra                    bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes HTTPDConfig does not model in Extra.
+func (h *HTTPDConfig) UnmarshalJSON(data []byte) error {
+	type plain HTTPDConfig
+	return bigip.UnmarshalExtra(data, (*plain)(h), &h.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of HTTPDConfig.
+func (h HTTPDConfig) MarshalJSON() ([]byte, error) {
+	type plain HTTPDConfig
+	return bigip.MarshalExtra(plain(h), h.Extra)
 }
 
 // HTTPDEndpoint represents the REST resource for managing HTTPD.
@@ -53,9 +66,14 @@ type HTTPDResource struct {
 
 // Get the HTTPD configurations.
 func (r *HTTPDResource) Get() (*HTTPDConfig, error) {
+	return r.GetContext(context.Background())
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (r *HTTPDResource) GetContext(ctx context.Context) (*HTTPDConfig, error) {
 	var item HTTPDConfig
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(HTTPDEndpoint).DoRaw(context.Background())
+		Resource(HTTPDEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
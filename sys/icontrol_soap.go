@@ -13,9 +13,22 @@ actively developed. See K98821303 on AskF5 for details.
 */
 // IControlSOAP holds the configuration of a single IControlSOAP.
 type IControlSOAP struct {
-	Kind     string   `json:"kind"`
-	SelfLink string   `json:"selfLink"`
-	Allow    []string `json:"allow"`
+	Kind     string      `json:"kind"`
+	SelfLink string      `json:"selfLink"`
+	Allow    []string    `json:"allow"`
+	Extra    bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes IControlSOAP does not model in Extra.
+func (i *IControlSOAP) UnmarshalJSON(data []byte) error {
+	type plain IControlSOAP
+	return bigip.UnmarshalExtra(data, (*plain)(i), &i.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of IControlSOAP.
+func (i IControlSOAP) MarshalJSON() ([]byte, error) {
+	type plain IControlSOAP
+	return bigip.MarshalExtra(plain(i), i.Extra)
 }
 
 // IControlSOAPEndpoint represents the REST resource for managing IControlSOAP.
@@ -28,9 +41,14 @@ type IControlSOAPResource struct {
 
 // Show retrieves IControlSOAP details.
 func (r *IControlSOAPResource) Show() (*IControlSOAP, error) {
+	return r.ShowContext(context.Background())
+}
+
+// ShowContext is like Show but uses ctx for the request.
+func (r *IControlSOAPResource) ShowContext(ctx context.Context) (*IControlSOAP, error) {
 	var items IControlSOAP
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(IControlSOAPEndpoint).DoRaw(context.Background())
+		Resource(IControlSOAPEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -12,7 +12,7 @@ type IPFixResource struct {
 
 func NewIPFix(b *bigip.BigIP) IPFixResource {
 	return IPFixResource{
-		iPFixElement: IPFixElementResource{b: b},
+		iPFixElement: newIPFixElementResource(b),
 	}
 }
 
@@ -1,9 +1,6 @@
 package ipfix
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
 )
 
@@ -16,16 +13,29 @@ type IPFixElementList struct {
 
 // IPFixElementConfig holds the configuration of a single IPFixElement.
 type IPFixElement struct {
-	DataType     string `json:"dataType"`
-	EnterpriseID int    `json:"enterpriseId"`
-	FullPath     string `json:"fullPath"`
-	Generation   int    `json:"generation"`
-	ID           int    `json:"id"`
-	Kind         string `json:"kind"`
-	Name         string `json:"name"`
-	Partition    string `json:"partition"`
-	SelfLink     string `json:"selfLink"`
-	Size         int    `json:"size"`
+	DataType     string      `json:"dataType"`
+	EnterpriseID int         `json:"enterpriseId"`
+	FullPath     string      `json:"fullPath"`
+	Generation   int         `json:"generation"`
+	ID           int         `json:"id"`
+	Kind         string      `json:"kind"`
+	Name         string      `json:"name"`
+	Partition    string      `json:"partition"`
+	SelfLink     string      `json:"selfLink"`
+	Size         int         `json:"size"`
+	Extra        bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes IPFixElement does not model in Extra.
+func (i *IPFixElement) UnmarshalJSON(data []byte) error {
+	type plain IPFixElement
+	return bigip.UnmarshalExtra(data, (*plain)(i), &i.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of IPFixElement.
+func (i IPFixElement) MarshalJSON() ([]byte, error) {
+	type plain IPFixElement
+	return bigip.MarshalExtra(plain(i), i.Extra)
 }
 
 // IPFixElementEndpoint represents the REST resource for managing IPFixElement.
@@ -33,34 +43,9 @@ const IPFixElementEndpoint = "element"
 
 // IPFixElementResource provides an API to manage IPFixElement configurations.
 type IPFixElementResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all IPFixElement details.
-func (r *IPFixElementResource) List() (*IPFixElementList, error) {
-	var items IPFixElementList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(IPFixEndpoint).SubResource(IPFixElementEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+	bigip.Resource[IPFixElement, IPFixElementList]
 }
 
-// Get retrieves the details of a single IPFixElement by node name.
-func (r *IPFixElementResource) Get(name string) (*IPFixElement, error) {
-	var item IPFixElement
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(IPFixEndpoint).SubResource(IPFixElementEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func newIPFixElementResource(b *bigip.BigIP) IPFixElementResource {
+	return IPFixElementResource{bigip.NewResource[IPFixElement, IPFixElementList](b, SysManager, IPFixEndpoint, IPFixElementEndpoint)}
 }
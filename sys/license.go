@@ -40,6 +40,19 @@ type License struct {
 	Kind     string                 `json:"kind"`
 	SelfLink string                 `json:"selfLink"`
 	Entries  map[string]LicenseData `json:"entries,omitempty"`
+	Extra    bigip.Extra            `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes License does not model in Extra.
+func (l *License) UnmarshalJSON(data []byte) error {
+	type plain License
+	return bigip.UnmarshalExtra(data, (*plain)(l), &l.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of License.
+func (l License) MarshalJSON() ([]byte, error) {
+	type plain License
+	return bigip.MarshalExtra(plain(l), l.Extra)
 }
 
 //type AutoGenerated struct {
@@ -139,9 +152,14 @@ type LicenseResource struct {
 
 // Get retrieves the details of a single License by node name.
 func (r *LicenseResource) Get() (*License, error) {
+	return r.GetContext(context.Background())
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (r *LicenseResource) GetContext(ctx context.Context) (*License, error) {
 	var item License
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LicenseEndpoint).DoRaw(context.Background())
+		Resource(LicenseEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +176,11 @@ type licenseKey struct {
 
 // The Activate method activates a license using the given registration key.
 func (r *LicenseResource) Activate(registrationKey string) error {
+	return r.ActivateContext(context.Background(), registrationKey)
+}
+
+// ActivateContext is like Activate but uses ctx for the request.
+func (r *LicenseResource) ActivateContext(ctx context.Context, registrationKey string) error {
 	item := licenseKey{
 		Command:         "install",
 		RegistrationKey: registrationKey,
@@ -169,7 +192,7 @@ func (r *LicenseResource) Activate(registrationKey string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LicenseEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(LicenseEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -1,11 +1,7 @@
 package sys
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ManagementIPConfigList holds a list of ManagementIP configuration.
@@ -17,87 +13,34 @@ type ManagementIPList struct {
 
 // ManagementIPConfig holds the configuration of a single ManagementIP.
 type ManagementIP struct {
-	FullPath   string `json:"fullPath"`
-	Generation int    `json:"generation"`
-	Kind       string `json:"kind"`
-	Name       string `json:"name"`
-	SelfLink   string `json:"selfLink"`
+	FullPath   string      `json:"fullPath"`
+	Generation int         `json:"generation"`
+	Kind       string      `json:"kind"`
+	Name       string      `json:"name"`
+	SelfLink   string      `json:"selfLink"`
+	Extra      bigip.Extra `json:"-"`
 }
 
-// ManagementIPEndpoint represents the REST resource for managing ManagementIP.
-const ManagementIPEndpoint = "management-ip"
-
-// ManagementIPResource provides an API to manage ManagementIP configurations.
-type ManagementIPResource struct {
-	b *bigip.BigIP
-}
-
-// List all management IP details
-func (r *ManagementIPResource) List() (*ManagementIPList, error) {
-	var items ManagementIPList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementIPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes ManagementIP does not model in Extra.
+func (m *ManagementIP) UnmarshalJSON(data []byte) error {
+	type plain ManagementIP
+	return bigip.UnmarshalExtra(data, (*plain)(m), &m.Extra)
 }
 
-// Get a single management IP details by the node name
-func (r *ManagementIPResource) Get(name string) (*ManagementIP, error) {
-	var item ManagementIP
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementIPEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of ManagementIP.
+func (m ManagementIP) MarshalJSON() ([]byte, error) {
+	type plain ManagementIP
+	return bigip.MarshalExtra(plain(m), m.Extra)
 }
 
-// Create a new management IP item
-func (r *ManagementIPResource) Create(item ManagementIP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementIPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// ManagementIPEndpoint represents the REST resource for managing ManagementIP.
+const ManagementIPEndpoint = "management-ip"
 
-// Update the management IP item identified by the management IP name, otherwise an error will be reported.
-func (r *ManagementIPResource) Update(name string, item ManagementIP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementIPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// ManagementIPResource provides an API to manage ManagementIP configurations.
+type ManagementIPResource struct {
+	bigip.Resource[ManagementIP, ManagementIPList]
 }
 
-// Delete a single management IP identified by the management IP name. if it is not exist return error
-func (r *ManagementIPResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementIPEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newManagementIPResource(b *bigip.BigIP) ManagementIPResource {
+	return ManagementIPResource{bigip.NewResource[ManagementIP, ManagementIPList](b, SysManager, ManagementIPEndpoint)}
 }
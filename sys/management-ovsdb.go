@@ -33,6 +33,19 @@ type ManagementOVSDB struct {
 		Sequence        string    `json:"sequence"`
 		Version         string    `json:"version"`
 	} `json:"apiRawValues"`
+	Extra bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes ManagementOVSDB does not model in Extra.
+func (m *ManagementOVSDB) UnmarshalJSON(data []byte) error {
+	type plain ManagementOVSDB
+	return bigip.UnmarshalExtra(data, (*plain)(m), &m.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of ManagementOVSDB.
+func (m ManagementOVSDB) MarshalJSON() ([]byte, error) {
+	type plain ManagementOVSDB
+	return bigip.MarshalExtra(plain(m), m.Extra)
 }
 
 // ManagementOVSDBEndpoint represents the REST resource for managing ManagementOVSDB.
@@ -45,9 +58,14 @@ type ManagementOVSDBResource struct {
 
 // List all management OVSDB details
 func (r *ManagementOVSDBResource) List() (*ManagementOVSDB, error) {
+	return r.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (r *ManagementOVSDBResource) ListContext(ctx context.Context) (*ManagementOVSDB, error) {
 	var items ManagementOVSDB
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementOVSDBEndpoint).DoRaw(context.Background())
+		Resource(ManagementOVSDBEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -60,13 +78,38 @@ func (r *ManagementOVSDBResource) List() (*ManagementOVSDB, error) {
 
 // Update the management OVSDB item identified by the management OVSDB name, otherwise an error will be reported.
 func (r *ManagementOVSDBResource) Update(name string, item ManagementOVSDB) error {
+	return r.UpdateContext(context.Background(), name, item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *ManagementOVSDBResource) UpdateContext(ctx context.Context, name string, item ManagementOVSDB) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementOVSDBEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(ManagementOVSDBEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (r *ManagementOVSDBResource) Patch(name string, fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), name, fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *ManagementOVSDBResource) PatchContext(ctx context.Context, name string, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
+		Resource(ManagementOVSDBEndpoint).ResourceInstance(name).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -75,8 +118,13 @@ func (r *ManagementOVSDBResource) Update(name string, item ManagementOVSDB) erro
 
 // Delete a single management OVSDB identified by the management OVSDB name. if it is not exist return error
 func (r *ManagementOVSDBResource) Delete(name string) error {
+	return r.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (r *ManagementOVSDBResource) DeleteContext(ctx context.Context, name string) error {
 	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementOVSDBEndpoint).ResourceInstance(name).DoRaw(context.Background())
+		Resource(ManagementOVSDBEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
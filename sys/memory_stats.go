@@ -35,7 +35,7 @@ type MemoryStats struct {
 		} `json:"hostId,omitempty"`
 		MaxAllocated struct {
 			Value int `json:"value"`
-		} `json maxAllocated,omitempty"`
+		} `json:"maxAllocated,omitempty"`
 		MemoryFree struct {
 			Value int `json:"value"`
 		} `json:"memoryFree,omitempty"`
@@ -93,9 +93,14 @@ type MemoryStatsResource struct {
 }
 
 func (r *MemoryStatsResource) All() (*MemoryStatsList, error) {
+	return r.AllContext(context.Background())
+}
+
+// AllContext is like All but uses ctx for the request.
+func (r *MemoryStatsResource) AllContext(ctx context.Context) (*MemoryStatsList, error) {
 	var items MemoryStatsList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(MemoryStatsEndpoint).DoRaw(context.Background())
+		Resource(MemoryStatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
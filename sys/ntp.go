@@ -15,9 +15,22 @@ type NTP struct {
 		IsSubcollection bool   `json:"isSubcollection,omitempty"`
 		Link            string `json:"link,omitempty"`
 	} `json:"restrictReference,omitempty"`
-	SelfLink string   `json:"selfLink,omitempty"`
-	Servers  []string `json:"servers,omitempty"`
-	Timezone string   `json:"timezone,omitempty"`
+	SelfLink string      `json:"selfLink,omitempty"`
+	Servers  []string    `json:"servers,omitempty"`
+	Timezone string      `json:"timezone,omitempty"`
+	Extra    bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes NTP does not model in Extra.
+func (n *NTP) UnmarshalJSON(data []byte) error {
+	type plain NTP
+	return bigip.UnmarshalExtra(data, (*plain)(n), &n.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of NTP.
+func (n NTP) MarshalJSON() ([]byte, error) {
+	type plain NTP
+	return bigip.MarshalExtra(plain(n), n.Extra)
 }
 
 // NTPEndpoint represents the REST resource for managing NTP.
@@ -30,9 +43,14 @@ type NTPResource struct {
 
 // Get a single ntp configuration identified by fullPathName.
 func (nr *NTPResource) Get() (*NTP, error) {
+	return nr.GetContext(context.Background())
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (nr *NTPResource) GetContext(ctx context.Context) (*NTP, error) {
 	var ntp NTP
 	res, err := nr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(NTPEndpoint).DoRaw(context.Background())
+		Resource(NTPEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -45,13 +63,18 @@ func (nr *NTPResource) Get() (*NTP, error) {
 
 // Create a new ntp configuration.
 func (nr *NTPResource) Create(item NTP) error {
+	return nr.CreateContext(context.Background(), item)
+}
+
+// CreateContext is like Create but uses ctx for the request.
+func (nr *NTPResource) CreateContext(ctx context.Context, item NTP) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = nr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(NTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(NTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -60,13 +83,38 @@ func (nr *NTPResource) Create(item NTP) error {
 
 // Edit a ntp configuration identified by name.
 func (nr *NTPResource) Update(name string, item NTP) error {
+	return nr.UpdateContext(context.Background(), name, item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (nr *NTPResource) UpdateContext(ctx context.Context, name string, item NTP) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = nr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(NTPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(NTPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (nr *NTPResource) Patch(name string, fields any, mask ...string) error {
+	return nr.PatchContext(context.Background(), name, fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (nr *NTPResource) PatchContext(ctx context.Context, name string, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = nr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
+		Resource(NTPEndpoint).ResourceInstance(name).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -74,10 +122,15 @@ func (nr *NTPResource) Update(name string, item NTP) error {
 }
 
 func (nr *NTPResource) AddServersForNTP(rs ...string) error {
+	return nr.AddServersForNTPContext(context.Background(), rs...)
+}
+
+// AddServersForNTPContext is like AddServersForNTP but uses ctx for the request.
+func (nr *NTPResource) AddServersForNTPContext(ctx context.Context, rs ...string) error {
 	if len(rs) == 0 {
 		return nil
 	}
-	item, err := nr.Get()
+	item, err := nr.GetContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -89,7 +142,7 @@ func (nr *NTPResource) AddServersForNTP(rs ...string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = nr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(NTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(NTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -99,8 +152,13 @@ func (nr *NTPResource) AddServersForNTP(rs ...string) error {
 
 // Delete a single ntp configuration identified by name.
 func (nr *NTPResource) Delete(name string) error {
+	return nr.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (nr *NTPResource) DeleteContext(ctx context.Context, name string) error {
 	_, err := nr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(NTPEndpoint).ResourceInstance(name).DoRaw(context.Background())
+		Resource(NTPEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
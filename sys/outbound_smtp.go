@@ -9,10 +9,23 @@ import (
 
 // OutboundSMTP holds the configuration of a single OutboundSMTP.
 type OutboundSMTP struct {
-	Kind             string `json:"kind"`
-	SelfLink         string `json:"selfLink"`
-	FromLineOverride string `json:"fromLineOverride"`
-	Mailhub          string `json:"mailhub"`
+	Kind             string      `json:"kind"`
+	SelfLink         string      `json:"selfLink"`
+	FromLineOverride string      `json:"fromLineOverride"`
+	Mailhub          string      `json:"mailhub"`
+	Extra            bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes OutboundSMTP does not model in Extra.
+func (o *OutboundSMTP) UnmarshalJSON(data []byte) error {
+	type plain OutboundSMTP
+	return bigip.UnmarshalExtra(data, (*plain)(o), &o.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of OutboundSMTP.
+func (o OutboundSMTP) MarshalJSON() ([]byte, error) {
+	type plain OutboundSMTP
+	return bigip.MarshalExtra(plain(o), o.Extra)
 }
 
 // OutboundSMTPEndpoint represents the REST resource for managing OutboundSMTP.
@@ -25,9 +38,14 @@ type OutboundSMTPResource struct {
 
 // Show retrieves all OutboundSMTP details.
 func (r *OutboundSMTPResource) Show() (*OutboundSMTP, error) {
+	return r.ShowContext(context.Background())
+}
+
+// ShowContext is like Show but uses ctx for the request.
+func (r *OutboundSMTPResource) ShowContext(ctx context.Context) (*OutboundSMTP, error) {
 	var item OutboundSMTP
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(OutboundSMTPEndpoint).DoRaw(context.Background())
+		Resource(OutboundSMTPEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
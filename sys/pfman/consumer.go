@@ -1,11 +1,7 @@
 package pfman
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // ConsumerList holds a list of Consumer configurations.
@@ -17,81 +13,29 @@ type ConsumerList struct {
 
 // Consumer holds the configuration of a single Consumer.
 type Consumer struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// ConsumerEndpoint represents the REST resource for managing Consumer.
-const ConsumerEndpoint = "consumer"
-
-// ConsumerResource provides an API to manage Consumer configurations.
-type ConsumerResource struct {
-	b *bigip.BigIP
+// UnmarshalJSON keeps the attributes Consumer does not model in Extra.
+func (c *Consumer) UnmarshalJSON(data []byte) error {
+	type plain Consumer
+	return bigip.UnmarshalExtra(data, (*plain)(c), &c.Extra)
 }
 
-// List retrieves all Consumer details.
-func (r *ConsumerResource) List() (*ConsumerList, error) {
-	var items ConsumerList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(ConsumerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// MarshalJSON adds the attributes in Extra to those of Consumer.
+func (c Consumer) MarshalJSON() ([]byte, error) {
+	type plain Consumer
+	return bigip.MarshalExtra(plain(c), c.Extra)
 }
 
-// Get retrieves the details of a single Consumer by node name.
-func (r *ConsumerResource) Get(name string) (*Consumer, error) {
-	var item Consumer
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(ConsumerEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
-}
-
-// Create creates a new Consumer item.
-func (r *ConsumerResource) Create(item Consumer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(ConsumerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// ConsumerEndpoint represents the REST resource for managing Consumer.
+const ConsumerEndpoint = "consumer"
 
-// Update modifies the Consumer item identified by the Consumer name.
-func (r *ConsumerResource) Update(name string, item Consumer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(ConsumerEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// ConsumerResource provides an API to manage Consumer configurations.
+type ConsumerResource struct {
+	bigip.Resource[Consumer, ConsumerList]
 }
 
-// Delete a single Consumer identified by the Consumer name. If it does not exist, return an error.
-func (r *ConsumerResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(ConsumerEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func newConsumerResource(b *bigip.BigIP) ConsumerResource {
+	return ConsumerResource{bigip.NewResource[Consumer, ConsumerList](b, SysManager, PFManEndpoint, ConsumerEndpoint)}
 }
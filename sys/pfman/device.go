@@ -1,11 +1,7 @@
 package pfman
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // DeviceList holds a list of Device configurations.
@@ -17,82 +13,29 @@ type DeviceList struct {
 
 // Device holds the configuration of a single Device.
 type Device struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// DeviceEndpoint represents the REST resource for managing Device.
-const DeviceEndpoint = "device"
-
-// DeviceResource provides an API to manage Device configurations.
-type DeviceResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all Device details.
-func (r *DeviceResource) List() (*DeviceList, error) {
-	var items DeviceList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(DeviceEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Device does not model in Extra.
+func (d *Device) UnmarshalJSON(data []byte) error {
+	type plain Device
+	return bigip.UnmarshalExtra(data, (*plain)(d), &d.Extra)
 }
 
-// Get retrieves the details of a single Device by node name.
-func (r *DeviceResource) Get(name string) (*Device, error) {
-	var item Device
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(DeviceEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of Device.
+func (d Device) MarshalJSON() ([]byte, error) {
+	type plain Device
+	return bigip.MarshalExtra(plain(d), d.Extra)
 }
 
-// Create creates a new Device item.
-func (r *DeviceResource) Create(item Device) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(DeviceEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// DeviceEndpoint represents the REST resource for managing Device.
+const DeviceEndpoint = "device"
 
-// Update modifies the Device item identified by the Device name.
-func (r *DeviceResource) Update(name string, item Device) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(DeviceEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// DeviceResource provides an API to manage Device configurations.
+type DeviceResource struct {
+	bigip.Resource[Device, DeviceList]
 }
 
-// Delete a single Device identified by the Device name. If it does not exist, return an error.
-func (r *DeviceResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(DeviceEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newDeviceResource(b *bigip.BigIP) DeviceResource {
+	return DeviceResource{bigip.NewResource[Device, DeviceList](b, SysManager, PFManEndpoint, DeviceEndpoint)}
 }
@@ -14,8 +14,8 @@ type PFManResource struct {
 
 func NewPFMan(b *bigip.BigIP) PFManResource {
 	return PFManResource{
-		device:   DeviceResource{b: b},
-		consumer: ConsumerResource{b: b},
+		device:   newDeviceResource(b),
+		consumer: newConsumerResource(b),
 	}
 }
 
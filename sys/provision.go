@@ -1,9 +1,6 @@
 package sys
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
 )
 
@@ -16,15 +13,28 @@ type ProvisionList struct {
 
 // Provision holds the configuration of a single Provision.
 type Provision struct {
-	Kind        string `json:"kind"`
-	Name        string `json:"name"`
-	FullPath    string `json:"fullPath"`
-	Generation  int    `json:"generation"`
-	SelfLink    string `json:"selfLink"`
-	CPURatio    int    `json:"cpuRatio"`
-	DiskRatio   int    `json:"diskRatio"`
-	Level       string `json:"level"`
-	MemoryRatio int    `json:"memoryRatio"`
+	Kind        string      `json:"kind"`
+	Name        string      `json:"name"`
+	FullPath    string      `json:"fullPath"`
+	Generation  int         `json:"generation"`
+	SelfLink    string      `json:"selfLink"`
+	CPURatio    int         `json:"cpuRatio"`
+	DiskRatio   int         `json:"diskRatio"`
+	Level       string      `json:"level"`
+	MemoryRatio int         `json:"memoryRatio"`
+	Extra       bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Provision does not model in Extra.
+func (p *Provision) UnmarshalJSON(data []byte) error {
+	type plain Provision
+	return bigip.UnmarshalExtra(data, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Provision.
+func (p Provision) MarshalJSON() ([]byte, error) {
+	type plain Provision
+	return bigip.MarshalExtra(plain(p), p.Extra)
 }
 
 // ProvisionEndpoint represents the REST resource for managing Provision.
@@ -32,34 +42,9 @@ const ProvisionEndpoint = "provision"
 
 // ProvisionResource provides an API to manage Provision configurations.
 type ProvisionResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all Provision details.
-func (r *ProvisionResource) List() (*ProvisionList, error) {
-	var items ProvisionList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ProvisionEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+	bigip.Resource[Provision, ProvisionList]
 }
 
-// Get retrieves the details of a single Provision by node name.
-func (r *ProvisionResource) Get(name string) (*Provision, error) {
-	var item Provision
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ProvisionEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func newProvisionResource(b *bigip.BigIP) ProvisionResource {
+	return ProvisionResource{bigip.NewResource[Provision, ProvisionList](b, SysManager, ProvisionEndpoint)}
 }
@@ -47,9 +47,14 @@ type DiskResource struct {
 
 // Get a single logical disk details by the node name
 func (r *DiskResource) Show() (*DiskList, error) {
+	return r.ShowContext(context.Background())
+}
+
+// ShowContext is like Show but uses ctx for the request.
+func (r *DiskResource) ShowContext(ctx context.Context) (*DiskList, error) {
 	var item DiskList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(RAIDEndpoint).Resource(DiskEndpoint).DoRaw(context.Background())
+		Resource(RAIDEndpoint).Resource(DiskEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,7 @@
 package sys
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // SMTPServerList holds a list of SMTPServer configurations.
@@ -17,83 +13,29 @@ type SMTPServerList struct {
 
 // SMTPServer holds the configuration of a single SMTPServer.
 type SMTPServer struct {
-	// ... Fields representing the SMTPServer configuration
+	Extra bigip.Extra `json:"-"`
 }
 
-// SMTPServerEndpoint represents the REST resource for managing SMTPServer.
-const SMTPServerEndpoint = "smtp-server"
-
-// SMTPServerResource provides an API to manage SMTPServer configurations.
-type SMTPServerResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all SMTPServer details.
-func (r *SMTPServerResource) List() (*SMTPServerList, error) {
-	var items SMTPServerList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SMTPServerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes SMTPServer does not model in Extra.
+func (s *SMTPServer) UnmarshalJSON(data []byte) error {
+	type plain SMTPServer
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
 }
 
-// Get retrieves the details of a single SMTPServer by node name.
-func (r *SMTPServerResource) Get(name string) (*SMTPServer, error) {
-	var item SMTPServer
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SMTPServerEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of SMTPServer.
+func (s SMTPServer) MarshalJSON() ([]byte, error) {
+	type plain SMTPServer
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
-// Create creates a new SMTPServer item.
-func (r *SMTPServerResource) Create(item SMTPServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SMTPServerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// SMTPServerEndpoint represents the REST resource for managing SMTPServer.
+const SMTPServerEndpoint = "smtp-server"
 
-// Update modifies the SMTPServer item identified by the SMTPServer name.
-func (r *SMTPServerResource) Update(name string, item SMTPServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SMTPServerEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// SMTPServerResource provides an API to manage SMTPServer configurations.
+type SMTPServerResource struct {
+	bigip.Resource[SMTPServer, SMTPServerList]
 }
 
-// Delete a single SMTPServer identified by the SMTPServer name. if it is not exist return error
-func (r *SMTPServerResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SMTPServerEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newSMTPServerResource(b *bigip.BigIP) SMTPServerResource {
+	return SMTPServerResource{bigip.NewResource[SMTPServer, SMTPServerList](b, SysManager, SMTPServerEndpoint)}
 }
@@ -55,6 +55,19 @@ type SNMP struct {
 		Link            string `json:"link"`
 		IsSubcollection bool   `json:"isSubcollection"`
 	} `json:"usersReference"`
+	Extra bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes SNMP does not model in Extra.
+func (s *SNMP) UnmarshalJSON(data []byte) error {
+	type plain SNMP
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of SNMP.
+func (s SNMP) MarshalJSON() ([]byte, error) {
+	type plain SNMP
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
 // SNMPEndpoint represents the REST resource for managing SNMP.
@@ -67,9 +80,14 @@ type SNMPResource struct {
 
 // list all the snmp configurations.
 func (sr *SNMPResource) Get() (*SNMP, error) {
+	return sr.GetContext(context.Background())
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (sr *SNMPResource) GetContext(ctx context.Context) (*SNMP, error) {
 	var sl SNMP
 	res, err := sr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SNMPEndpoint).DoRaw(context.Background())
+		Resource(SNMPEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -82,13 +100,18 @@ func (sr *SNMPResource) Get() (*SNMP, error) {
 
 // Create a new snmp configuration.
 func (sr *SNMPResource) Create(item SNMP) error {
+	return sr.CreateContext(context.Background(), item)
+}
+
+// CreateContext is like Create but uses ctx for the request.
+func (sr *SNMPResource) CreateContext(ctx context.Context, item SNMP) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = sr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SNMPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(SNMPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -97,13 +120,38 @@ func (sr *SNMPResource) Create(item SNMP) error {
 
 // Edit a snmp configuration identified by name.
 func (sr *SNMPResource) Update(name string, item SNMP) error {
+	return sr.UpdateContext(context.Background(), name, item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (sr *SNMPResource) UpdateContext(ctx context.Context, name string, item SNMP) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = sr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SNMPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(SNMPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (sr *SNMPResource) Patch(name string, fields any, mask ...string) error {
+	return sr.PatchContext(context.Background(), name, fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (sr *SNMPResource) PatchContext(ctx context.Context, name string, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = sr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
+		Resource(SNMPEndpoint).ResourceInstance(name).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -112,8 +160,13 @@ func (sr *SNMPResource) Update(name string, item SNMP) error {
 
 // Delete a single snmp configuration identified by name.
 func (sr *SNMPResource) Delete(name string) error {
+	return sr.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (sr *SNMPResource) DeleteContext(ctx context.Context, name string) error {
 	_, err := sr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SNMPEndpoint).ResourceInstance(name).DoRaw(context.Background())
+		Resource(SNMPEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
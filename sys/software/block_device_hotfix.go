@@ -1,11 +1,7 @@
 package software
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // BlockDeviceHotfixList holds a list of BlockDeviceHotfix configurations.
@@ -17,82 +13,29 @@ type BlockDeviceHotfixList struct {
 
 // BlockDeviceHotfix holds the configuration of a single BlockDeviceHotfix.
 type BlockDeviceHotfix struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// BlockDeviceHotfixEndpoint represents the REST resource for managing BlockDeviceHotfix.
-const BlockDeviceHotfixEndpoint = "block-device-hotfix"
-
-// BlockDeviceHotfixResource provides an API to manage BlockDeviceHotfix configurations.
-type BlockDeviceHotfixResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all BlockDeviceHotfix details.
-func (r *BlockDeviceHotfixResource) List() (*BlockDeviceHotfixList, error) {
-	var items BlockDeviceHotfixList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceHotfixEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes BlockDeviceHotfix does not model in Extra.
+func (b *BlockDeviceHotfix) UnmarshalJSON(data []byte) error {
+	type plain BlockDeviceHotfix
+	return bigip.UnmarshalExtra(data, (*plain)(b), &b.Extra)
 }
 
-// Get retrieves the details of a single BlockDeviceHotfix by node name.
-func (r *BlockDeviceHotfixResource) Get(name string) (*BlockDeviceHotfix, error) {
-	var item BlockDeviceHotfix
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceHotfixEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of BlockDeviceHotfix.
+func (b BlockDeviceHotfix) MarshalJSON() ([]byte, error) {
+	type plain BlockDeviceHotfix
+	return bigip.MarshalExtra(plain(b), b.Extra)
 }
 
-// Create creates a new BlockDeviceHotfix item.
-func (r *BlockDeviceHotfixResource) Create(item BlockDeviceHotfix) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceHotfixEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// BlockDeviceHotfixEndpoint represents the REST resource for managing BlockDeviceHotfix.
+const BlockDeviceHotfixEndpoint = "block-device-hotfix"
 
-// Update modifies the BlockDeviceHotfix item identified by the BlockDeviceHotfix name.
-func (r *BlockDeviceHotfixResource) Update(name string, item BlockDeviceHotfix) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceHotfixEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// BlockDeviceHotfixResource provides an API to manage BlockDeviceHotfix configurations.
+type BlockDeviceHotfixResource struct {
+	bigip.Resource[BlockDeviceHotfix, BlockDeviceHotfixList]
 }
 
-// Delete a single BlockDeviceHotfix identified by the BlockDeviceHotfix name. if it is not exist return error
-func (r *BlockDeviceHotfixResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceHotfixEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newBlockDeviceHotfixResource(b *bigip.BigIP) BlockDeviceHotfixResource {
+	return BlockDeviceHotfixResource{bigip.NewResource[BlockDeviceHotfix, BlockDeviceHotfixList](b, SysManager, SoftwareEndpoint, BlockDeviceHotfixEndpoint)}
 }
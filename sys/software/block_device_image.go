@@ -1,11 +1,7 @@
 package software
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // BlockDeviceImageList holds a list of BlockDeviceImage configurations.
@@ -17,82 +13,29 @@ type BlockDeviceImageList struct {
 
 // BlockDeviceImage holds the configuration of a single BlockDeviceImage.
 type BlockDeviceImage struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// BlockDeviceImageEndpoint represents the REST resource for managing BlockDeviceImage.
-const BlockDeviceImageEndpoint = "block-device-image"
-
-// BlockDeviceImageResource provides an API to manage BlockDeviceImage configurations.
-type BlockDeviceImageResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all BlockDeviceImage details.
-func (r *BlockDeviceImageResource) List() (*BlockDeviceImageList, error) {
-	var items BlockDeviceImageList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceImageEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes BlockDeviceImage does not model in Extra.
+func (b *BlockDeviceImage) UnmarshalJSON(data []byte) error {
+	type plain BlockDeviceImage
+	return bigip.UnmarshalExtra(data, (*plain)(b), &b.Extra)
 }
 
-// Get retrieves the details of a single BlockDeviceImage by node name.
-func (r *BlockDeviceImageResource) Get(name string) (*BlockDeviceImage, error) {
-	var item BlockDeviceImage
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceImageEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of BlockDeviceImage.
+func (b BlockDeviceImage) MarshalJSON() ([]byte, error) {
+	type plain BlockDeviceImage
+	return bigip.MarshalExtra(plain(b), b.Extra)
 }
 
-// Create creates a new BlockDeviceImage item.
-func (r *BlockDeviceImageResource) Create(item BlockDeviceImage) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceImageEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// BlockDeviceImageEndpoint represents the REST resource for managing BlockDeviceImage.
+const BlockDeviceImageEndpoint = "block-device-image"
 
-// Update modifies the BlockDeviceImage item identified by the BlockDeviceImage name.
-func (r *BlockDeviceImageResource) Update(name string, item BlockDeviceImage) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceImageEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// BlockDeviceImageResource provides an API to manage BlockDeviceImage configurations.
+type BlockDeviceImageResource struct {
+	bigip.Resource[BlockDeviceImage, BlockDeviceImageList]
 }
 
-// Delete a single BlockDeviceImage identified by the BlockDeviceImage name. if it is not exist return error
-func (r *BlockDeviceImageResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceImageEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newBlockDeviceImageResource(b *bigip.BigIP) BlockDeviceImageResource {
+	return BlockDeviceImageResource{bigip.NewResource[BlockDeviceImage, BlockDeviceImageList](b, SysManager, SoftwareEndpoint, BlockDeviceImageEndpoint)}
 }
@@ -1,11 +1,7 @@
 package software
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // HotfixList holds a list of Hotfix configurations.
@@ -17,94 +13,41 @@ type HotfixList struct {
 
 // Hotfix holds the configuration of a single Hotfix.
 type Hotfix struct {
-	Build      string `json:"build"`
-	Checksum   string `json:"checksum"`
-	FullPath   string `json:"fullPath"`
-	Generation int    `json:"generation"`
-	ID         string `json:"id"`
-	Kind       string `json:"kind"`
-	Name       string `json:"name"`
-	Product    string `json:"product"`
-	SelfLink   string `json:"selfLink"`
-	Title      string `json:"title"`
-	Verified   string `json:"verified"`
-	Version    string `json:"version"`
+	Build      string      `json:"build"`
+	Checksum   string      `json:"checksum"`
+	FullPath   string      `json:"fullPath"`
+	Generation int         `json:"generation"`
+	ID         string      `json:"id"`
+	Kind       string      `json:"kind"`
+	Name       string      `json:"name"`
+	Product    string      `json:"product"`
+	SelfLink   string      `json:"selfLink"`
+	Title      string      `json:"title"`
+	Verified   string      `json:"verified"`
+	Version    string      `json:"version"`
+	Extra      bigip.Extra `json:"-"`
 }
 
-// HotfixEndpoint represents the REST resource for managing Hotfix.
-const HotfixEndpoint = "hotfix"
-
-// HotfixResource provides an API to manage Hotfix configurations.
-type HotfixResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all Hotfix details.
-func (r *HotfixResource) List() (*HotfixList, error) {
-	var items HotfixList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(HotfixEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Hotfix does not model in Extra.
+func (h *Hotfix) UnmarshalJSON(data []byte) error {
+	type plain Hotfix
+	return bigip.UnmarshalExtra(data, (*plain)(h), &h.Extra)
 }
 
-// Get retrieves the details of a single Hotfix by node name.
-func (r *HotfixResource) Get(name string) (*Hotfix, error) {
-	var item Hotfix
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(HotfixEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of Hotfix.
+func (h Hotfix) MarshalJSON() ([]byte, error) {
+	type plain Hotfix
+	return bigip.MarshalExtra(plain(h), h.Extra)
 }
 
-// Create creates a new Hotfix item.
-func (r *HotfixResource) Create(item Hotfix) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(HotfixEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// HotfixEndpoint represents the REST resource for managing Hotfix.
+const HotfixEndpoint = "hotfix"
 
-// Update modifies the Hotfix item identified by the Hotfix name.
-func (r *HotfixResource) Update(name string, item Hotfix) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(HotfixEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// HotfixResource provides an API to manage Hotfix configurations.
+type HotfixResource struct {
+	bigip.Resource[Hotfix, HotfixList]
 }
 
-// Delete a single Hotfix identified by the Hotfix name. if it is not exist return error
-func (r *HotfixResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(HotfixEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newHotfixResource(b *bigip.BigIP) HotfixResource {
+	return HotfixResource{bigip.NewResource[Hotfix, HotfixList](b, SysManager, SoftwareEndpoint, HotfixEndpoint)}
 }
@@ -24,13 +24,13 @@ type SoftwareResource struct {
 
 func NewSoftware(b *bigip.BigIP) SoftwareResource {
 	return SoftwareResource{
-		image:             ImageResource{b: b},
+		image:             newImageResource(b),
 		update:            UpdateResource{b: b},
-		updateStatus:      UpdateStatusResource{b: b},
-		volume:            VolumeResource{b: b},
-		blockDeviceHotfix: BlockDeviceHotfixResource{b: b},
-		blockDeviceImage:  BlockDeviceImageResource{b: b},
-		hotfix:            HotfixResource{b: b},
+		updateStatus:      newUpdateStatusResource(b),
+		volume:            newVolumeResource(b),
+		blockDeviceHotfix: newBlockDeviceHotfixResource(b),
+		blockDeviceImage:  newBlockDeviceImageResource(b),
+		hotfix:            newHotfixResource(b),
 	}
 }
 
@@ -9,13 +9,26 @@ import (
 
 // Update holds the configuration of a single Update.
 type Update struct {
-	Kind          string `json:"kind"`
-	SelfLink      string `json:"selfLink"`
-	AutoCheck     string `json:"autoCheck"`
-	AutoPhonehome string `json:"autoPhonehome"`
-	CheckStatus   string `json:"checkStatus"`
-	Errors        int    `json:"errors"`
-	Frequency     string `json:"frequency"`
+	Kind          string      `json:"kind"`
+	SelfLink      string      `json:"selfLink"`
+	AutoCheck     string      `json:"autoCheck"`
+	AutoPhonehome string      `json:"autoPhonehome"`
+	CheckStatus   string      `json:"checkStatus"`
+	Errors        int         `json:"errors"`
+	Frequency     string      `json:"frequency"`
+	Extra         bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Update does not model in Extra.
+func (u *Update) UnmarshalJSON(data []byte) error {
+	type plain Update
+	return bigip.UnmarshalExtra(data, (*plain)(u), &u.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Update.
+func (u Update) MarshalJSON() ([]byte, error) {
+	type plain Update
+	return bigip.MarshalExtra(plain(u), u.Extra)
 }
 
 // UpdateEndpoint represents the REST resource for managing Update.
@@ -27,10 +40,15 @@ type UpdateResource struct {
 }
 
 // Show retrieves the details of a single Update
-func (r *UpdateStatusResource) Show() (*Update, error) {
+func (r *UpdateResource) Show() (*Update, error) {
+	return r.ShowContext(context.Background())
+}
+
+// ShowContext is like Show but uses ctx for the request.
+func (r *UpdateResource) ShowContext(ctx context.Context) (*Update, error) {
 	var item Update
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateEndpoint).DoRaw(context.Background())
+		Resource(SoftwareEndpoint).SubResource(UpdateEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,7 @@
 package software
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // VolumeList holds a list of UpdateStatus configurations.
@@ -39,82 +35,29 @@ type UpdateStatus struct {
 			Link string `json:"link"`
 		} `json:"nameReference"`
 	} `json:"media"`
+	Extra bigip.Extra `json:"-"`
 }
 
-// UpdateStatusEndpoint represents the REST resource for managing UpdateStatus.
-const UpdateStatusEndpoint = "update-status"
-
-// UpdateStatusResource provides an API to manage UpdateStatus configurations.
-type UpdateStatusResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all UpdateStatus details.
-func (r *UpdateStatusResource) List() (*UpdateStatusList, error) {
-	var items UpdateStatusList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateStatusEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes UpdateStatus does not model in Extra.
+func (u *UpdateStatus) UnmarshalJSON(data []byte) error {
+	type plain UpdateStatus
+	return bigip.UnmarshalExtra(data, (*plain)(u), &u.Extra)
 }
 
-// Get retrieves the details of a single UpdateStatus by node name.
-func (r *UpdateStatusResource) Get(name string) (*UpdateStatus, error) {
-	var item UpdateStatus
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateStatusEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of UpdateStatus.
+func (u UpdateStatus) MarshalJSON() ([]byte, error) {
+	type plain UpdateStatus
+	return bigip.MarshalExtra(plain(u), u.Extra)
 }
 
-// Create creates a new UpdateStatus item.
-func (r *UpdateStatusResource) Create(item UpdateStatus) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateStatusEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// UpdateStatusEndpoint represents the REST resource for managing UpdateStatus.
+const UpdateStatusEndpoint = "update-status"
 
-// Update modifies the UpdateStatus item identified by the UpdateStatus name.
-func (r *UpdateStatusResource) Update(name string, item UpdateStatus) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateStatusEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// UpdateStatusResource provides an API to manage UpdateStatus configurations.
+type UpdateStatusResource struct {
+	bigip.Resource[UpdateStatus, UpdateStatusList]
 }
 
-// Delete a single UpdateStatus identified by the UpdateStatus name. if it is not exist return error
-func (r *UpdateStatusResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateStatusEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newUpdateStatusResource(b *bigip.BigIP) UpdateStatusResource {
+	return UpdateStatusResource{bigip.NewResource[UpdateStatus, UpdateStatusList](b, SysManager, SoftwareEndpoint, UpdateStatusEndpoint)}
 }
@@ -1,11 +1,7 @@
 package software
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // VolumeList holds a list of Volume configurations.
@@ -39,82 +35,29 @@ type Volume struct {
 			Link string `json:"link"`
 		} `json:"nameReference"`
 	} `json:"media"`
+	Extra bigip.Extra `json:"-"`
 }
 
-// VolumeEndpoint represents the REST resource for managing Volume.
-const VolumeEndpoint = "volume"
-
-// VolumeResource provides an API to manage Volume configurations.
-type VolumeResource struct {
-	b *bigip.BigIP
-}
-
-// List retrieves all Volume details.
-func (r *VolumeResource) List() (*VolumeList, error) {
-	var items VolumeList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(VolumeEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes Volume does not model in Extra.
+func (v *Volume) UnmarshalJSON(data []byte) error {
+	type plain Volume
+	return bigip.UnmarshalExtra(data, (*plain)(v), &v.Extra)
 }
 
-// Get retrieves the details of a single Volume by node name.
-func (r *VolumeResource) Get(name string) (*Volume, error) {
-	var item Volume
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(VolumeEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of Volume.
+func (v Volume) MarshalJSON() ([]byte, error) {
+	type plain Volume
+	return bigip.MarshalExtra(plain(v), v.Extra)
 }
 
-// Create creates a new Volume item.
-func (r *VolumeResource) Create(item Volume) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(VolumeEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// VolumeEndpoint represents the REST resource for managing Volume.
+const VolumeEndpoint = "volume"
 
-// Update modifies the Volume item identified by the Volume name.
-func (r *VolumeResource) Update(name string, item Volume) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(VolumeEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// VolumeResource provides an API to manage Volume configurations.
+type VolumeResource struct {
+	bigip.Resource[Volume, VolumeList]
 }
 
-// Delete a single Volume identified by the Volume name. if it is not exist return error
-func (r *VolumeResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(VolumeEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newVolumeResource(b *bigip.BigIP) VolumeResource {
+	return VolumeResource{bigip.NewResource[Volume, VolumeList](b, SysManager, SoftwareEndpoint, VolumeEndpoint)}
 }
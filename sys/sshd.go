@@ -13,15 +13,28 @@ import (
 
 // SSHD holds the configuration of a single SSHD.
 type SSHD struct {
-	Kind              string   `json:"kind"`
-	SelfLink          string   `json:"selfLink"`
-	Allow             []string `json:"allow"`
-	Banner            string   `json:"banner"`
-	FipsCipherVersion int      `json:"fipsCipherVersion"`
-	InactivityTimeout int      `json:"inactivityTimeout"`
-	LogLevel          string   `json:"logLevel"`
-	Login             string   `json:"login"`
-	Port              int      `json:"port"`
+	Kind              string      `json:"kind"`
+	SelfLink          string      `json:"selfLink"`
+	Allow             []string    `json:"allow"`
+	Banner            string      `json:"banner"`
+	FipsCipherVersion int         `json:"fipsCipherVersion"`
+	InactivityTimeout int         `json:"inactivityTimeout"`
+	LogLevel          string      `json:"logLevel"`
+	Login             string      `json:"login"`
+	Port              int         `json:"port"`
+	Extra             bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes SSHD does not model in Extra.
+func (s *SSHD) UnmarshalJSON(data []byte) error {
+	type plain SSHD
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of SSHD.
+func (s SSHD) MarshalJSON() ([]byte, error) {
+	type plain SSHD
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
 // SSHDEndpoint represents the REST resource for managing SSHD.
@@ -34,9 +47,14 @@ type SSHDResource struct {
 
 // ListAll  lists all the SSHD configurations.
 func (r *SSHDResource) List() (*SSHD, error) {
+	return r.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (r *SSHDResource) ListContext(ctx context.Context) (*SSHD, error) {
 	var item SSHD
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SSHDEndpoint).DoRaw(context.Background())
+		Resource(SSHDEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -5,11 +5,7 @@
 package sys
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // StateMirroringList holds a list of StateMirroring configuration.
@@ -21,82 +17,29 @@ type StateMirroringList struct {
 
 // StateMirroring holds the configuration of a single StateMirroring.
 type StateMirroring struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// StateMirroringEndpoint represents the REST resource for managing StateMirroring.
-const StateMirroringEndpoint = "state-mirroring"
-
-// StateMirroringResource provides an API to manage StateMirroring configurations.
-type StateMirroringResource struct {
-	b *bigip.BigIP
-}
-
-// List all state mirroring details
-func (r *StateMirroringResource) List() (*StateMirroringList, error) {
-	var items StateMirroringList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(StateMirroringEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes StateMirroring does not model in Extra.
+func (s *StateMirroring) UnmarshalJSON(data []byte) error {
+	type plain StateMirroring
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
 }
 
-// Get a single state mirroring details by the node name
-func (r *StateMirroringResource) Get(name string) (*StateMirroring, error) {
-	var item StateMirroring
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(StateMirroringEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of StateMirroring.
+func (s StateMirroring) MarshalJSON() ([]byte, error) {
+	type plain StateMirroring
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
-// Create a new state mirroring item
-func (r *StateMirroringResource) Create(item StateMirroring) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(StateMirroringEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// StateMirroringEndpoint represents the REST resource for managing StateMirroring.
+const StateMirroringEndpoint = "state-mirroring"
 
-// Update the state mirroring item identified by the state mirroring name, otherwise an error will be reported.
-func (r *StateMirroringResource) Update(name string, item StateMirroring) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(StateMirroringEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// StateMirroringResource provides an API to manage StateMirroring configurations.
+type StateMirroringResource struct {
+	bigip.Resource[StateMirroring, StateMirroringList]
 }
 
-// Delete a single state mirroring identified by the state mirroring name. if it is not exist return error
-func (r *StateMirroringResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(StateMirroringEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newStateMirroringResource(b *bigip.BigIP) StateMirroringResource {
+	return StateMirroringResource{bigip.NewResource[StateMirroring, StateMirroringList](b, SysManager, StateMirroringEndpoint)}
 }
@@ -1,11 +1,7 @@
 package sys
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
 )
 
 // SyncSysFilesList holds a list of SyncSysFiles configuration.
@@ -17,82 +13,29 @@ type SyncSysFilesList struct {
 
 // SyncSysFiles holds the configuration of a single SyncSysFiles.
 type SyncSysFiles struct {
+	Extra bigip.Extra `json:"-"`
 }
 
-// SyncSysFilesEndpoint represents the REST resource for managing SyncSysFiles.
-const SyncSysFilesEndpoint = "sync-sys-files"
-
-// SyncSysFilesResource provides an API to manage SyncSysFiles configurations.
-type SyncSysFilesResource struct {
-	b *bigip.BigIP
-}
-
-// List all sync sys files details
-func (r *SyncSysFilesResource) List() (*SyncSysFilesList, error) {
-	var items SyncSysFilesList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyncSysFilesEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// UnmarshalJSON keeps the attributes SyncSysFiles does not model in Extra.
+func (s *SyncSysFiles) UnmarshalJSON(data []byte) error {
+	type plain SyncSysFiles
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
 }
 
-// Get a single sync sys files details by the node name
-func (r *SyncSysFilesResource) Get(name string) (*SyncSysFiles, error) {
-	var item SyncSysFiles
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyncSysFilesEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// MarshalJSON adds the attributes in Extra to those of SyncSysFiles.
+func (s SyncSysFiles) MarshalJSON() ([]byte, error) {
+	type plain SyncSysFiles
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
-// Create a new sync sys files item
-func (r *SyncSysFilesResource) Create(item SyncSysFiles) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyncSysFilesEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// SyncSysFilesEndpoint represents the REST resource for managing SyncSysFiles.
+const SyncSysFilesEndpoint = "sync-sys-files"
 
-// Update the sync sys files item identified by the sync sys files name, otherwise an error will be reported.
-func (r *SyncSysFilesResource) Update(name string, item SyncSysFiles) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyncSysFilesEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// SyncSysFilesResource provides an API to manage SyncSysFiles configurations.
+type SyncSysFilesResource struct {
+	bigip.Resource[SyncSysFiles, SyncSysFilesList]
 }
 
-// Delete a single sync sys files identified by the sync sys files name. if it is not exist return error
-func (r *SyncSysFilesResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyncSysFilesEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func newSyncSysFilesResource(b *bigip.BigIP) SyncSysFilesResource {
+	return SyncSysFilesResource{bigip.NewResource[SyncSysFiles, SyncSysFilesList](b, SysManager, SyncSysFilesEndpoint)}
 }
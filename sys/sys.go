@@ -112,6 +112,7 @@ type Sys struct {
 	stateMirroring StateMirroringResource
 	syncSysFiles   SyncSysFilesResource
 	syslog         SyslogResource
+	uCS            UCSResource
 	//uRLDB                               URLDBResource
 	//uRLDBDownloadResult                 URLDBDownloadResultResource
 	//uRLDBDownloadSchedule               URLDBDownloadScheduleResource
@@ -128,12 +129,12 @@ func New(b *bigip.BigIP) Sys {
 		//classificationSignature: ClassificationSignatureResource{c: b},
 		clock: ClockResource{b: b},
 		//cluster:                 ClusterResource{c: b},
-		connection: ConnectionResource{b: b},
-		console:    ConsoleResource{b: b},
+		connection: newConnectionResource(b),
+		console:    newConsoleResource(b),
 		cpuStats:   CPUStatsResource{b: b},
 		crypto:     crypto.NewCrypto(b),
 
-		dB:       DBResource{b: b},
+		dB:       newDBResource(b),
 		dNS:      DNSResource{b: b},
 		daemonHA: DaemonHAResource{b: b},
 		//daemonLogSettings:                   DaemonLogSettingsResource{c: c},
@@ -192,30 +193,31 @@ func New(b *bigip.BigIP) Sys {
 		//logConfigFilter:       LogConfigFilterResource{c: c},
 		//logConfigPublisher:    LogConfigPublisherResource{c: c},
 		//logRotate:             LogRotateResource{c: c},
-		managementDHCP:  ManagementDHCPResource{b: b},
-		managementIP:    ManagementIPResource{b: b},
+		managementDHCP:  newManagementDHCPResource(b),
+		managementIP:    newManagementIPResource(b),
 		managementOVSDB: ManagementOVSDBResource{b: b},
-		managementRoute: ManagementRouteResource{b: b},
+		managementRoute: newManagementRouteResource(b),
 		memoryStats:     MemoryStatsResource{b: b},
 		nTP:             NTPResource{b: b},
 		outboundSMTP:    OutboundSMTPResource{b: b},
 		pFMan:           pfman.NewPFMan(b),
 		//pPTPCallInfo: PPTPCallInfoResource{b: b},
 		//performance:      PerformanceResource{c: b},
-		provision:        ProvisionResource{b: b},
+		provision:        newProvisionResource(b),
 		rAID:             raid.NewRAID(b),
 		restrictedModule: RestrictedModuleResource{b: b},
 		//sFlow:                 SFlowResource{c: c},
 		//sFlowReceiver:         SFlowReceiverResource{c: c},
-		sMTPServer:     SMTPServerResource{b: b},
+		sMTPServer:     newSMTPServerResource(b),
 		sNMP:           SNMPResource{b: b},
 		sSHD:           SSHDResource{b: b},
-		scriptd:        ScriptdResource{b: b},
-		service:        ServiceResource{b: b},
+		scriptd:        newScriptdResource(b),
+		service:        newServiceResource(b),
 		software:       software.NewSoftware(b),
-		stateMirroring: StateMirroringResource{b: b},
-		syncSysFiles:   SyncSysFilesResource{b: b},
+		stateMirroring: newStateMirroringResource(b),
+		syncSysFiles:   newSyncSysFilesResource(b),
 		syslog:         SyslogResource{b: b},
+		uCS:            newUCSResource(b),
 		//uRLDB:                 URLDBResource{c: c},
 		//uRLDBDownloadResult:   URLDBDownloadResultResource{c: c},
 		//uRLDBDownloadSchedule: URLDBDownloadScheduleResource{c: c},
@@ -708,6 +710,11 @@ func (sys Sys) Syslog() *SyslogResource {
 	return &sys.syslog
 }
 
+// uCS returns a configured UCSResource.
+func (sys Sys) UCS() *UCSResource {
+	return &sys.uCS
+}
+
 //// uRLDB returns a configured URLDBResource.
 //func (sys Sys) URLDB() *URLDBResource {
 //	return &sys.uRLDB
@@ -37,6 +37,19 @@ type Syslog struct {
 	SelfLink             string         `json:"selfLink,omitempty"`
 	UserLogFrom          string         `json:"userLogFrom,omitempty"`
 	UserLogTo            string         `json:"userLogTo,omitempty"`
+	Extra                bigip.Extra    `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes Syslog does not model in Extra.
+func (s *Syslog) UnmarshalJSON(data []byte) error {
+	type plain Syslog
+	return bigip.UnmarshalExtra(data, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of Syslog.
+func (s Syslog) MarshalJSON() ([]byte, error) {
+	type plain Syslog
+	return bigip.MarshalExtra(plain(s), s.Extra)
 }
 
 type RemoteServer struct {
@@ -47,12 +60,17 @@ type RemoteServer struct {
 }
 
 func (r *SyslogResource) AddRemoteServers(rs ...RemoteServer) error {
+	return r.AddRemoteServersContext(context.Background(), rs...)
+}
+
+// AddRemoteServersContext is like AddRemoteServers but uses ctx for the request.
+func (r *SyslogResource) AddRemoteServersContext(ctx context.Context, rs ...RemoteServer) error {
 	if len(rs) == 0 {
 		return nil
 	}
 	var item Syslog
 	_, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyslogEndpoint).DoRaw(context.Background())
+		Resource(SyslogEndpoint).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -65,7 +83,7 @@ func (r *SyslogResource) AddRemoteServers(rs ...RemoteServer) error {
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyslogEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(SyslogEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -82,9 +100,14 @@ type SyslogResource struct {
 
 // List all syslog details
 func (r *SyslogResource) Get() (*Syslog, error) {
+	return r.GetContext(context.Background())
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (r *SyslogResource) GetContext(ctx context.Context) (*Syslog, error) {
 	var item Syslog
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyslogEndpoint).DoRaw(context.Background())
+		Resource(SyslogEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -96,13 +119,38 @@ func (r *SyslogResource) Get() (*Syslog, error) {
 
 // Update the syslog item identified by the syslog name, otherwise an error will be reported.
 func (r *SyslogResource) Update(item Syslog) error {
+	return r.UpdateContext(context.Background(), item)
+}
+
+// UpdateContext is like Update but uses ctx for the request.
+func (r *SyslogResource) UpdateContext(ctx context.Context, item Syslog) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyslogEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(SyslogEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch modifies only the given attributes, leaving the others as they are. See
+// bigip.PatchBody for fields and mask.
+func (r *SyslogResource) Patch(fields any, mask ...string) error {
+	return r.PatchContext(context.Background(), fields, mask...)
+}
+
+// PatchContext is like Patch but uses ctx for the request.
+func (r *SyslogResource) PatchContext(ctx context.Context, fields any, mask ...string) error {
+	data, err := bigip.PatchBody(fields, mask...)
+	if err != nil {
+		return err
+	}
+	_, err = r.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
+		Resource(SyslogEndpoint).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -111,8 +159,13 @@ func (r *SyslogResource) Update(item Syslog) error {
 
 // Delete a single syslog identified by the syslog name. if it is not exist return error
 func (r *SyslogResource) Delete(name string) error {
+	return r.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (r *SyslogResource) DeleteContext(ctx context.Context, name string) error {
 	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyslogEndpoint).ResourceInstance(name).DoRaw(context.Background())
+		Resource(SyslogEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
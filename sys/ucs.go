@@ -2,9 +2,12 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
+	"io"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -36,6 +39,19 @@ type UCS struct {
 		Sequence        string    `json:"sequence"`
 		Version         string    `json:"version"`
 	} `json:"apiRawValues"`
+	Extra bigip.Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes UCS does not model in Extra.
+func (u *UCS) UnmarshalJSON(data []byte) error {
+	type plain UCS
+	return bigip.UnmarshalExtra(data, (*plain)(u), &u.Extra)
+}
+
+// MarshalJSON adds the attributes in Extra to those of UCS.
+func (u UCS) MarshalJSON() ([]byte, error) {
+	type plain UCS
+	return bigip.MarshalExtra(plain(u), u.Extra)
 }
 
 // UCSEndpoint represents the REST resource for managing UCS.
@@ -43,75 +59,52 @@ const UCSEndpoint = "ucs"
 
 // UCSResource provides an API to manage UCS configurations.
 type UCSResource struct {
-	b *bigip.BigIP
+	bigip.Resource[UCS, UCSList]
 }
 
-// List all ucs details
-func (r *UCSResource) List() (*UCSList, error) {
-	var items UCSList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(UCSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func newUCSResource(b *bigip.BigIP) UCSResource {
+	return UCSResource{bigip.NewResource[UCS, UCSList](b, SysManager, UCSEndpoint)}
 }
 
-// Get a single ucs details by the node name
-func (r *UCSResource) Get(name string) (*UCS, error) {
-	var item UCS
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(UCSEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// Size returns the size in bytes the device reports for the UCS archive name,
+// e.g. backup.ucs. A missing archive yields an error for which rest.IsNotFound
+// reports true.
+func (r *UCSResource) Size(name string) (int64, error) {
+	return r.SizeContext(context.Background(), name)
 }
 
-// Create a new ucs item
-func (r *UCSResource) Create(item UCS) error {
-	jsonData, err := json.Marshal(item)
+// SizeContext is like Size but uses ctx for the request.
+func (r *UCSResource) SizeContext(ctx context.Context, name string) (int64, error) {
+	list, err := r.ListContext(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
+		return 0, err
 	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(UCSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
+	for _, item := range list.Items {
+		if path.Base(item.APIRawValues.Filename) != name {
+			continue
+		}
+		// The size is reported as "<bytes> (in bytes)".
+		fields := strings.Fields(item.APIRawValues.FileSize)
+		if len(fields) == 0 {
+			return 0, fmt.Errorf("invalid size %q of UCS archive %s", item.APIRawValues.FileSize, name)
+		}
+		return strconv.ParseInt(fields[0], 10, 64)
 	}
-	return nil
+	return 0, fmt.Errorf("UCS archive %s: %w", name, rest.ErrNotFound)
 }
 
-// Update the ucs item identified by the ucs name, otherwise an error will be reported.
-func (r *UCSResource) Update(name string, item UCS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(UCSEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Download writes the UCS archive name to w and checks that its size is the one
+// the device reports.
+func (r *UCSResource) Download(name string, w io.Writer, opts bigip.TransferOptions) (*bigip.TransferResult, error) {
+	return r.DownloadContext(context.Background(), name, w, opts)
 }
 
-// Delete a single ucs identified by the ucs name. if it is not exist return error
-func (r *UCSResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(UCSEndpoint).ResourceInstance(name).DoRaw(context.Background())
+// DownloadContext is like Download but uses ctx for the requests.
+func (r *UCSResource) DownloadContext(ctx context.Context, name string, w io.Writer, opts bigip.TransferOptions) (*bigip.TransferResult, error) {
+	size, err := r.SizeContext(ctx, name)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	return nil
+	opts.Size = size
+	return r.BigIP().FileTransfer().DownloadUCSContext(ctx, name, w, opts)
 }
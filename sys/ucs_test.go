@@ -0,0 +1,30 @@
+package sys
+
+import (
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"testing"
+)
+
+func TestUCSSize(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+	s.Add("sys/ucs", map[string]any{
+		"name":         "/var/local/ucs/backup.ucs",
+		"apiRawValues": map[string]any{"filename": "/var/local/ucs/backup.ucs", "file_size": "3000 (in bytes)"},
+	})
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
+	if err != nil {
+		t.Fatalf("connect to bigip failed: %v", err)
+	}
+	ucs := newUCSResource(bigIP)
+
+	if size, err := ucs.Size("backup.ucs"); err != nil || size != 3000 {
+		t.Errorf("expected a size of 3000, got %d, %v", size, err)
+	}
+	if _, err := ucs.Size("missing.ucs"); !rest.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
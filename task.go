@@ -0,0 +1,275 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TaskEndpoint represents the REST resource under which asynchronous tasks run.
+const TaskEndpoint = "task"
+
+// States a task can be in.
+const (
+	TaskCreated    = "CREATED"
+	TaskValidating = "VALIDATING"
+	TaskStarted    = "STARTED"
+	TaskCompleted  = "COMPLETED"
+	TaskFailed     = "FAILED"
+)
+
+// Default bounds of the delay between two polls of Task.Wait.
+const (
+	DefaultTaskMinPollInterval = time.Second
+	DefaultTaskMaxPollInterval = 15 * time.Second
+)
+
+// TaskState holds the state of a task as reported by the device.
+type TaskState struct {
+	TaskID    int64  `json:"_taskId,omitempty"`
+	State     string `json:"_taskState,omitempty"`
+	Command   string `json:"command,omitempty"`
+	StartTime string `json:"startTime,omitempty"`
+	EndTime   string `json:"endTime,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	SelfLink  string `json:"selfLink,omitempty"`
+
+	// Result holds the other attributes of the task, such as the arguments it was
+	// submitted with and, once it is done, its output or the reason it failed.
+	Result Extra `json:"-"`
+}
+
+// UnmarshalJSON keeps the attributes TaskState does not model in Result.
+func (s *TaskState) UnmarshalJSON(data []byte) error {
+	type plain TaskState
+	return UnmarshalExtra(data, (*plain)(s), &s.Result)
+}
+
+// MarshalJSON adds the attributes in Result to those of TaskState.
+func (s TaskState) MarshalJSON() ([]byte, error) {
+	type plain TaskState
+	return MarshalExtra(plain(s), s.Result)
+}
+
+// TaskError is returned by Task.Wait when the task failed.
+type TaskError struct {
+	Path  string
+	ID    int64
+	State *TaskState
+}
+
+func (e *TaskError) Error() string {
+	msg := fmt.Sprintf("task %s/%d failed", e.Path, e.ID)
+	for _, key := range []string{"message", "errorMessage", "_taskResult"} {
+		var reason string
+		if json.Unmarshal(e.State.Result[key], &reason) == nil && reason != "" {
+			return msg + ": " + reason
+		}
+	}
+	return msg
+}
+
+// Task is a command run asynchronously by the device under /mgmt/tm/task, for
+// operations that take longer than the 60 seconds a synchronous request may, such
+// as saving or loading the configuration:
+//
+//	task, err := b.StartTask("sys/config", map[string]any{"command": "save"})
+//	if err != nil {
+//		return err
+//	}
+//	state, err := task.Wait(ctx)
+type Task struct {
+	// MinPollInterval and MaxPollInterval bound the delay between two polls of
+	// Wait, which doubles after every poll. They are DefaultTaskMinPollInterval
+	// and DefaultTaskMaxPollInterval when not positive.
+	MinPollInterval time.Duration
+	MaxPollInterval time.Duration
+
+	b    *BigIP
+	path string
+	id   int64
+}
+
+// StartTask submits command to the task endpoint at path, e.g. sys/config or
+// cli/script, and starts it.
+func (b *BigIP) StartTask(path string, command any) (*Task, error) {
+	return b.StartTaskContext(context.Background(), path, command)
+}
+
+// StartTaskContext is like StartTask but uses ctx for the requests.
+func (b *BigIP) StartTaskContext(ctx context.Context, path string, command any) (*Task, error) {
+	data, err := json.Marshal(command)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	res, err := taskRequest(b.RestClient.Post(), strings.Trim(path, "/")).Body(data).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state, err := unmarshalTaskState(res)
+	if err != nil {
+		return nil, err
+	}
+	t := b.Task(path, state.TaskID)
+	if err := t.StartContext(ctx); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Task returns the task with the given id at path, for example one started by
+// another process.
+func (b *BigIP) Task(path string, id int64) *Task {
+	return &Task{
+		MinPollInterval: DefaultTaskMinPollInterval,
+		MaxPollInterval: DefaultTaskMaxPollInterval,
+		b:               b,
+		path:            strings.Trim(path, "/"),
+		id:              id,
+	}
+}
+
+// RunTask starts command at path, waits until it is done and deletes it. If ctx
+// is done first, the task keeps running on the device and is not deleted.
+func (b *BigIP) RunTask(ctx context.Context, path string, command any) (*TaskState, error) {
+	t, err := b.StartTaskContext(ctx, path, command)
+	if err != nil {
+		return nil, err
+	}
+	state, err := t.Wait(ctx)
+	if state == nil {
+		return nil, err
+	}
+	if deleteErr := t.DeleteContext(ctx); deleteErr != nil && err == nil {
+		return state, deleteErr
+	}
+	return state, err
+}
+
+// ID returns the id of the task.
+func (t *Task) ID() int64 {
+	return t.id
+}
+
+// Path returns the endpoint of the task, e.g. sys/config.
+func (t *Task) Path() string {
+	return t.path
+}
+
+// Start moves a created task to VALIDATING, after which the device runs it.
+// StartTask calls it already.
+func (t *Task) Start() error {
+	return t.StartContext(context.Background())
+}
+
+// StartContext is like Start but uses ctx for the request.
+func (t *Task) StartContext(ctx context.Context) error {
+	data, err := json.Marshal(TaskState{State: TaskValidating})
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	_, err = t.request(t.b.RestClient.Put()).Body(data).DoRaw(ctx)
+	return err
+}
+
+// Get retrieves the task as it was submitted, with its current state.
+func (t *Task) Get() (*TaskState, error) {
+	return t.GetContext(context.Background())
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (t *Task) GetContext(ctx context.Context) (*TaskState, error) {
+	res, err := t.request(t.b.RestClient.Get()).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalTaskState(res)
+}
+
+// Result retrieves the state of the task and, once it is done, its result.
+func (t *Task) Result() (*TaskState, error) {
+	return t.ResultContext(context.Background())
+}
+
+// ResultContext is like Result but uses ctx for the request.
+func (t *Task) ResultContext(ctx context.Context) (*TaskState, error) {
+	res, err := t.request(t.b.RestClient.Get()).SubResource("result").DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalTaskState(res)
+}
+
+// Wait polls the result of the task until it is completed or failed and returns
+// it. A failed task is returned along with a *TaskError. Polls answered while the
+// device is busy, see rest.IsBusy, are repeated. If ctx is done first, Wait
+// returns its error and the task keeps running on the device.
+func (t *Task) Wait(ctx context.Context) (*TaskState, error) {
+	interval, maxInterval := t.MinPollInterval, t.MaxPollInterval
+	if interval <= 0 {
+		interval = DefaultTaskMinPollInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultTaskMaxPollInterval
+	}
+	for {
+		state, err := t.ResultContext(ctx)
+		switch {
+		case rest.IsBusy(err) && ctx.Err() == nil:
+		case err != nil:
+			return nil, err
+		case state.State == TaskCompleted:
+			return state, nil
+		case state.State == TaskFailed:
+			return state, &TaskError{Path: t.path, ID: t.id, State: state}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// Delete removes the task from the device, which keeps finished tasks until they
+// are deleted.
+func (t *Task) Delete() error {
+	return t.DeleteContext(context.Background())
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (t *Task) DeleteContext(ctx context.Context) error {
+	_, err := t.request(t.b.RestClient.Delete()).DoRaw(ctx)
+	return err
+}
+
+// request makes r address the task.
+func (t *Task) request(r *rest.Request) *rest.Request {
+	return taskRequest(r, t.path).ResourceInstance(strconv.FormatInt(t.id, 10))
+}
+
+// taskRequest makes r address the task endpoint at p. All but the last segment of
+// p are part of the prefix, as a resource is a single segment.
+func taskRequest(r *rest.Request, p string) *rest.Request {
+	dir, resource := path.Split(p)
+	return r.Prefix(GetBaseResource(), GetTMResource(), TaskEndpoint, dir).Resource(resource)
+}
+
+func unmarshalTaskState(data []byte) (*TaskState, error) {
+	var state TaskState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &state, nil
+}
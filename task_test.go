@@ -0,0 +1,123 @@
+package bigip
+
+import (
+	"context"
+	"errors"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTask(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+	b, err := NewSession(s.URL, s.Username, s.Password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	task, err := b.StartTask("sys/config", map[string]any{"command": "save"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	task.MinPollInterval = time.Millisecond
+	state, err := task.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.TaskID != task.ID() || state.State != TaskStarted || state.Command != "save" {
+		t.Errorf("unexpected task %+v", state)
+	}
+	// A poll answered while the device is busy is repeated.
+	s.FailNext(http.StatusServiceUnavailable, "restjavad is busy")
+	state, err = task.Wait(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.State != TaskCompleted {
+		t.Errorf("expected the task to be completed, got %s", state.State)
+	}
+	if err := task.Delete(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := task.Get(); err == nil {
+		t.Error("expected the deleted task to be gone")
+	}
+
+	expected := []string{
+		"POST /mgmt/tm/task/sys/config",
+		"PUT /mgmt/tm/task/sys/config/1",
+		"GET /mgmt/tm/task/sys/config/1",
+		"GET /mgmt/tm/task/sys/config/1/result",
+		"GET /mgmt/tm/task/sys/config/1/result",
+		"GET /mgmt/tm/task/sys/config/1/result",
+		"DELETE /mgmt/tm/task/sys/config/1",
+		"GET /mgmt/tm/task/sys/config/1",
+	}
+	if requests := s.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %q, got %q", expected, requests)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	task, err = b.StartTaskContext(ctx, "cli/script", map[string]any{"command": "run", "name": "/Common/backup"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := task.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the wait to time out, got %v", err)
+	}
+}
+
+func TestRunTaskFailed(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+	s.HandleFunc("/mgmt/tm/task/sys/ucs/1/result", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"_taskId":1,"_taskState":"FAILED","command":"load","name":"missing.ucs","message":"UCS file not found"}`))
+	})
+	b, err := NewSession(s.URL, s.Username, s.Password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := b.RunTask(context.Background(), "sys/ucs", map[string]any{"command": "load", "name": "missing.ucs"})
+	var taskErr *TaskError
+	if !errors.As(err, &taskErr) {
+		t.Fatalf("expected a task error, got %v", err)
+	}
+	if msg := "task sys/ucs/1 failed: UCS file not found"; err.Error() != msg {
+		t.Errorf("expected error %q, got %q", msg, err)
+	}
+	if state == nil || state.State != TaskFailed || string(state.Result["name"]) != `"missing.ucs"` {
+		t.Errorf("unexpected task %+v", state)
+	}
+	if requests := s.Requests(); requests[len(requests)-1] != "DELETE /mgmt/tm/task/sys/ucs/1" {
+		t.Errorf("expected the failed task to be deleted, got %q", requests)
+	}
+}
+
+func TestTaskWaitDefaultInterval(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+	b, err := NewSession(s.URL, s.Username, s.Password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.HandleFunc("/mgmt/tm/task/sys/config/1/result", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"_taskId":1,"_taskState":"STARTED"}`))
+	})
+
+	task := b.Task("sys/config", 1)
+	task.MinPollInterval, task.MaxPollInterval = 0, 0
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := task.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the wait to time out, got %v", err)
+	}
+	// Without a minimum interval, the task would be polled in a loop.
+	if n := len(s.Requests()); n != 1 {
+		t.Errorf("expected a single poll, got %d", n)
+	}
+}
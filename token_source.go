@@ -0,0 +1,166 @@
+package bigip
+
+import (
+	"context"
+	"errors"
+	"golang.org/x/oauth2"
+	"sync"
+	"time"
+)
+
+// ErrSessionClosed is returned for requests made through a session after Close.
+var ErrSessionClosed = errors.New("bigip: session is closed")
+
+// DefaultTokenRefreshWindow is how long before its expiry a token is replaced by a new one.
+var DefaultTokenRefreshWindow = 60 * time.Second
+
+// tokenSource logs in to the device through authPayload and caches the token
+// until it is about to expire. It is safe for concurrent use.
+type tokenSource struct {
+	mu       sync.Mutex
+	auth     *authPayload
+	issuedAt time.Time
+	closed   bool
+	// login is the login in progress, which concurrent callers wait for instead
+	// of logging in themselves.
+	login *login
+}
+
+// login is a login shared by the callers of tokenSource.TokenContext.
+type login struct {
+	done  chan struct{}
+	token *oauth2.Token
+	err   error
+}
+
+var _ oauth2.TokenSource = &tokenSource{}
+
+func newTokenSource(auth *authPayload) *tokenSource {
+	return &tokenSource{auth: auth}
+}
+
+// Token is like TokenContext with a background context.
+func (ts *tokenSource) Token() (*oauth2.Token, error) {
+	return ts.TokenContext(context.Background())
+}
+
+// TokenContext returns the cached token, logging in again when there is none or
+// when it expires within the refresh window. If logging in fails while the cached
+// token has not expired yet, that token is returned and the next call tries again.
+// Only one login runs at a time; the
+// other callers wait for it until ctx is done. The lock is not held while logging
+// in, so that a device that stops responding blocks no more than the callers
+// waiting for a token.
+func (ts *tokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	for {
+		ts.mu.Lock()
+		if ts.closed {
+			ts.mu.Unlock()
+			return nil, ErrSessionClosed
+		}
+		if ts.auth.token != "" && !time.Now().Add(ts.auth.RefreshWindow).After(ts.auth.tokenExpiresAt) {
+			token := &oauth2.Token{AccessToken: ts.auth.token, Expiry: ts.auth.tokenExpiresAt}
+			ts.mu.Unlock()
+			return token, nil
+		}
+		if l := ts.login; l != nil {
+			ts.mu.Unlock()
+			select {
+			case <-l.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			// If the login failed because the context of the caller running it was
+			// done, which says nothing about this one, try again.
+			if l.err == nil || (!errors.Is(l.err, context.Canceled) && !errors.Is(l.err, context.DeadlineExceeded)) {
+				return ts.orUnexpired(l.token, l.err)
+			}
+			continue
+		}
+		l := &login{done: make(chan struct{})}
+		ts.login = l
+		ts.mu.Unlock()
+
+		l.token, l.err = ts.logIn(ctx)
+		ts.mu.Lock()
+		ts.login = nil
+		ts.mu.Unlock()
+		close(l.done)
+		return ts.orUnexpired(l.token, l.err)
+	}
+}
+
+// orUnexpired returns token and err, or the cached token if err is not nil and
+// that token has not expired, so that a refresh failing within the refresh window
+// does not fail requests the token is still good for.
+func (ts *tokenSource) orUnexpired(token *oauth2.Token, err error) (*oauth2.Token, error) {
+	if err == nil {
+		return token, nil
+	}
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if !ts.closed && ts.auth.token != "" && time.Now().Before(ts.auth.tokenExpiresAt) {
+		return &oauth2.Token{AccessToken: ts.auth.token, Expiry: ts.auth.tokenExpiresAt}, nil
+	}
+	return nil, err
+}
+
+// logIn obtains a new token and stores it unless the session was closed meanwhile.
+func (ts *tokenSource) logIn(ctx context.Context) (*oauth2.Token, error) {
+	issuedAt := time.Now()
+	token, expiresAt, err := ts.auth.generateToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ts.auth.TokenTimeout > 0 {
+		if err := ts.auth.extendToken(ctx, token, ts.auth.TokenTimeout); err != nil {
+			return nil, err
+		}
+		expiresAt = issuedAt.Add(ts.auth.TokenTimeout)
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.closed {
+		return nil, ErrSessionClosed
+	}
+	ts.auth.token = token
+	ts.auth.tokenExpiresAt = expiresAt
+	ts.issuedAt = issuedAt
+	return &oauth2.Token{AccessToken: token, Expiry: expiresAt}, nil
+}
+
+// ResetTokenOlderThan drops the cached token if it was issued before t, so that the
+// next call to Token logs in again. Tokens obtained after t are kept, which prevents
+// concurrent requests failing with the same stale token from each logging in.
+func (ts *tokenSource) ResetTokenOlderThan(t time.Time) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.issuedAt.Before(t) {
+		ts.auth.token = ""
+		ts.auth.tokenExpiresAt = time.Time{}
+	}
+}
+
+// extended records that the lifetime of token was changed to timeout.
+func (ts *tokenSource) extended(token string, timeout time.Duration) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.auth.token == token {
+		ts.auth.tokenExpiresAt = ts.issuedAt.Add(timeout)
+	}
+}
+
+// close marks the session as closed and returns the token that was in use, if any.
+func (ts *tokenSource) close() string {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	token := ts.auth.token
+	ts.closed = true
+	ts.auth.token = ""
+	ts.auth.tokenExpiresAt = time.Time{}
+	return token
+}
@@ -0,0 +1,215 @@
+package bigip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/transport"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newLoginServer returns a server that hands out numbered tokens valid for timeout
+// seconds and accepts only the most recent one.
+func newLoginServer(timeout int) (*httptest.Server, *int) {
+	var mu sync.Mutex
+	logins := 0
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/mgmt/shared/authn/login" {
+			logins++
+			fmt.Fprintf(w, `{"token":{"token":"token-%d","timeout":%d,"startTime":"2024-01-01T00:00:00.000+0000"}}`, logins, timeout)
+			return
+		}
+		if r.Header.Get("X-F5-Auth-Token") != fmt.Sprintf("token-%d", logins) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":401,"message":"X-F5-Auth-Token does not exist."}`))
+			return
+		}
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	return ts, &logins
+}
+
+func TestTokenSourceRefreshesBeforeExpiry(t *testing.T) {
+	ts, logins := newLoginServer(1)
+	defer ts.Close()
+
+	b, err := NewToken(ts.URL, "admin", "admin", "tmos", WithTokenRefreshWindow(2*time.Second))
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := b.RestClient.Get().Prefix("mgmt", "tm", "ltm", "pool").DoRaw(context.Background()); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+	}
+	// Every token expires within the refresh window, so each request logs in again.
+	if *logins != 3 {
+		t.Errorf("expected 3 logins, got %d", *logins)
+	}
+}
+
+func TestTokenSourceReloginOnUnauthorized(t *testing.T) {
+	ts, logins := newLoginServer(1200)
+	defer ts.Close()
+
+	b, err := NewToken(ts.URL, "admin", "admin", "tmos")
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+	// Simulate the token being revoked on the device.
+	*logins++
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := b.RestClient.Get().Prefix("mgmt", "tm", "ltm", "pool").DoRaw(context.Background()); err != nil {
+				t.Errorf("request: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTokenSourceLoginHonoursContext(t *testing.T) {
+	hang := make(chan struct{})
+	var logins int32
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/mgmt/shared/authn/login" {
+			if atomic.AddInt32(&logins, 1) > 1 {
+				select {
+				case <-hang:
+				case <-r.Context().Done():
+				}
+				return
+			}
+			w.Write([]byte(`{"token":{"token":"token-1","timeout":1200}}`))
+			return
+		}
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer ts.Close()
+	defer close(hang)
+
+	b, err := NewToken(ts.URL, "admin", "admin", "tmos")
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+	// The device stops responding to logins once the token has to be renewed.
+	b.tokenSource.ResetTokenOlderThan(time.Now().Add(time.Second))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			_, err := b.RestClient.Get().Prefix("mgmt", "tm", "ltm", "pool").DoRaw(ctx)
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("expected the request to time out, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected the requests to give up with their context, took %s", elapsed)
+	}
+	if n := atomic.LoadInt32(&logins); n > 4 {
+		t.Errorf("expected the requests to share logins, got %d", n)
+	}
+}
+
+func TestTokenSourceReloginUnderRateLimit(t *testing.T) {
+	s := bigiptest.NewServer()
+	defer s.Close()
+	b, err := NewToken(s.URL, s.Username, s.Password, "tmos",
+		WithRateLimit(transport.LimitPolicy{Writes: transport.Limit{MaxInFlight: 1}}))
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+
+	// The rejected write holds the only write slot until its response is released,
+	// which must happen before the login it waits for.
+	s.FailNext(http.StatusUnauthorized, "X-F5-Auth-Token does not exist.")
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if _, err := b.Dynamic().Resource("ltm/pool").CreateContext(ctx, Object{"name": "p1"}); err != nil {
+		t.Fatalf("expected the write to succeed after logging in again, got %v", err)
+	}
+	if _, ok := s.Object("ltm/pool/p1"); !ok {
+		t.Error("expected the pool to be created")
+	}
+}
+
+func TestTokenSourceExtendFailure(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/mgmt/shared/authn/login" {
+			w.Write([]byte(`{"token":{"token":"token-1","timeout":1200}}`))
+			return
+		}
+		if r.Method != http.MethodPatch {
+			w.Write([]byte(`{"items":[]}`))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"code":403,"message":"Access denied."}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewToken(ts.URL, "admin", "admin", "tmos", WithTokenTimeout(time.Hour))
+	var apiErr *rest.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden || apiErr.Message != "Access denied." {
+		t.Fatalf("expected a 403 *rest.APIError, got %v", err)
+	}
+	if strings.Contains(err.Error(), "token-1") {
+		t.Errorf("the error contains the token: %v", err)
+	}
+}
+
+func TestTokenSourceRefreshFailureKeepsValidToken(t *testing.T) {
+	var logins int32
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/mgmt/shared/authn/login" && atomic.AddInt32(&logins, 1) > 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"code":503,"message":"restjavad is busy"}`))
+			return
+		}
+		w.Write([]byte(`{"token":{"token":"token-1","timeout":1}}`))
+	}))
+	defer ts.Close()
+
+	b, err := NewToken(ts.URL, "admin", "admin", "tmos", WithTokenRefreshWindow(time.Hour))
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+	// The token is within the refresh window, but the refresh failing does not
+	// matter as long as it has not expired.
+	if token, err := b.Token(); err != nil || token != "token-1" {
+		t.Errorf("expected the unexpired token, got %q, %v", token, err)
+	}
+	if n := atomic.LoadInt32(&logins); n != 2 {
+		t.Errorf("expected a refresh to be attempted, got %d logins", n)
+	}
+
+	time.Sleep(1100 * time.Millisecond)
+	if _, err := b.Token(); err == nil {
+		t.Error("expected an error once the token expired")
+	}
+}
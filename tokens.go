@@ -0,0 +1,180 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxTokenTimeout is the longest lifetime BIG-IP accepts for a token.
+const MaxTokenTimeout = 36000 * time.Second
+
+// AuthzManager is the manager of the authorization API under /mgmt/shared.
+const AuthzManager = "authz"
+
+// TokensEndpoint represents the REST resource for managing tokens.
+const TokensEndpoint = "tokens"
+
+// TokenList holds a list of tokens.
+type TokenList struct {
+	Items    []Token `json:"items,omitempty"`
+	Kind     string  `json:"kind,omitempty"`
+	SelfLink string  `json:"selfLink,omitempty"`
+}
+
+// Token holds an authentication token issued by the device.
+type Token struct {
+	Token            string        `json:"token,omitempty"`
+	Name             string        `json:"name,omitempty"`
+	UserName         string        `json:"userName,omitempty"`
+	AuthProviderName string        `json:"authProviderName,omitempty"`
+	GroupReferences  []interface{} `json:"groupReferences,omitempty"`
+	Timeout          int64         `json:"timeout,omitempty"`
+	StartTime        string        `json:"startTime,omitempty"`
+	Address          string        `json:"address,omitempty"`
+	Partition        string        `json:"partition,omitempty"`
+	Generation       int64         `json:"generation,omitempty"`
+	LastUpdateMicros int64         `json:"lastUpdateMicros,omitempty"`
+	ExpirationMicros int64         `json:"expirationMicros,omitempty"`
+	Kind             string        `json:"kind,omitempty"`
+	SelfLink         string        `json:"selfLink,omitempty"`
+}
+
+// ExpiresAt returns the time the token expires at, as reported by the device.
+func (t Token) ExpiresAt() time.Time {
+	return time.UnixMicro(t.ExpirationMicros)
+}
+
+// Remaining returns the lifetime left to the token, measured against the local clock.
+func (t Token) Remaining() time.Duration {
+	remaining := time.Until(t.ExpiresAt())
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// TokenResource provides an API to manage the tokens issued by the device.
+type TokenResource struct {
+	b *BigIP
+}
+
+// Tokens returns a TokenResource to manage the tokens of /mgmt/shared/authz/tokens.
+func (b *BigIP) Tokens() *TokenResource {
+	return &TokenResource{b: b}
+}
+
+// List retrieves all tokens issued by the device.
+func (r *TokenResource) List() (*TokenList, error) {
+	return r.ListContext(context.Background())
+}
+
+// ListContext is like List but uses ctx for the request.
+func (r *TokenResource) ListContext(ctx context.Context) (*TokenList, error) {
+	var items TokenList
+	res, err := r.b.RestClient.Get().Prefix(GetBaseResource()).ResourceCategory(GetShareResource()).ManagerName(AuthzManager).
+		Resource(TokensEndpoint).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(res, &items); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &items, nil
+}
+
+// ListByUser retrieves the tokens issued to the given user.
+func (r *TokenResource) ListByUser(userName string) ([]Token, error) {
+	return r.ListByUserContext(context.Background(), userName)
+}
+
+// ListByUserContext is like ListByUser but uses ctx for the request.
+func (r *TokenResource) ListByUserContext(ctx context.Context, userName string) ([]Token, error) {
+	list, err := r.ListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var items []Token
+	for _, item := range list.Items {
+		if strings.EqualFold(item.UserName, userName) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// Get retrieves a single token.
+func (r *TokenResource) Get(token string) (*Token, error) {
+	return r.GetContext(context.Background(), token)
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (r *TokenResource) GetContext(ctx context.Context, token string) (*Token, error) {
+	var item Token
+	res, err := r.b.RestClient.Get().Prefix(GetBaseResource()).ResourceCategory(GetShareResource()).ManagerName(AuthzManager).
+		Resource(TokensEndpoint).ResourceInstance(token).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(res, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &item, nil
+}
+
+// Extend sets the lifetime of a token to timeout, counted from the time the token
+// was issued. The timeout may not exceed MaxTokenTimeout.
+func (r *TokenResource) Extend(token string, timeout time.Duration) (*Token, error) {
+	return r.ExtendContext(context.Background(), token, timeout)
+}
+
+// ExtendContext is like Extend but uses ctx for the request.
+func (r *TokenResource) ExtendContext(ctx context.Context, token string, timeout time.Duration) (*Token, error) {
+	data, err := tokenTimeoutBody(timeout)
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.b.RestClient.Patch().Prefix(GetBaseResource()).ResourceCategory(GetShareResource()).ManagerName(AuthzManager).
+		Resource(TokensEndpoint).ResourceInstance(token).Body(data).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var item Token
+	if err := json.Unmarshal(res, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	if r.b.tokenSource != nil {
+		r.b.tokenSource.extended(token, timeout)
+	}
+	return &item, nil
+}
+
+// Delete revokes a token.
+func (r *TokenResource) Delete(token string) error {
+	return r.DeleteContext(context.Background(), token)
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (r *TokenResource) DeleteContext(ctx context.Context, token string) error {
+	_, err := r.b.RestClient.Delete().Prefix(GetBaseResource()).ResourceCategory(GetShareResource()).ManagerName(AuthzManager).
+		Resource(TokensEndpoint).ResourceInstance(token).DoRaw(ctx)
+	return err
+}
+
+// tokenTimeoutBody returns the body of a request setting the timeout of a token.
+// The timeout is sent in whole seconds, so it must be at least 1s.
+func tokenTimeoutBody(timeout time.Duration) ([]byte, error) {
+	seconds := int64(timeout / time.Second)
+	if seconds < 1 || timeout > MaxTokenTimeout {
+		return nil, fmt.Errorf("token timeout must be between 1s and %s, got %s", MaxTokenTimeout, timeout)
+	}
+	data, err := json.Marshal(struct {
+		Timeout int64 `json:"timeout"`
+	}{Timeout: seconds})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,91 @@
+package bigip
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTokenLifecycle(t *testing.T) {
+	var mu sync.Mutex
+	tokens := map[string]*Token{}
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		const prefix = "/mgmt/shared/authz/tokens/"
+		switch {
+		case r.URL.Path == "/mgmt/shared/authn/login":
+			token := &Token{Token: "token-1", UserName: "admin", Timeout: 1200}
+			tokens[token.Token] = token
+			json.NewEncoder(w).Encode(authToken{Token: *token})
+		case tokens[r.Header.Get("X-F5-Auth-Token")] == nil:
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/mgmt/shared/authz/tokens":
+			list := TokenList{Items: []Token{{Token: "other", UserName: "guest"}}}
+			for _, token := range tokens {
+				list.Items = append(list.Items, *token)
+			}
+			json.NewEncoder(w).Encode(list)
+		case r.Method == http.MethodPatch:
+			var body Token
+			data, _ := io.ReadAll(r.Body)
+			json.Unmarshal(data, &body)
+			token := tokens[r.URL.Path[len(prefix):]]
+			token.Timeout = body.Timeout
+			token.ExpirationMicros = time.Now().Add(time.Duration(body.Timeout) * time.Second).UnixMicro()
+			json.NewEncoder(w).Encode(token)
+		case r.Method == http.MethodDelete:
+			delete(tokens, r.URL.Path[len(prefix):])
+		}
+	}))
+	defer ts.Close()
+
+	b, err := NewToken(ts.URL, "admin", "admin", "tmos", WithTokenTimeout(3600*time.Second))
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+	if tokens["token-1"].Timeout != 3600 {
+		t.Errorf("expected the login to extend the token to 3600s, got %d", tokens["token-1"].Timeout)
+	}
+
+	items, err := b.Tokens().ListByUser("admin")
+	if err != nil {
+		t.Fatalf("ListByUser: %v", err)
+	}
+	if len(items) != 1 || items[0].Token != "token-1" {
+		t.Errorf("unexpected tokens %+v", items)
+	}
+
+	token, err := b.Tokens().Extend("token-1", 10*time.Hour)
+	if err != nil {
+		t.Fatalf("Extend: %v", err)
+	}
+	if remaining := token.Remaining(); remaining < 9*time.Hour {
+		t.Errorf("expected about 10h remaining, got %s", remaining)
+	}
+	if _, err := b.Tokens().Extend("token-1", 11*time.Hour); err == nil {
+		t.Error("expected an error extending the token beyond MaxTokenTimeout")
+	}
+	if _, err := b.Tokens().Extend("token-1", 500*time.Millisecond); err == nil {
+		t.Error("expected an error extending the token by less than 1s")
+	}
+	if tokens["token-1"].Timeout != 36000 {
+		t.Errorf("expected the timeout to stay 36000s, got %d", tokens["token-1"].Timeout)
+	}
+
+	if err := b.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if len(tokens) != 0 {
+		t.Errorf("expected the token to be revoked, got %v", tokens)
+	}
+	if _, err := b.Tokens().List(); !errors.Is(err, ErrSessionClosed) {
+		t.Errorf("expected ErrSessionClosed after Close, got %v", err)
+	}
+}
@@ -0,0 +1,246 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"strconv"
+)
+
+// TransactionEndpoint represents the REST resource for managing transactions.
+const TransactionEndpoint = "transaction"
+
+// CoordinationIDHeader is the header that adds a request to a transaction.
+const CoordinationIDHeader = "X-F5-REST-Coordination-Id"
+
+// States a transaction can be in.
+const (
+	TransactionStarted    = "STARTED"
+	TransactionValidating = "VALIDATING"
+	TransactionCompleted  = "COMPLETED"
+	TransactionFailed     = "FAILED"
+)
+
+// TransactionState holds the state of a transaction as reported by the device.
+type TransactionState struct {
+	TransID               int64  `json:"transId,omitempty"`
+	State                 string `json:"state,omitempty"`
+	TimeoutSeconds        int    `json:"timeoutSeconds,omitempty"`
+	AsyncExecutionTimeout int    `json:"asyncExecutionTimeout,omitempty"`
+	ValidateOnly          bool   `json:"validateOnly,omitempty"`
+	ExecutionTimeout      int    `json:"executionTimeout,omitempty"`
+	ExecutionTime         int    `json:"executionTime,omitempty"`
+	FailureReason         string `json:"failureReason,omitempty"`
+	Kind                  string `json:"kind,omitempty"`
+	SelfLink              string `json:"selfLink,omitempty"`
+}
+
+// TransactionCommandList holds the commands queued in a transaction.
+type TransactionCommandList struct {
+	Items    []TransactionCommand `json:"items,omitempty"`
+	Kind     string               `json:"kind,omitempty"`
+	SelfLink string               `json:"selfLink,omitempty"`
+}
+
+// TransactionCommand is a request queued in a transaction.
+type TransactionCommand struct {
+	CommandID int64           `json:"commandId,omitempty"`
+	EvalOrder int             `json:"evalOrder,omitempty"`
+	Method    string          `json:"method,omitempty"`
+	URI       string          `json:"uri,omitempty"`
+	Body      json.RawMessage `json:"body,omitempty"`
+	Kind      string          `json:"kind,omitempty"`
+	SelfLink  string          `json:"selfLink,omitempty"`
+}
+
+// Transaction groups changes that the device applies atomically when the
+// transaction is committed. Changes are added to it by making them through the
+// session returned by BigIP, so that every resource can take part in it:
+//
+//	tx, err := b.BeginTransaction()
+//	if err != nil {
+//		return err
+//	}
+//	l := ltm.New(tx.BigIP())
+//	if err := l.Pool().Create(pool); err != nil {
+//		return err
+//	}
+//	if err := l.Virtual().Create(vs); err != nil {
+//		return err
+//	}
+//	_, err = tx.Commit()
+type Transaction struct {
+	b  *BigIP
+	tx *BigIP
+	id int64
+}
+
+// BeginTransaction starts a new transaction.
+func (b *BigIP) BeginTransaction() (*Transaction, error) {
+	return b.BeginTransactionContext(context.Background())
+}
+
+// BeginTransactionContext is like BeginTransaction but uses ctx for the request.
+func (b *BigIP) BeginTransactionContext(ctx context.Context) (*Transaction, error) {
+	res, err := b.RestClient.Post().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).ManagerName(TransactionEndpoint).
+		Body([]byte("{}")).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var state TransactionState
+	if err := json.Unmarshal(res, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return b.Transaction(state.TransID), nil
+}
+
+// Transaction returns the transaction with the given id, for example one started
+// by another process.
+func (b *BigIP) Transaction(id int64) *Transaction {
+	return &Transaction{
+		b: b,
+		tx: &BigIP{
+			RestClient:  b.RestClient.WithHeader(CoordinationIDHeader, strconv.FormatInt(id, 10)),
+			tokenSource: b.tokenSource,
+		},
+		id: id,
+	}
+}
+
+// RunTransaction starts a transaction, calls fn with the session bound to it and
+// commits it if fn succeeds. The transaction is deleted if fn or the commit fails.
+func (b *BigIP) RunTransaction(ctx context.Context, fn func(tx *BigIP) error) (*TransactionState, error) {
+	t, err := b.BeginTransactionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := fn(t.BigIP()); err != nil {
+		t.DeleteContext(ctx)
+		return nil, err
+	}
+	state, err := t.CommitContext(ctx)
+	if err != nil {
+		t.DeleteContext(ctx)
+		return nil, err
+	}
+	return state, nil
+}
+
+// ID returns the id of the transaction.
+func (t *Transaction) ID() int64 {
+	return t.id
+}
+
+// BigIP returns a session that queues the changes made through it in the
+// transaction instead of applying them. Only changes can be queued; reads should
+// be made through the original session. The session shares the authentication of
+// the original one, so it must not be closed.
+func (t *Transaction) BigIP() *BigIP {
+	return t.tx
+}
+
+// Get retrieves the state of the transaction.
+func (t *Transaction) Get() (*TransactionState, error) {
+	return t.GetContext(context.Background())
+}
+
+// GetContext is like Get but uses ctx for the request.
+func (t *Transaction) GetContext(ctx context.Context) (*TransactionState, error) {
+	res, err := t.request(t.b.RestClient.Get()).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalTransactionState(res)
+}
+
+// Commands retrieves the commands queued in the transaction, in the order they
+// will be applied.
+func (t *Transaction) Commands() (*TransactionCommandList, error) {
+	return t.CommandsContext(context.Background())
+}
+
+// CommandsContext is like Commands but uses ctx for the request.
+func (t *Transaction) CommandsContext(ctx context.Context) (*TransactionCommandList, error) {
+	res, err := t.request(t.b.RestClient.Get()).SubResource("commands").DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var items TransactionCommandList
+	if err := json.Unmarshal(res, &items); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &items, nil
+}
+
+// RemoveCommand removes a queued command from the transaction.
+func (t *Transaction) RemoveCommand(commandID int64) error {
+	return t.RemoveCommandContext(context.Background(), commandID)
+}
+
+// RemoveCommandContext is like RemoveCommand but uses ctx for the request.
+func (t *Transaction) RemoveCommandContext(ctx context.Context, commandID int64) error {
+	_, err := t.request(t.b.RestClient.Delete()).SubResource("commands").
+		SubResourceInstance(strconv.FormatInt(commandID, 10)).DoRaw(ctx)
+	return err
+}
+
+// Commit applies the queued commands atomically. Either all of them take effect or
+// none does, in which case the returned error explains why.
+func (t *Transaction) Commit() (*TransactionState, error) {
+	return t.CommitContext(context.Background())
+}
+
+// CommitContext is like Commit but uses ctx for the request.
+func (t *Transaction) CommitContext(ctx context.Context) (*TransactionState, error) {
+	return t.setState(ctx, TransactionState{State: TransactionValidating})
+}
+
+// Validate checks whether the queued commands could be committed without applying
+// them. The transaction can still be committed afterwards.
+func (t *Transaction) Validate() (*TransactionState, error) {
+	return t.ValidateContext(context.Background())
+}
+
+// ValidateContext is like Validate but uses ctx for the request.
+func (t *Transaction) ValidateContext(ctx context.Context) (*TransactionState, error) {
+	return t.setState(ctx, TransactionState{State: TransactionValidating, ValidateOnly: true})
+}
+
+// Delete discards the transaction and the commands queued in it.
+func (t *Transaction) Delete() error {
+	return t.DeleteContext(context.Background())
+}
+
+// DeleteContext is like Delete but uses ctx for the request.
+func (t *Transaction) DeleteContext(ctx context.Context) error {
+	_, err := t.request(t.b.RestClient.Delete()).DoRaw(ctx)
+	return err
+}
+
+func (t *Transaction) setState(ctx context.Context, state TransactionState) (*TransactionState, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	res, err := t.request(t.b.RestClient.Patch()).Body(data).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalTransactionState(res)
+}
+
+// request makes r address the transaction. It is built from the original session,
+// as requests on the transaction itself must not carry the coordination header.
+func (t *Transaction) request(r *rest.Request) *rest.Request {
+	return r.Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).ManagerName(TransactionEndpoint).
+		ResourceInstance(strconv.FormatInt(t.id, 10))
+}
+
+func unmarshalTransactionState(data []byte) (*TransactionState, error) {
+	var state TransactionState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &state, nil
+}
@@ -0,0 +1,174 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// newTransactionServer returns a server that queues the requests carrying the
+// coordination header of transaction 42 and applies them on commit.
+func newTransactionServer(t *testing.T) (*httptest.Server, *[]TransactionCommand, *[]string) {
+	var mu sync.Mutex
+	var commands []TransactionCommand
+	var applied []string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+
+		if id := r.Header.Get(CoordinationIDHeader); id != "" {
+			if id != "42" {
+				t.Errorf("unexpected transaction id %q", id)
+			}
+			command := TransactionCommand{CommandID: int64(len(commands) + 1), EvalOrder: len(commands) + 1,
+				Method: r.Method, URI: r.URL.Path, Body: body}
+			commands = append(commands, command)
+			json.NewEncoder(w).Encode(command)
+			return
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "POST /mgmt/tm/transaction":
+			w.Write([]byte(`{"transId":42,"state":"STARTED","timeoutSeconds":120,"kind":"tm:transactionstate"}`))
+		case "GET /mgmt/tm/transaction/42":
+			w.Write([]byte(`{"transId":42,"state":"STARTED"}`))
+		case "GET /mgmt/tm/transaction/42/commands":
+			json.NewEncoder(w).Encode(TransactionCommandList{Items: commands})
+		case "DELETE /mgmt/tm/transaction/42/commands/1":
+			commands = commands[1:]
+		case "PATCH /mgmt/tm/transaction/42":
+			var state TransactionState
+			json.Unmarshal(body, &state)
+			if state.State != TransactionValidating {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			for _, c := range commands {
+				if c.URI == "/mgmt/tm/ltm/virtual" {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write([]byte(`{"code":400,"message":"transaction failed:01070734:3: Configuration error: invalid pool"}`))
+					return
+				}
+			}
+			if !state.ValidateOnly {
+				for _, c := range commands {
+					applied = append(applied, c.Method+" "+c.URI)
+				}
+				commands = nil
+			}
+			w.Write([]byte(`{"transId":42,"state":"COMPLETED","validateOnly":` + map[bool]string{true: "true", false: "false"}[state.ValidateOnly] + `}`))
+		case "DELETE /mgmt/tm/transaction/42":
+			commands = nil
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return ts, &commands, &applied
+}
+
+func TestTransaction(t *testing.T) {
+	ts, commands, applied := newTransactionServer(t)
+	defer ts.Close()
+
+	b, err := NewSession(ts.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	tx, err := b.BeginTransaction()
+	if err != nil {
+		t.Fatalf("BeginTransaction: %v", err)
+	}
+	if tx.ID() != 42 {
+		t.Fatalf("expected transaction 42, got %d", tx.ID())
+	}
+	pools := newTestResource(tx.BigIP(), "pool")
+	if err := pools.Create(testItem{Name: "p1"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := pools.Delete("/Common/p2"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	list, err := tx.Commands()
+	if err != nil {
+		t.Fatalf("Commands: %v", err)
+	}
+	if len(list.Items) != 2 || list.Items[0].Method != http.MethodPost || list.Items[1].URI != "/mgmt/tm/ltm/pool/~Common~p2" {
+		t.Fatalf("unexpected commands %+v", list.Items)
+	}
+
+	state, err := tx.Validate()
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if !state.ValidateOnly || len(*applied) != 0 {
+		t.Errorf("expected validation not to apply the commands, applied %v", *applied)
+	}
+
+	if err := tx.RemoveCommand(1); err != nil {
+		t.Fatalf("RemoveCommand: %v", err)
+	}
+	state, err = tx.Commit()
+	if err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	if state.State != TransactionCompleted {
+		t.Errorf("expected state COMPLETED, got %s", state.State)
+	}
+	if len(*applied) != 1 || (*applied)[0] != "DELETE /mgmt/tm/ltm/pool/~Common~p2" || len(*commands) != 0 {
+		t.Errorf("unexpected applied commands %v", *applied)
+	}
+}
+
+func TestRunTransaction(t *testing.T) {
+	ts, commands, applied := newTransactionServer(t)
+	defer ts.Close()
+
+	b, err := NewSession(ts.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+
+	_, err = b.RunTransaction(context.Background(), func(tx *BigIP) error {
+		if err := newTestResource(tx, "pool").Create(testItem{Name: "p1"}); err != nil {
+			return err
+		}
+		return newTestResource(tx, "virtual").Create(testItem{Name: "vs1"})
+	})
+	if err == nil || len(*applied) != 0 || len(*commands) != 0 {
+		t.Fatalf("expected the failed commit to be discarded, got %v, applied %v", err, *applied)
+	}
+
+	abort := errors.New("abort")
+	_, err = b.RunTransaction(context.Background(), func(tx *BigIP) error {
+		if err := newTestResource(tx, "pool").Create(testItem{Name: "p1"}); err != nil {
+			return err
+		}
+		return abort
+	})
+	if !errors.Is(err, abort) || len(*commands) != 0 {
+		t.Fatalf("expected the transaction to be deleted, got %v", err)
+	}
+
+	state, err := b.RunTransaction(context.Background(), func(tx *BigIP) error {
+		return newTestResource(tx, "pool").Create(testItem{Name: "p1"})
+	})
+	if err != nil {
+		t.Fatalf("RunTransaction: %v", err)
+	}
+	if state.State != TransactionCompleted || len(*applied) != 1 {
+		t.Errorf("unexpected state %+v, applied %v", state, *applied)
+	}
+}
+
+func newTestResource(b *BigIP, endpoint string) *Resource[testItem, testItemList] {
+	r := NewResource[testItem, testItemList](b, "ltm", endpoint)
+	return &r
+}
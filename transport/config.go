@@ -1,6 +1,9 @@
 package transport
 
-import "net/http"
+import (
+	"golang.org/x/oauth2"
+	"net/http"
+)
 
 // holds various options for establishing a transport.
 type Config struct {
@@ -16,6 +19,14 @@ type Config struct {
 	// The last successfully read value takes precedence over BearerToken.
 	BearerTokenFile string
 
+	// TokenSource provides the bearer token for every request. It takes precedence
+	// over BearerToken and allows the token to be refreshed when it expires.
+	TokenSource oauth2.TokenSource
+
+	// TLS configures the verification of the server certificate and the client
+	// certificate. When nil, the server certificate is not verified.
+	TLS *TLSConfig
+
 	// WrapTransport for most client level operations.
 	Transport http.RoundTripper
 
@@ -31,5 +42,5 @@ func (c *Config) HasBasicAuth() bool {
 }
 
 func (c *Config) HasTokenAuth() bool {
-	return len(c.BearerToken) != 0 || len(c.BearerTokenFile) != 0
+	return len(c.BearerToken) != 0 || len(c.BearerTokenFile) != 0 || c.TokenSource != nil
 }
@@ -0,0 +1,236 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultMaxBodyBytes is the number of bytes of a body logged when
+// DebugConfig.MaxBodyBytes is zero.
+const DefaultMaxBodyBytes = 4096
+
+// Logger receives the records of the debug round tripper as a message followed by
+// alternating keys and values. *slog.Logger implements it.
+type Logger interface {
+	DebugContext(ctx context.Context, msg string, args ...any)
+}
+
+// LoggerFunc adapts a function to a Logger, e.g. to log with the log package:
+//
+//	transport.LoggerFunc(func(ctx context.Context, msg string, args ...any) {
+//		log.Println(append([]any{msg}, args...)...)
+//	})
+type LoggerFunc func(ctx context.Context, msg string, args ...any)
+
+// DebugContext calls f.
+func (f LoggerFunc) DebugContext(ctx context.Context, msg string, args ...any) {
+	f(ctx, msg, args...)
+}
+
+// DebugConfig configures the round tripper returned by NewDebugRoundTripper.
+type DebugConfig struct {
+	Logger Logger
+
+	// Headers logs the headers of requests and responses.
+	Headers bool
+	// Bodies logs the bodies of requests and responses, up to MaxBodyBytes each.
+	// Only the logged part is read ahead, the rest is streamed. Bodies of type
+	// application/octet-stream, such as file uploads, are not read and are logged
+	// as their size, as are other bodies that are not text.
+	Bodies bool
+	// MaxBodyBytes is DefaultMaxBodyBytes when zero and unlimited when negative.
+	MaxBodyBytes int
+}
+
+// Debug returns a WrapperFunc logging requests as configured by config.
+func Debug(config DebugConfig) WrapperFunc {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return NewDebugRoundTripper(config, rt)
+	}
+}
+
+type debugRoundTripper struct {
+	config DebugConfig
+	rt     http.RoundTripper
+}
+
+var _ RoundTripperWrapper = &debugRoundTripper{}
+
+// NewDebugRoundTripper logs the method, URL, status and latency of every request
+// made through rt with the message "bigip request", and optionally its headers and
+// bodies. Credentials are redacted: the Authorization, X-F5-Auth-Token and cookie
+// headers, passwords, passphrases and tokens in JSON bodies, passphrases of tmsh
+// commands and PEM encoded private keys.
+func NewDebugRoundTripper(config DebugConfig, rt http.RoundTripper) http.RoundTripper {
+	if config.MaxBodyBytes == 0 {
+		config.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+	return &debugRoundTripper{config: config, rt: rt}
+}
+
+func (rt *debugRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.config.Logger == nil {
+		return rt.rt.RoundTrip(req)
+	}
+	var r redactor
+	r.addSecret(req.Header.Get("X-F5-Auth-Token"))
+
+	var reqBody []byte
+	reqBinary := rt.config.Bodies && isBinary(req.Header)
+	if rt.config.Bodies && !reqBinary && req.Body != nil && req.Body != http.NoBody {
+		body, rest, err := rt.capture(req.Body)
+		if err != nil {
+			req.Body.Close()
+			return nil, err
+		}
+		req = CloneRequest(req)
+		req.Body, reqBody = rest, body
+	}
+
+	start := time.Now()
+	resp, err := rt.rt.RoundTrip(req)
+	latency := time.Since(start)
+
+	var respBody []byte
+	if rt.config.Bodies && err == nil && !isBinary(resp.Header) {
+		var rest io.ReadCloser
+		if respBody, rest, err = rt.capture(resp.Body); err != nil {
+			resp.Body.Close()
+			resp = nil
+		} else {
+			resp.Body = rest
+		}
+	}
+
+	// The bodies are redacted first, as a token they contain may also appear in the URL.
+	var reqLog, respLog string
+	if rt.config.Bodies {
+		if reqBinary {
+			n := req.ContentLength
+			if n == 0 && req.Body != nil && req.Body != http.NoBody {
+				n = -1
+			}
+			reqLog = size(n)
+		} else {
+			reqLog = rt.body(&r, reqBody)
+		}
+		if resp != nil {
+			if isBinary(resp.Header) {
+				respLog = size(resp.ContentLength)
+			} else {
+				respLog = rt.body(&r, respBody)
+			}
+		}
+	}
+	args := []any{"method", req.Method, "url", r.redactString(req.URL.Redacted())}
+	if resp != nil {
+		args = append(args, "status", resp.StatusCode)
+	}
+	args = append(args, "latency", latency)
+	if rt.config.Bodies {
+		args = append(args, "requestBody", reqLog)
+		if resp != nil {
+			args = append(args, "responseBody", respLog)
+		}
+	}
+	if rt.config.Headers {
+		args = append(args, "requestHeader", redactHeader(req.Header, true))
+		if resp != nil {
+			args = append(args, "responseHeader", redactHeader(resp.Header, true))
+		}
+	}
+	if err != nil {
+		args = append(args, "error", err)
+	}
+	rt.config.Logger.DebugContext(req.Context(), "bigip request", args...)
+	return resp, err
+}
+
+// capture reads the part of body to log: MaxBodyBytes and one more byte, telling
+// body that there is more, or all of it when MaxBodyBytes is negative. It returns
+// that part and a body made of it followed by the unread rest, so that large
+// bodies are not held in memory.
+func (rt *debugRoundTripper) capture(body io.ReadCloser) ([]byte, io.ReadCloser, error) {
+	r := io.Reader(body)
+	if rt.config.MaxBodyBytes > 0 {
+		r = io.LimitReader(body, int64(rt.config.MaxBodyBytes)+1)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), body), body}, nil
+}
+
+// body returns the redacted body to log, truncated to MaxBodyBytes.
+func (rt *debugRoundTripper) body(r *redactor, body []byte) string {
+	truncated := rt.config.MaxBodyBytes > 0 && len(body) > rt.config.MaxBodyBytes
+	if truncated {
+		body = trimPartialRune(body[:rt.config.MaxBodyBytes])
+	}
+	if len(body) == 0 && !truncated {
+		return ""
+	}
+	if !utf8.Valid(body) {
+		if truncated {
+			return "[more than " + strconv.Itoa(rt.config.MaxBodyBytes) + " bytes]"
+		}
+		return size(int64(len(body)))
+	}
+	s := r.redactBody(body)
+	if rt.config.MaxBodyBytes > 0 && len(s) > rt.config.MaxBodyBytes {
+		s, truncated = s[:rt.config.MaxBodyBytes], true
+	}
+	if truncated {
+		s += "..."
+	}
+	return s
+}
+
+// isBinary reports whether a body with header is logged as its size only.
+func isBinary(header http.Header) bool {
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	return mediaType == "application/octet-stream"
+}
+
+// size returns the size of a body of length n, which is unknown when negative, to
+// log in place of the body.
+func size(n int64) string {
+	switch {
+	case n == 0:
+		return ""
+	case n < 0:
+		return "[unknown bytes]"
+	}
+	return "[" + strconv.FormatInt(n, 10) + " bytes]"
+}
+
+// trimPartialRune removes the start of a rune cut off at the end of b.
+func trimPartialRune(b []byte) []byte {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				return b[:i]
+			}
+			break
+		}
+	}
+	return b
+}
+
+func (rt *debugRoundTripper) CancelRequest(req *http.Request) {
+	tryCancelRequest(rt.WrappedRoundTripper(), req)
+}
+
+func (rt *debugRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.rt
+}
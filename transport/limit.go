@@ -0,0 +1,169 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Limit throttles one kind of request.
+type Limit struct {
+	// Rate is the number of requests sent per second on average. It is unlimited
+	// when zero.
+	Rate float64
+	// Burst is the number of requests that may be sent at once above Rate. It is 1
+	// when zero.
+	Burst int
+	// MaxInFlight is the number of requests that may be in flight at once, from
+	// the time they are sent until their response headers are received. Response
+	// bodies being read do not count, so that a request may be sent while the body
+	// of another is streamed, e.g. by an Iterator. It is unlimited when zero.
+	MaxInFlight int
+}
+
+// LimitPolicy configures the round tripper returned by NewRateLimitRoundTripper.
+type LimitPolicy struct {
+	// Reads limits GET, HEAD and OPTIONS requests.
+	Reads Limit
+	// Writes limits all other requests.
+	Writes Limit
+}
+
+// RateLimit returns a WrapperFunc throttling requests as configured by policy. The
+// round trippers it returns share their limits, so that all the clients of a
+// session are throttled together.
+func RateLimit(policy LimitPolicy) WrapperFunc {
+	reads, writes := newLimiter(policy.Reads), newLimiter(policy.Writes)
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &limitRoundTripper{reads: reads, writes: writes, rt: rt}
+	}
+}
+
+// NewRateLimitRoundTripper delays the requests made through rt to send them at the
+// rate and with the number in flight allowed by policy. A request waiting for its
+// turn fails with the error of its context once that is done.
+func NewRateLimitRoundTripper(policy LimitPolicy, rt http.RoundTripper) http.RoundTripper {
+	return RateLimit(policy)(rt)
+}
+
+type skipRateLimitKey struct{}
+
+// SkipRateLimit returns a copy of ctx whose requests are not throttled, for requests
+// that others wait for, such as the login renewing a token that was rejected.
+func SkipRateLimit(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipRateLimitKey{}, true)
+}
+
+type limitRoundTripper struct {
+	reads  *limiter
+	writes *limiter
+	rt     http.RoundTripper
+}
+
+var _ RoundTripperWrapper = &limitRoundTripper{}
+
+func (rt *limitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if skip, _ := req.Context().Value(skipRateLimitKey{}).(bool); skip {
+		return rt.rt.RoundTrip(req)
+	}
+	l := rt.writes
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		l = rt.reads
+	}
+	release, err := l.acquire(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	defer release()
+	return rt.rt.RoundTrip(req)
+}
+
+func (rt *limitRoundTripper) CancelRequest(req *http.Request) {
+	tryCancelRequest(rt.WrappedRoundTripper(), req)
+}
+
+func (rt *limitRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.rt
+}
+
+// limiter combines a token bucket with a semaphore.
+type limiter struct {
+	slots chan struct{}
+
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(limit Limit) *limiter {
+	l := &limiter{rate: limit.Rate, burst: float64(limit.Burst)}
+	if l.burst <= 0 {
+		l.burst = 1
+	}
+	l.tokens = l.burst
+	if limit.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, limit.MaxInFlight)
+	}
+	return l
+}
+
+// acquire waits until a request may be sent and returns the function to call once
+// it is done.
+func (l *limiter) acquire(ctx context.Context) (release func(), err error) {
+	release = func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = func() { <-l.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if err := l.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// wait takes a token from the bucket, waiting for one to be added if it is empty.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+	l.mu.Lock()
+	now := time.Now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	// The token is reserved now, so that waiting requests are sent in turn.
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,113 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimitRoundTripper(t *testing.T) {
+	var inFlight, maxInFlight int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer ts.Close()
+
+	rt := NewRateLimitRoundTripper(LimitPolicy{
+		Reads:  Limit{MaxInFlight: 2},
+		Writes: Limit{Rate: 50, Burst: 1},
+	}, http.DefaultTransport)
+	client := &http.Client{Transport: rt}
+	do := func(ctx context.Context, method string) error {
+		req, err := http.NewRequestWithContext(ctx, method, ts.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		io.Copy(io.Discard, resp.Body)
+		return resp.Body.Close()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := do(context.Background(), http.MethodGet); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if maxInFlight != 2 {
+		t.Errorf("expected 2 reads in flight at most, got %d", maxInFlight)
+	}
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := do(context.Background(), http.MethodPost); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The first write is sent at once, the others every 20ms.
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("expected the writes to take at least 60ms, took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	do(context.Background(), http.MethodPost)
+	if err := do(ctx, http.MethodPost); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the queued write to time out, got %v", err)
+	}
+}
+
+func TestRateLimitRoundTripperOpenBody(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: NewRateLimitRoundTripper(LimitPolicy{Reads: Limit{MaxInFlight: 1}}, http.DefaultTransport)}
+	get := func(ctx context.Context) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client.Do(req)
+	}
+
+	// The body of the first response is still being read, like a page of an
+	// Iterator, when the second request is sent.
+	open, err := get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer open.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	resp, err := get(ctx)
+	if err != nil {
+		t.Fatalf("expected the read to be sent while another body is open, got %v", err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
@@ -0,0 +1,138 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Labels identify the endpoint of a request for metrics and traces. They are set by
+// rest.Request from the segments the request was built with, e.g. tm, ltm, pool and
+// members for the members of a pool, but never include the name of an object.
+type Labels struct {
+	Method           string
+	ResourceCategory string
+	Manager          string
+	Resource         string
+	SubResource      string
+}
+
+// String returns the labels as a path, e.g. "GET tm/ltm/pool/members".
+func (l Labels) String() string {
+	segments := []string{}
+	for _, s := range []string{l.ResourceCategory, l.Manager, l.Resource, l.SubResource} {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	return strings.TrimSpace(l.Method + " " + strings.Join(segments, "/"))
+}
+
+type labelsKey struct{}
+
+// WithLabels returns a copy of ctx carrying the labels of the request made with it.
+func WithLabels(ctx context.Context, labels Labels) context.Context {
+	return context.WithValue(ctx, labelsKey{}, labels)
+}
+
+// LabelsFor returns the labels of req: those its context carries, or otherwise the
+// category and manager of its path, e.g. tm and ltm for /mgmt/tm/ltm/policy.
+func LabelsFor(req *http.Request) Labels {
+	labels, ok := req.Context().Value(labelsKey{}).(Labels)
+	if !ok {
+		segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+		if len(segments) > 1 && segments[0] == "mgmt" {
+			labels.ResourceCategory = segments[1]
+		}
+		if len(segments) > 2 && segments[0] == "mgmt" {
+			labels.Manager = segments[2]
+		}
+	}
+	labels.Method = req.Method
+	return labels
+}
+
+// Metrics receives the measurements of the instrumented round tripper, e.g. to
+// update Prometheus or OpenTelemetry instruments: a gauge of the requests in flight,
+// a histogram of their latency and a counter of their status codes.
+type Metrics interface {
+	// RequestStarted is called when a request is sent.
+	RequestStarted(labels Labels)
+	// RequestDone is called when the response to a request arrived or the request
+	// failed, with the status code, or 0 if there is no response, and the latency
+	// until the response headers were received.
+	RequestDone(labels Labels, code int, latency time.Duration)
+}
+
+// Tracer starts a span for every request of the instrumented round tripper.
+type Tracer interface {
+	// Start starts a span for the request with labels and returns the context the
+	// request is sent with and a function ending the span, which is called with
+	// the status code, or 0 if there is no response, and the error of the request.
+	Start(ctx context.Context, labels Labels) (context.Context, func(code int, err error))
+}
+
+// InstrumentConfig configures the round tripper returned by NewInstrumentedRoundTripper.
+// Metrics and Tracer are optional.
+type InstrumentConfig struct {
+	Metrics Metrics
+	Tracer  Tracer
+}
+
+// Instrument returns a WrapperFunc reporting requests as configured by config.
+func Instrument(config InstrumentConfig) WrapperFunc {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return NewInstrumentedRoundTripper(config, rt)
+	}
+}
+
+type instrumentedRoundTripper struct {
+	config InstrumentConfig
+	rt     http.RoundTripper
+}
+
+var _ RoundTripperWrapper = &instrumentedRoundTripper{}
+
+// NewInstrumentedRoundTripper reports the requests made through rt to config.Metrics
+// and config.Tracer, labelled with the endpoint they were made to.
+func NewInstrumentedRoundTripper(config InstrumentConfig, rt http.RoundTripper) http.RoundTripper {
+	return &instrumentedRoundTripper{config: config, rt: rt}
+}
+
+func (rt *instrumentedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	labels := LabelsFor(req)
+	var end func(code int, err error)
+	if rt.config.Tracer != nil {
+		var ctx context.Context
+		ctx, end = rt.config.Tracer.Start(req.Context(), labels)
+		req = req.WithContext(ctx)
+	}
+	if rt.config.Metrics != nil {
+		rt.config.Metrics.RequestStarted(labels)
+	}
+
+	start := time.Now()
+	resp, err := rt.rt.RoundTrip(req)
+	latency := time.Since(start)
+
+	code := 0
+	if resp != nil {
+		code = resp.StatusCode
+	}
+	if rt.config.Metrics != nil {
+		rt.config.Metrics.RequestDone(labels, code, latency)
+	}
+	if end != nil {
+		end(code, err)
+	}
+	return resp, err
+}
+
+func (rt *instrumentedRoundTripper) CancelRequest(req *http.Request) {
+	tryCancelRequest(rt.WrappedRoundTripper(), req)
+}
+
+func (rt *instrumentedRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.rt
+}
@@ -0,0 +1,97 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testMetrics struct {
+	mu       sync.Mutex
+	inFlight int
+	done     []string
+	codes    []int
+}
+
+func (m *testMetrics) RequestStarted(labels Labels) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inFlight++
+}
+
+func (m *testMetrics) RequestDone(labels Labels, code int, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inFlight--
+	m.done = append(m.done, labels.String())
+	m.codes = append(m.codes, code)
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type spanKey struct{}
+
+type testTracer struct {
+	spans []string
+}
+
+func (tr *testTracer) Start(ctx context.Context, labels Labels) (context.Context, func(code int, err error)) {
+	return context.WithValue(ctx, spanKey{}, labels.String()), func(code int, err error) {
+		tr.spans = append(tr.spans, labels.String())
+	}
+}
+
+func TestInstrumentedRoundTripper(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/mgmt/tm/ltm/pool/~Common~missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	metrics, tracer := &testMetrics{}, &testTracer{}
+	var spans []any
+	inner := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		spans = append(spans, req.Context().Value(spanKey{}))
+		if req.URL.Path == "/mgmt/tm/sys/failover" {
+			return nil, errors.New("connection refused")
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	client := &http.Client{Transport: NewInstrumentedRoundTripper(InstrumentConfig{Metrics: metrics, Tracer: tracer}, inner)}
+
+	ctx := WithLabels(context.Background(), Labels{ResourceCategory: "tm", Manager: "ltm", Resource: "pool", SubResource: "members"})
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/mgmt/tm/ltm/pool/~Common~p1/members", nil)
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	req, _ = http.NewRequest(http.MethodDelete, ts.URL+"/mgmt/tm/ltm/pool/~Common~missing", nil)
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	req, _ = http.NewRequest(http.MethodPost, ts.URL+"/mgmt/tm/sys/failover", nil)
+	if _, err := client.Do(req); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	expected := []string{"GET tm/ltm/pool/members", "DELETE tm/ltm", "POST tm/sys"}
+	for i, labels := range expected {
+		if metrics.done[i] != labels || tracer.spans[i] != labels || spans[i] != labels {
+			t.Errorf("request %d: expected %q, got %q, %q and %q", i, labels, metrics.done[i], tracer.spans[i], spans[i])
+		}
+	}
+	if codes := metrics.codes; codes[0] != 200 || codes[1] != 404 || codes[2] != 0 {
+		t.Errorf("unexpected codes %v", codes)
+	}
+	if metrics.inFlight != 0 {
+		t.Errorf("expected no request in flight, got %d", metrics.inFlight)
+	}
+}
@@ -0,0 +1,102 @@
+package transport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /mgmt/shared/authn/login":
+			w.Write([]byte(`{"username":"admin","token":{"token":"TOKEN123456","selfLink":"https://localhost/mgmt/shared/authz/tokens/TOKEN123456"}}`))
+		case "GET /mgmt/tm/ltm/pool":
+			w.Write([]byte(`{"items":[{"name":"p1","minActiveMembers":12345678901234567}]}`))
+		case "DELETE /mgmt/shared/authz/tokens/TOKEN123456":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	requests := []struct {
+		method, path, body string
+	}{
+		{http.MethodPost, "/mgmt/shared/authn/login", `{"username":"admin","password":"secret-password"}`},
+		{http.MethodGet, "/mgmt/tm/ltm/pool?$select=name&$top=5", ""},
+		{http.MethodDelete, "/mgmt/shared/authz/tokens/TOKEN123456", ""},
+	}
+	do := func(rt http.RoundTripper, method, path, body string) (int, string, error) {
+		req, err := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.SetBasicAuth("admin", "secret-password")
+		resp, err := (&http.Client{Transport: rt}).Do(req)
+		if err != nil {
+			return 0, "", err
+		}
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(data), nil
+	}
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := NewRecorder(path, ModeRecord)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	rt := rec.Wrap(http.DefaultTransport)
+	for _, r := range requests {
+		if _, _, err := do(rt, r.method, r.path, r.body); err != nil {
+			t.Fatalf("%s %s: %v", r.method, r.path, err)
+		}
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secret-password", "TOKEN123456", "Authorization"} {
+		if bytes.Contains(data, []byte(secret)) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+
+	rec, err = NewRecorder(path, ModeReplay)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	rt = rec.Wrap(nil)
+	ts.Close()
+	expected := []string{
+		`{"token":{"selfLink":"https://localhost/mgmt/shared/authz/tokens/REDACTED","token":"REDACTED"},"username":"admin"}`,
+		`{"items":[{"minActiveMembers":12345678901234567,"name":"p1"}]}`,
+		``,
+	}
+	for i, r := range requests {
+		// The client uses the redacted token it was given.
+		code, body, err := do(rt, r.method, strings.ReplaceAll(r.path, "TOKEN123456", Redacted), r.body)
+		if err != nil {
+			t.Fatalf("%s %s: %v", r.method, r.path, err)
+		}
+		if code != http.StatusOK || body != expected[i] {
+			t.Errorf("%s %s: unexpected response %d %s", r.method, r.path, code, body)
+		}
+	}
+	if _, _, err := do(rt, http.MethodGet, "/mgmt/tm/ltm/pool?$select=name&$top=5", ""); err == nil {
+		t.Error("expected an interaction not to be replayed twice")
+	}
+	if _, _, err := do(rt, http.MethodGet, "/mgmt/tm/ltm/virtual", ""); err == nil {
+		t.Error("expected an unrecorded request to fail")
+	}
+}
@@ -0,0 +1,145 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces secrets in logged and recorded requests.
+const Redacted = "REDACTED"
+
+// redactedHeaders are the headers carrying credentials.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-F5-Auth-Token"}
+
+// redactedKeys are the JSON attributes whose string values are replaced by Redacted,
+// such as the password of a login and the token it returns. Tokens are also replaced
+// wherever else they appear, e.g. in the path addressing them.
+var redactedKeys = map[string]bool{
+	"oldPassword":  false,
+	"passphrase":   false,
+	"password":     false,
+	"refreshToken": true,
+	"secret":       false,
+	"token":        true,
+}
+
+var (
+	// privateKeyPattern matches PEM encoded private keys, e.g. in the body of a key
+	// upload, including a key cut off by the end of a truncated body.
+	privateKeyPattern = regexp.MustCompile(`-----BEGIN ([A-Z0-9 ]*)PRIVATE KEY-----[\s\S]*?(-----END ([A-Z0-9 ]*)PRIVATE KEY-----|$)`)
+	// jsonSecretPattern matches the string values of redactedKeys in JSON that could
+	// not be decoded, such as a truncated body.
+	jsonSecretPattern = regexp.MustCompile(`"(oldPassword|passphrase|password|refreshToken|secret|token)"(\s*:\s*)"(?:[^"\\]|\\.)*("|$)`)
+	// passphrasePattern matches the passphrase or password argument of tmsh commands,
+	// e.g. "save sys ucs backup passphrase secret".
+	passphrasePattern = regexp.MustCompile(`\b(passphrase|password)(\s+)("[^"]*"|'[^']*'|[^\s"']+)`)
+)
+
+// redactor replaces credentials and secrets in requests and responses.
+type redactor struct {
+	// secrets holds the tokens seen so far.
+	secrets []string
+}
+
+// redactHeader returns a copy of header with the values of redactedHeaders
+// removed, or replaced by Redacted when keep is set.
+func redactHeader(header http.Header, keep bool) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	header = CloneHeader(header)
+	for _, key := range redactedHeaders {
+		if keep && header.Get(key) != "" {
+			header.Set(key, Redacted)
+			continue
+		}
+		header.Del(key)
+	}
+	return header
+}
+
+// redactBody returns body with the values of redactedKeys and the secrets seen so
+// far replaced. JSON bodies are returned in a canonical form.
+func (r *redactor) redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var v any
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return r.redactString(jsonSecretPattern.ReplaceAllString(string(body), `"$1"$2"`+Redacted+`"`))
+	}
+	r.collectSecrets(v)
+	v = r.redactValue(v)
+	data, err := json.Marshal(v)
+	if err != nil {
+		return r.redactString(string(body))
+	}
+	return string(data)
+}
+
+// collectSecrets adds the tokens in v to the secrets.
+func (r *redactor) collectSecrets(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if s, ok := value.(string); ok && redactedKeys[key] {
+				r.addSecret(s)
+			}
+			r.collectSecrets(value)
+		}
+	case []any:
+		for _, value := range v {
+			r.collectSecrets(value)
+		}
+	}
+}
+
+// addSecret makes s redacted wherever it appears.
+func (r *redactor) addSecret(s string) {
+	if s != "" && s != Redacted {
+		r.secrets = append(r.secrets, s)
+	}
+}
+
+func (r *redactor) redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if s, ok := value.(string); ok && s != "" {
+				if _, redacted := redactedKeys[key]; redacted {
+					v[key] = Redacted
+					continue
+				}
+			}
+			v[key] = r.redactValue(value)
+		}
+	case []any:
+		for i := range v {
+			v[i] = r.redactValue(v[i])
+		}
+	case string:
+		return r.redactString(v)
+	}
+	return v
+}
+
+// redactString replaces the secrets, private keys and command passphrases in s.
+func (r *redactor) redactString(s string) string {
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, Redacted)
+	}
+	s = privateKeyPattern.ReplaceAllStringFunc(s, func(key string) string {
+		m := privateKeyPattern.FindStringSubmatch(key)
+		redacted := "-----BEGIN " + m[1] + "PRIVATE KEY-----" + Redacted
+		if m[2] != "" {
+			redacted += "-----END " + m[3] + "PRIVATE KEY-----"
+		}
+		return redacted
+	})
+	return passphrasePattern.ReplaceAllString(s, "${1}${2}"+Redacted)
+}
@@ -0,0 +1,223 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Defaults used for the zero fields of a RetryPolicy.
+const (
+	DefaultMaxRetries = 3
+	DefaultMinBackoff = 500 * time.Millisecond
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy configures the round tripper returned by NewRetryRoundTripper.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a request is repeated after the first
+	// attempt. DefaultMaxRetries is used when zero; a negative value disables retries.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry. It doubles with every retry
+	// up to MaxBackoff, and a random jitter of up to half the delay is subtracted.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// RetryPOST allows POST requests to be retried. They are not by default, as
+	// repeating them may create an object twice.
+	RetryPOST bool
+	// RetryPATCH allows PATCH requests to be retried. They are not by default, as
+	// a PATCH replacing a list, such as the rules of a virtual server, may undo
+	// a change made by another client in between.
+	RetryPATCH bool
+
+	// Retryable decides whether an attempt failed in a way worth retrying. It is
+	// DefaultRetryable when nil.
+	Retryable func(resp *http.Response, err error) bool
+
+	// OnAttempt, when set, is called after every attempt.
+	OnAttempt func(Attempt)
+}
+
+// Attempt describes one attempt of a request made by the retry round tripper.
+type Attempt struct {
+	Request *http.Request
+	// Number counts the attempts of the request, starting at 1.
+	Number int
+	// Response and Err are the result of the attempt. The body of Response must not
+	// be read.
+	Response *http.Response
+	Err      error
+	// Retry reports whether the request is attempted again after Delay.
+	Retry bool
+	Delay time.Duration
+}
+
+// DefaultRetryable retries connection errors, 429 Too Many Requests and the 502,
+// 503 and 504 responses returned while restjavad is busy or restarting. Errors
+// verifying the certificate of the device, including a *PinError, and TLS record
+// header errors, e.g. from speaking TLS to a plain HTTP port, are permanent.
+func DefaultRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) && !isTLSError(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isTLSError reports whether err is an error of the TLS handshake that repeating
+// the request cannot fix.
+func isTLSError(err error) bool {
+	var (
+		verificationErr *tls.CertificateVerificationError
+		recordHeaderErr tls.RecordHeaderError
+		pinErr          *PinError
+		unknownAuthErr  x509.UnknownAuthorityError
+		hostnameErr     x509.HostnameError
+		invalidErr      x509.CertificateInvalidError
+	)
+	return errors.As(err, &verificationErr) || errors.As(err, &recordHeaderErr) || errors.As(err, &pinErr) ||
+		errors.As(err, &unknownAuthErr) || errors.As(err, &hostnameErr) || errors.As(err, &invalidErr)
+}
+
+// Retry returns a WrapperFunc that retries requests according to policy.
+func Retry(policy RetryPolicy) WrapperFunc {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return NewRetryRoundTripper(policy, rt)
+	}
+}
+
+type retryRoundTripper struct {
+	policy RetryPolicy
+	rt     http.RoundTripper
+}
+
+var _ RoundTripperWrapper = &retryRoundTripper{}
+
+// NewRetryRoundTripper retries requests failing as decided by policy.Retryable with
+// an exponential backoff, or after the delay given by a Retry-After header. GET,
+// HEAD, OPTIONS, PUT and DELETE requests are retried, POST and PATCH requests only
+// when policy.RetryPOST or policy.RetryPATCH is set. A request with a body is only retried if its GetBody is
+// set, which is the case for all requests built by the rest package from []byte.
+func NewRetryRoundTripper(policy RetryPolicy, rt http.RoundTripper) http.RoundTripper {
+	if policy.MaxRetries == 0 {
+		policy.MaxRetries = DefaultMaxRetries
+	}
+	if policy.MinBackoff <= 0 {
+		policy.MinBackoff = DefaultMinBackoff
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = DefaultMaxBackoff
+	}
+	if policy.Retryable == nil {
+		policy.Retryable = DefaultRetryable
+	}
+	return &retryRoundTripper{policy: policy, rt: rt}
+}
+
+func (rt *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	canRetry := rt.retriesMethod(req.Method) && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+	backoff := rt.policy.MinBackoff
+	for attempt := 1; ; attempt++ {
+		resp, err := rt.rt.RoundTrip(req)
+
+		retry := canRetry && attempt <= rt.policy.MaxRetries && rt.policy.Retryable(resp, err)
+		var delay time.Duration
+		if retry {
+			delay = rt.delay(resp, backoff)
+			backoff *= 2
+		}
+		if rt.policy.OnAttempt != nil {
+			rt.policy.OnAttempt(Attempt{Request: req, Number: attempt, Response: resp, Err: err, Retry: retry, Delay: delay})
+		}
+		if !retry {
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = CloneRequest(req)
+			req.Body = body
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (rt *retryRoundTripper) retriesMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPatch:
+		return rt.policy.RetryPATCH
+	case http.MethodPost:
+		return rt.policy.RetryPOST
+	}
+	return false
+}
+
+// delay returns how long to wait before retrying after resp, given the current
+// backoff. A Retry-After header takes precedence but is capped at MaxBackoff.
+func (rt *retryRoundTripper) delay(resp *http.Response, backoff time.Duration) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if d > rt.policy.MaxBackoff {
+				d = rt.policy.MaxBackoff
+			}
+			return d
+		}
+	}
+	if backoff > rt.policy.MaxBackoff {
+		backoff = rt.policy.MaxBackoff
+	}
+	return backoff - time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// retryAfter parses the value of a Retry-After header, given either in seconds or
+// as an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+func (rt *retryRoundTripper) CancelRequest(req *http.Request) {
+	tryCancelRequest(rt.WrappedRoundTripper(), req)
+}
+
+func (rt *retryRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.rt
+}
@@ -0,0 +1,184 @@
+package transport
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newBusyServer returns a server that answers the first failures requests with
+// 503 Service Unavailable and records the bodies it receives.
+func newBusyServer(failures int32, retryAfter string) (*httptest.Server, *int32, *[]string) {
+	var calls int32
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if atomic.AddInt32(&calls, 1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"code":503,"message":"restjavad is busy"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	return ts, &calls, &bodies
+}
+
+func TestRetryRoundTripper(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		policy    RetryPolicy
+		failures  int32
+		wantCalls int32
+		wantCode  int
+	}{
+		{name: "GET succeeds after retries", method: http.MethodGet, failures: 2, wantCalls: 3, wantCode: http.StatusOK},
+		{name: "PUT replays the body", method: http.MethodPut, failures: 1, wantCalls: 2, wantCode: http.StatusOK},
+		{name: "gives up after MaxRetries", method: http.MethodDelete, policy: RetryPolicy{MaxRetries: 2}, failures: 5, wantCalls: 3, wantCode: http.StatusServiceUnavailable},
+		{name: "POST is not retried", method: http.MethodPost, failures: 1, wantCalls: 1, wantCode: http.StatusServiceUnavailable},
+		{name: "POST opted in", method: http.MethodPost, policy: RetryPolicy{RetryPOST: true}, failures: 1, wantCalls: 2, wantCode: http.StatusOK},
+		{name: "PATCH is not retried", method: http.MethodPatch, failures: 1, wantCalls: 1, wantCode: http.StatusServiceUnavailable},
+		{name: "PATCH opted in", method: http.MethodPatch, policy: RetryPolicy{RetryPATCH: true}, failures: 1, wantCalls: 2, wantCode: http.StatusOK},
+		{name: "disabled", method: http.MethodGet, policy: RetryPolicy{MaxRetries: -1}, failures: 1, wantCalls: 1, wantCode: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, calls, bodies := newBusyServer(tt.failures, "")
+			defer ts.Close()
+
+			tt.policy.MinBackoff = time.Millisecond
+			client := &http.Client{Transport: NewRetryRoundTripper(tt.policy, http.DefaultTransport)}
+			req, err := http.NewRequest(tt.method, ts.URL, bytes.NewReader([]byte(`{"name":"pool1"}`)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("expected status %d, got %d", tt.wantCode, resp.StatusCode)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, *calls)
+			}
+			for i, body := range *bodies {
+				if body != `{"name":"pool1"}` {
+					t.Errorf("attempt %d sent body %q", i+1, body)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryRoundTripperRetryAfter(t *testing.T) {
+	ts, _, _ := newBusyServer(1, "1")
+	defer ts.Close()
+
+	var attempts []Attempt
+	policy := RetryPolicy{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 50 * time.Millisecond,
+		OnAttempt: func(a Attempt) {
+			attempts = append(attempts, a)
+		},
+	}
+	resp, err := (&http.Client{Transport: NewRetryRoundTripper(policy, http.DefaultTransport)}).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	resp.Body.Close()
+
+	if len(attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(attempts))
+	}
+	first, last := attempts[0], attempts[1]
+	if first.Number != 1 || !first.Retry || first.Response.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("unexpected first attempt %+v", first)
+	}
+	// Retry-After asks for a second, which is capped at MaxBackoff.
+	if first.Delay != 50*time.Millisecond {
+		t.Errorf("expected a delay of 50ms, got %v", first.Delay)
+	}
+	if last.Number != 2 || last.Retry || last.Delay != 0 {
+		t.Errorf("unexpected last attempt %+v", last)
+	}
+}
+
+func TestRetryRoundTripperConnectionError(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	url := ts.URL
+	ts.Close()
+
+	var attempts int
+	policy := RetryPolicy{
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+		OnAttempt: func(a Attempt) {
+			attempts++
+		},
+	}
+	_, err := (&http.Client{Transport: NewRetryRoundTripper(policy, http.DefaultTransport)}).Get(url)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryRoundTripperTLSError(t *testing.T) {
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	defer ts.Close()
+	ts.Config.ErrorLog = log.New(io.Discard, "", 0)
+	pinned, err := TLSConfigFor(&TLSConfig{Pins: []string{strings.Repeat("00", 32)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, transport := range map[string]http.RoundTripper{
+		"unknown authority": http.DefaultTransport,
+		"pin mismatch":      &http.Transport{TLSClientConfig: pinned},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var attempts int
+			policy := RetryPolicy{
+				MinBackoff: time.Millisecond,
+				OnAttempt: func(a Attempt) {
+					attempts++
+				},
+			}
+			_, err := (&http.Client{Transport: NewRetryRoundTripper(policy, transport)}).Get(ts.URL)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if attempts != 1 {
+				t.Errorf("expected 1 attempt, got %d: %v", attempts, err)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	if d, ok := retryAfter("120"); !ok || d != 2*time.Minute {
+		t.Errorf("expected 2m, got %v %v", d, ok)
+	}
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if d, ok := retryAfter(date); !ok || d <= 59*time.Minute {
+		t.Errorf("expected about an hour, got %v %v", d, ok)
+	}
+	if _, ok := retryAfter("soon"); ok {
+		t.Error("expected an invalid value to be ignored")
+	}
+}
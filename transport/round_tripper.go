@@ -1,9 +1,13 @@
 package transport
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"golang.org/x/oauth2"
+	"io"
 	"net/http"
+	"time"
 )
 
 func HTTPWrappersFor(config *Config, rt http.RoundTripper) (http.RoundTripper, error) {
@@ -14,10 +18,10 @@ func HTTPWrappersFor(config *Config, rt http.RoundTripper) (http.RoundTripper, e
 	case config.HasBasicAuth() && config.HasTokenAuth():
 		return nil, fmt.Errorf("username/password or bearer token may be set, but not both")
 	case config.HasTokenAuth():
-		var err error
-		rt = NewTokenAuthRoundTripper(config.BearerToken, rt)
-		if err != nil {
-			return nil, err
+		if config.TokenSource != nil {
+			rt = NewTokenSourceAuthRoundTripper(config.TokenSource, rt)
+		} else {
+			rt = NewTokenAuthRoundTripper(config.BearerToken, rt)
 		}
 	case config.HasBasicAuth():
 		rt = NewBasicAuthRoundTripper(config.Username, config.Password, rt)
@@ -61,6 +65,10 @@ func (rt *basicAuthRoundTripper) WrappedRoundTripper() http.RoundTripper {
 	return rt.rt
 }
 
+// maxUnauthorizedBody is the size of the body of a 401 response kept when the
+// request is retried with a new token.
+const maxUnauthorizedBody = 64 << 10
+
 // token login
 type tokenAuthRoundTripper struct {
 	token  string
@@ -70,28 +78,103 @@ type tokenAuthRoundTripper struct {
 
 var _ RoundTripperWrapper = &tokenAuthRoundTripper{}
 
+// ResettableTokenSource is a TokenSource that can be told to drop its cached token,
+// for example after the server rejected it.
+type ResettableTokenSource interface {
+	oauth2.TokenSource
+	// ResetTokenOlderThan discards the cached token if it was issued before t.
+	ResetTokenOlderThan(t time.Time)
+}
+
+// ContextTokenSource is a TokenSource that can obtain a token on behalf of a
+// request, giving up once the context of the request is done.
+type ContextTokenSource interface {
+	oauth2.TokenSource
+	TokenContext(ctx context.Context) (*oauth2.Token, error)
+}
+
+// tokenFor returns the token of source for the request made with ctx.
+func tokenFor(ctx context.Context, source oauth2.TokenSource) (*oauth2.Token, error) {
+	if s, ok := source.(ContextTokenSource); ok {
+		return s.TokenContext(ctx)
+	}
+	return source.Token()
+}
+
 // NewTokenAuthRoundTripper adds the provided bearer token to a request
 // unless the authorization header has already been set.
 func NewTokenAuthRoundTripper(token string, rt http.RoundTripper) http.RoundTripper {
 	return &tokenAuthRoundTripper{token, nil, rt}
 }
 
+// NewTokenSourceAuthRoundTripper adds the token returned by source to a request
+// unless the authorization header has already been set. When source is a
+// ResettableTokenSource, a request rejected with 401 Unauthorized is retried once
+// with a fresh token.
+func NewTokenSourceAuthRoundTripper(source oauth2.TokenSource, rt http.RoundTripper) http.RoundTripper {
+	return &tokenAuthRoundTripper{source: source, rt: rt}
+}
+
 func (rt *tokenAuthRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	if len(req.Header.Get("X-F5-Auth-Token")) != 0 {
 		return rt.rt.RoundTrip(req)
 	}
-	req = CloneRequest(req)
-	token := rt.token
-	if rt.source != nil {
-		if refreshedToken, err := rt.source.Token(); err == nil {
-			token = refreshedToken.AccessToken
+	if rt.source == nil {
+		req = CloneRequest(req)
+		req.Header.Set("X-F5-Auth-Token", rt.token)
+		return rt.rt.RoundTrip(req)
+	}
+
+	start := time.Now()
+	token, err := tokenFor(req.Context(), rt.source)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rt.roundTripWithToken(req, token.AccessToken)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The token was rejected, most likely because it expired or was revoked on the
+	// device. Log in again and replay the request once.
+	source, ok := rt.source.(ResettableTokenSource)
+	if !ok || (req.Body != nil && req.GetBody == nil) {
+		return resp, nil
+	}
+	// The rejection is read into memory first, so that the response releases what
+	// it holds, such as a slot of a rate limiter the login may need.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxUnauthorizedBody))
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	source.ResetTokenOlderThan(start)
+	refreshed, err := tokenFor(req.Context(), source)
+	if err != nil || refreshed.AccessToken == token.AccessToken {
+		return resp, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
 		}
+		req = CloneRequest(req)
+		req.Body = body
 	}
+	return rt.roundTripWithToken(req, refreshed.AccessToken)
+}
 
+func (rt *tokenAuthRoundTripper) roundTripWithToken(req *http.Request, token string) (*http.Response, error) {
+	req = CloneRequest(req)
 	req.Header.Set("X-F5-Auth-Token", token)
 	return rt.rt.RoundTrip(req)
 }
 
+func (rt *tokenAuthRoundTripper) CancelRequest(req *http.Request) {
+	tryCancelRequest(rt.WrappedRoundTripper(), req)
+}
+
 func (rt *tokenAuthRoundTripper) WrappedRoundTripper() http.RoundTripper {
 	return rt.rt
 }
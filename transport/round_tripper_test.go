@@ -3,9 +3,13 @@ package transport
 import (
 	"encoding/base64"
 	"fmt"
+	"golang.org/x/oauth2"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestBasicAuthRoundTripper(t *testing.T) {
@@ -44,11 +48,10 @@ func TestBasicAuthRoundTripper(t *testing.T) {
 
 func TestBearerAuthRoundTripper(t *testing.T) {
 	bearerToken := "123josd235l0o2lf;235rj"
-	expectedAuthHeader := fmt.Sprintf("Bearer %s", bearerToken)
 
 	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authHeader := r.Header.Get("Authorization")
-		if authHeader != expectedAuthHeader {
+		authHeader := r.Header.Get("X-F5-Auth-Token")
+		if authHeader != bearerToken {
 			w.WriteHeader(http.StatusUnauthorized)
 			return
 		}
@@ -74,3 +77,50 @@ func TestBearerAuthRoundTripper(t *testing.T) {
 		t.Errorf("Expected status code %d, got %d", http.StatusOK, resp.StatusCode)
 	}
 }
+
+type fakeTokenSource struct {
+	tokens []string
+	resets int
+}
+
+func (s *fakeTokenSource) Token() (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: s.tokens[0]}, nil
+}
+
+func (s *fakeTokenSource) ResetTokenOlderThan(time.Time) {
+	s.resets++
+	s.tokens = s.tokens[1:]
+}
+
+func TestTokenSourceAuthRoundTripperRetriesOnUnauthorized(t *testing.T) {
+	var bodies []string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if r.Header.Get("X-F5-Auth-Token") != "fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	source := &fakeTokenSource{tokens: []string{"expired", "fresh"}}
+	client := &http.Client{
+		Transport: NewTokenSourceAuthRoundTripper(source, ts.Client().Transport),
+	}
+
+	resp, err := client.Post(ts.URL, "application/json", strings.NewReader(`{"name":"pool"}`))
+	if err != nil {
+		t.Fatalf("Error performing request: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if source.resets != 1 {
+		t.Errorf("Expected the token to be reset once, got %d", source.resets)
+	}
+	if len(bodies) != 2 || bodies[1] != `{"name":"pool"}` {
+		t.Errorf("Expected the body to be replayed, got %q", bodies)
+	}
+}
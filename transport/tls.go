@@ -0,0 +1,189 @@
+package transport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// TLSConfig holds the information needed to verify the device and to authenticate
+// to it with a client certificate.
+type TLSConfig struct {
+	// Insecure skips the verification of the server certificate. Pins are still
+	// checked when set.
+	Insecure bool
+
+	// RootCAs is the pool used to verify the server certificate. CAFile and CAData
+	// are added to it. The system pool is used when all three are empty.
+	RootCAs *x509.CertPool
+	// CAFile is the path to a PEM encoded CA bundle.
+	CAFile string
+	// CAData holds PEM encoded CA certificates. It is added to CAFile.
+	CAData []byte
+
+	// Pins lists the certificates the device may present. An entry is either the
+	// base64 SHA-256 digest of the subject public key info prefixed with "sha256/",
+	// or the hex SHA-256 fingerprint of the whole certificate, with or without
+	// colons. When set and no CA is configured, the pins replace the verification
+	// against a CA, which allows self-signed device certificates to be trusted.
+	Pins []string
+
+	// ServerName overrides the name the server certificate is verified against,
+	// for devices reached by an address that is not in their certificate.
+	ServerName string
+
+	// MinVersion is the minimum TLS version accepted, for example tls.VersionTLS12.
+	MinVersion uint16
+
+	// CertFile and KeyFile are the paths to a PEM encoded client certificate and
+	// key used for mutual TLS.
+	CertFile string
+	KeyFile  string
+	// CertData and KeyData hold a PEM encoded client certificate and key. They
+	// take precedence over CertFile and KeyFile.
+	CertData []byte
+	KeyData  []byte
+}
+
+// HasCA reports whether a CA to verify the server certificate is configured.
+func (c *TLSConfig) HasCA() bool {
+	return c.RootCAs != nil || len(c.CAFile) != 0 || len(c.CAData) != 0
+}
+
+// HasCertAuth reports whether a client certificate is configured.
+func (c *TLSConfig) HasCertAuth() bool {
+	return (len(c.CertData) != 0 || len(c.CertFile) != 0) && (len(c.KeyData) != 0 || len(c.KeyFile) != 0)
+}
+
+// TLSConfigFor returns the tls.Config described by c. A nil c yields a config that
+// does not verify the server certificate, which is what devices still using their
+// default self-signed certificate require.
+func TLSConfigFor(c *TLSConfig) (*tls.Config, error) {
+	if c == nil {
+		return &tls.Config{InsecureSkipVerify: true}, nil
+	}
+	cfg := &tls.Config{
+		ServerName: c.ServerName,
+		MinVersion: c.MinVersion,
+	}
+
+	if c.HasCA() {
+		pool, err := c.rootCAs()
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+
+	if c.HasCertAuth() {
+		cert, err := c.clientCertificate()
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if len(c.Pins) != 0 {
+		verify, err := verifyPins(c.Pins)
+		if err != nil {
+			return nil, err
+		}
+		cfg.VerifyConnection = verify
+		// Without a CA the pins alone decide whether the certificate is trusted.
+		cfg.InsecureSkipVerify = !c.HasCA()
+	}
+	if c.Insecure {
+		cfg.InsecureSkipVerify = true
+	}
+	return cfg, nil
+}
+
+func (c *TLSConfig) rootCAs() (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if c.RootCAs != nil {
+		pool = c.RootCAs.Clone()
+	}
+	if len(c.CAFile) != 0 {
+		data, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", c.CAFile)
+		}
+	}
+	if len(c.CAData) != 0 && !pool.AppendCertsFromPEM(c.CAData) {
+		return nil, errors.New("no certificates found in CA data")
+	}
+	return pool, nil
+}
+
+func (c *TLSConfig) clientCertificate() (tls.Certificate, error) {
+	if len(c.CertData) != 0 || len(c.KeyData) != 0 {
+		return tls.X509KeyPair(c.CertData, c.KeyData)
+	}
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// verifyPins returns a function that accepts a connection only if the leaf
+// certificate of the server matches one of pins.
+func verifyPins(pins []string) (func(tls.ConnectionState) error, error) {
+	var spkiPins, certPins [][]byte
+	for _, pin := range pins {
+		if strings.HasPrefix(pin, "sha256/") {
+			digest, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(pin, "sha256/"))
+			if err != nil || len(digest) != sha256.Size {
+				return nil, fmt.Errorf("invalid public key pin %q", pin)
+			}
+			spkiPins = append(spkiPins, digest)
+			continue
+		}
+		digest, err := hex.DecodeString(strings.ReplaceAll(pin, ":", ""))
+		if err != nil || len(digest) != sha256.Size {
+			return nil, fmt.Errorf("invalid certificate fingerprint %q", pin)
+		}
+		certPins = append(certPins, digest)
+	}
+
+	return func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return errors.New("server presented no certificate")
+		}
+		leaf := state.PeerCertificates[0]
+		spki := sha256.Sum256(leaf.RawSubjectPublicKeyInfo)
+		for _, pin := range spkiPins {
+			if bytes.Equal(pin, spki[:]) {
+				return nil
+			}
+		}
+		fingerprint := sha256.Sum256(leaf.Raw)
+		for _, pin := range certPins {
+			if bytes.Equal(pin, fingerprint[:]) {
+				return nil
+			}
+		}
+		return &PinError{Fingerprint: hex.EncodeToString(fingerprint[:])}
+	}, nil
+}
+
+// PinError is returned when the certificate of the server matches none of the
+// configured pins.
+type PinError struct {
+	// Fingerprint is the hex SHA-256 fingerprint of the certificate presented.
+	Fingerprint string
+}
+
+func (e *PinError) Error() string {
+	return fmt.Sprintf("server certificate %s matches no pin", e.Fingerprint)
+}
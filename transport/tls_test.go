@@ -0,0 +1,168 @@
+package transport
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTLSTestServer() *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func get(t *testing.T, config *Config, url string) (*http.Response, error) {
+	t.Helper()
+	rt, err := New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	resp, err := (&http.Client{Transport: rt}).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return resp, nil
+}
+
+func TestTLSConfigVerification(t *testing.T) {
+	ts := newTLSTestServer()
+	defer ts.Close()
+
+	leaf := ts.Certificate()
+	spki := sha256.Sum256(leaf.RawSubjectPublicKeyInfo)
+	fingerprint := sha256.Sum256(leaf.Raw)
+	pool := x509.NewCertPool()
+	pool.AddCert(leaf)
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})
+
+	tests := []struct {
+		name    string
+		tls     *TLSConfig
+		wantErr bool
+	}{
+		{name: "default skips verification", tls: nil},
+		{name: "system roots", tls: &TLSConfig{}, wantErr: true},
+		{name: "root pool", tls: &TLSConfig{RootCAs: pool}},
+		{name: "CA data", tls: &TLSConfig{CAData: caData}},
+		{name: "server name", tls: &TLSConfig{CAData: caData, ServerName: "example.com"}},
+		{name: "wrong server name", tls: &TLSConfig{CAData: caData, ServerName: "bigip.example.org"}, wantErr: true},
+		{name: "public key pin", tls: &TLSConfig{Pins: []string{"sha256/" + base64.StdEncoding.EncodeToString(spki[:])}}},
+		{name: "certificate pin", tls: &TLSConfig{Pins: []string{hex.EncodeToString(fingerprint[:])}}},
+		{name: "wrong pin", tls: &TLSConfig{Pins: []string{hex.EncodeToString(spki[:])}}, wantErr: true},
+		{name: "insecure", tls: &TLSConfig{Insecure: true}},
+		{name: "minimum version", tls: &TLSConfig{RootCAs: pool, MinVersion: tls.VersionTLS12}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := get(t, &Config{TLS: tt.tls}, ts.URL)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestTLSConfigPinError(t *testing.T) {
+	ts := newTLSTestServer()
+	defer ts.Close()
+
+	_, err := get(t, &Config{TLS: &TLSConfig{Pins: []string{"00:" + hex.EncodeToString(make([]byte, 31))}}}, ts.URL)
+	var pinErr *PinError
+	if !errors.As(err, &pinErr) {
+		t.Fatalf("expected a *PinError, got %v", err)
+	}
+	fingerprint := sha256.Sum256(ts.Certificate().Raw)
+	if pinErr.Fingerprint != hex.EncodeToString(fingerprint[:]) {
+		t.Errorf("unexpected fingerprint %s", pinErr.Fingerprint)
+	}
+}
+
+func TestTLSConfigInvalidPin(t *testing.T) {
+	if _, err := TLSConfigFor(&TLSConfig{Pins: []string{"sha256/not-base64"}}); err == nil {
+		t.Fatal("expected an error for an invalid pin")
+	}
+}
+
+func TestTLSConfigClientCertificate(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Client-Subject", r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	ts.StartTLS()
+	defer ts.Close()
+
+	certData, keyData := newClientCertificate(t, "automation")
+	resp, err := get(t, &Config{TLS: &TLSConfig{Insecure: true, CertData: certData, KeyData: keyData}}, ts.URL)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	if subject := resp.Header.Get("X-Client-Subject"); subject != "automation" {
+		t.Errorf("expected client certificate automation, got %q", subject)
+	}
+
+	if _, err := get(t, &Config{TLS: &TLSConfig{Insecure: true}}, ts.URL); err == nil {
+		t.Error("expected the request without client certificate to fail")
+	}
+}
+
+func TestNewCustomTransport(t *testing.T) {
+	ts := newTLSTestServer()
+	defer ts.Close()
+
+	// A custom transport without TLS options is used as is.
+	if _, err := get(t, &Config{Transport: ts.Client().Transport}, ts.URL); err != nil {
+		t.Fatalf("request: %v", err)
+	}
+
+	// An *http.Transport is given the TLS options.
+	custom := &http.Transport{}
+	if _, err := get(t, &Config{Transport: custom, TLS: &TLSConfig{Insecure: true}}, ts.URL); err != nil {
+		t.Fatalf("request: %v", err)
+	}
+
+	wrapped := NewBasicAuthRoundTripper("admin", "admin", custom)
+	if _, err := New(&Config{Transport: wrapped, TLS: &TLSConfig{Insecure: true}}); err == nil {
+		t.Error("expected TLS options to be refused for a custom round tripper")
+	}
+}
+
+// newClientCertificate returns a PEM encoded self-signed certificate and key for cn.
+func newClientCertificate(t *testing.T, cn string) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
@@ -2,23 +2,33 @@ package transport
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"net/http"
 )
 
+// New returns a round tripper for config. A custom Transport is used as is unless
+// TLS options are set, in which case it must be an *http.Transport; it is cloned
+// and given the TLS settings.
 func New(config *Config) (http.RoundTripper, error) {
-	if config.Transport != nil {
-		return nil, fmt.Errorf("using a custom transport with TLS certificate options or the insecure flag is not allowed")
+	rt := config.Transport
+	if rt == nil || config.TLS != nil {
+		base, ok := rt.(*http.Transport)
+		switch {
+		case rt == nil:
+			base = http.DefaultTransport.(*http.Transport)
+		case !ok:
+			return nil, fmt.Errorf("using a custom transport of type %T with TLS options is not allowed", rt)
+		}
+		tlsConfig, err := TLSConfigFor(config.TLS)
+		if err != nil {
+			return nil, err
+		}
+		customTransport := base.Clone()
+		customTransport.TLSClientConfig = tlsConfig
+		rt = customTransport
 	}
 
-	// clone a new http.Transport connect, and settting InsecureSkipVerify is true.
-
-	customTransport := http.DefaultTransport.(*http.Transport).Clone()
-	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
-	// Use customTransport instead of http.DefaultTransport
-	return HTTPWrappersFor(config, customTransport)
+	return HTTPWrappersFor(config, rt)
 }
 
 // WrapperFunc wraps an http.RoundTripper when a new transport
@@ -29,6 +29,11 @@ Provides a way to manipulate bigip via commands，for example:
 	"uptime"
 */
 func (br *BashResource) Run(item Bash) (*Bash, error) {
+	return br.RunContext(context.Background(), item)
+}
+
+// RunContext is like Run but uses ctx for the request.
+func (br *BashResource) RunContext(ctx context.Context, item Bash) (*Bash, error) {
 
 	var bash Bash
 
@@ -52,7 +57,7 @@ func (br *BashResource) Run(item Bash) (*Bash, error) {
 	}
 	jsonString := string(jsonData)
 	res, err := br.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).
-		ManagerName(UtilManager).Resource(BashEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		ManagerName(UtilManager).Resource(BashEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,12 +1,24 @@
 package util
 
 import (
+	"encoding/json"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"net/http"
 	"testing"
 )
 
 func TestBashResource_Run(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	s := bigiptest.NewServer()
+	defer s.Close()
+	s.HandleFunc("/mgmt/tm/util/bash", func(w http.ResponseWriter, r *http.Request) {
+		var bash Bash
+		json.NewDecoder(r.Body).Decode(&bash)
+		bash.CommandResult = "ltm virtual vs1 { }\n"
+		json.NewEncoder(w).Encode(bash)
+	})
+
+	bigIP, err := bigip.NewSession(s.URL, s.Username, s.Password)
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}